@@ -2,7 +2,10 @@ package main
 
 import (
 	"github.com/gin-gonic/gin"
+	"log"
 	"net/http"
+	"os"
+	"untitled/server"
 )
 
 func pong(c *gin.Context) {
@@ -13,7 +16,14 @@ func pong(c *gin.Context) {
 }
 
 func main() {
-	r := gin.Default()
+	cfg, err := server.LoadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv := server.New(cfg)
+	r := srv.Router()
 	r.GET("/ping", pong)
-	r.Run(":8083")
+	if err := srv.Run(); err != nil {
+		log.Fatal(err)
+	}
 }
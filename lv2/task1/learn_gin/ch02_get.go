@@ -2,12 +2,20 @@ package main
 
 import (
 	"github.com/gin-gonic/gin"
+	"log"
 	"net/http"
+	"os"
+	"untitled/server"
 )
 
 func main() {
 
-	router := gin.Default()
+	cfg, err := server.LoadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv := server.New(cfg)
+	router := srv.Router()
 	goodsGroup := router.Group("/goods")
 	{
 		goodsGroup.GET("/", goodsList)
@@ -27,7 +35,9 @@ func main() {
 
 	}
 
-	router.Run(":8083")
+	if err := srv.Run(); err != nil {
+		log.Fatal(err)
+	}
 
 }
 
@@ -2,7 +2,10 @@ package main
 
 import (
 	"github.com/gin-gonic/gin"
+	"log"
 	"net/http"
+	"os"
+	"untitled/server"
 )
 
 type Person struct {
@@ -12,7 +15,12 @@ type Person struct {
 }
 
 func main() {
-	router := gin.Default()
+	cfg, err := server.LoadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv := server.New(cfg)
+	router := srv.Router()
 	router.GET("/:name/:id", func(c *gin.Context) {
 		var person Person
 		if err := c.ShouldBindUri(&person); err != nil {
@@ -25,5 +33,7 @@ func main() {
 		})
 	})
 
-	router.Run(":8083")
+	if err := srv.Run(); err != nil {
+		log.Fatal(err)
+	}
 }
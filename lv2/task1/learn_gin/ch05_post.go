@@ -2,16 +2,26 @@ package main
 
 import (
 	"github.com/gin-gonic/gin"
+	"log"
 	"net/http"
+	"os"
+	"untitled/server"
 )
 
 func main() {
-	router := gin.Default()
+	cfg, err := server.LoadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv := server.New(cfg)
+	router := srv.Router()
 	router.GET("welcome", welcome)
 	router.POST("/form_post", formPost)
 	router.POST("/post", getPost)
 
-	router.Run(":8083")
+	if err := srv.Run(); err != nil {
+		log.Fatal(err)
+	}
 }
 
 func getPost(c *gin.Context) {
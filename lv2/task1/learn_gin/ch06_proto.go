@@ -2,17 +2,27 @@ package main
 
 import (
 	"github.com/gin-gonic/gin"
+	"log"
 	"net/http"
+	"os"
 	"untitled/proto"
+	"untitled/server"
 )
 
 func main() {
-	router := gin.Default()
+	cfg, err := server.LoadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv := server.New(cfg)
+	router := srv.Router()
 	router.GET("/morejson", moreJson)
 	router.GET("protoBuf", returnProto)
 	router.GET("purejson", purejson)
 
-	router.Run(":8083")
+	if err := srv.Run(); err != nil {
+		log.Fatal(err)
+	}
 	// protoc --go_out=. user.proto
 }
 
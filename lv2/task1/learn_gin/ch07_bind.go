@@ -4,65 +4,17 @@ import "C"
 import (
 	"fmt"
 	"github.com/gin-gonic/gin"
-	"github.com/gin-gonic/gin/binding"
-	"github.com/go-playground/locales/en"
-	"github.com/go-playground/locales/zh"
-	ut "github.com/go-playground/universal-translator"
-	"github.com/go-playground/validator/v10"
-	en_translations "github.com/go-playground/validator/v10/translations/en"
-	zh_translations "github.com/go-playground/validator/v10/translations/zh"
+	"log"
 	"net/http"
-	"reflect"
-	"strings"
+	"os"
+	"untitled/server"
+	"untitled/validate"
 )
 
-var trans ut.Translator
-
-func removeTopStruct(fields map[string]string) map[string]string {
-	rsp := map[string]string{}
-	for field, err := range fields {
-		fmt.Println(err)
-		rsp[field[strings.Index(field, ".")+1:]] = err
-	}
-	return rsp
-}
-
-func InitFrans(locale string) (err error) {
-	//注册gin 框架中 validator 引擎属性
-	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-
-		v.RegisterTagNameFunc(func(fld reflect.StructField) string {
-			name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
-			if name == "-" {
-				return ""
-			}
-			return name
-		})
-
-		zhT := zh.New()
-		enT := en.New()
-		uni := ut.New(enT, zhT, enT)
-		trans, ok = uni.GetTranslator(locale)
-		if !ok {
-			return fmt.Errorf("uni.getTranslator(%s)", locale)
-		}
-		switch locale {
-		case "en":
-			en_translations.RegisterDefaultTranslations(v, trans)
-		case "zh":
-			zh_translations.RegisterDefaultTranslations(v, trans)
-		default:
-			en_translations.RegisterDefaultTranslations(v, trans)
-		}
-		return
-	}
-	return
-}
-
 // godoc.org/github.com/go-playground/validator
 type LoginForm struct {
 	User     string `form:"user" json:"user" xml:"user" binding:"required,min=3,max=10"`
-	Password string `from:"password" json:"password" xml:"password" binding:"required"`
+	Password string `form:"password" json:"password" xml:"password" binding:"required"`
 }
 
 type SignUpForm struct {
@@ -73,27 +25,39 @@ type SignUpForm struct {
 	RePassword string `json:"repassword" binding:"eqfield=Password"`
 }
 
+type TransferForm struct {
+	To     string `json:"to" binding:"required,eth_checksum"`
+	Token  string `json:"token" binding:"omitempty,eth_address"`
+	Amount string `json:"amount" binding:"required,decimal_amount=18"`
+}
+
 func main() {
-	if err := InitFrans("zh"); err != nil {
-		fmt.Println("Ttranslate init has some error")
+	v, err := validate.New("zh")
+	if err != nil {
+		fmt.Println("Ttranslate init has some error", err)
 		return
 	}
-	router := gin.Default()
+	for _, l := range []validate.Locale{validate.Japanese(), validate.Korean(), validate.French()} {
+		if err := v.RegisterLocale(l); err != nil {
+			fmt.Println("Ttranslate init has some error", err)
+			return
+		}
+	}
+	// 自定义规则和 json 字段名要在 gin 开始绑定请求之前装上
+	v.Install()
+	cfg, err := server.LoadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	srv := server.New(cfg)
+	router := srv.Router()
+	// 语言来源：?lang=ja > cookie lang > Accept-Language > zh
+	router.Use(v.Locale())
 	router.POST("/loginJson", func(c *gin.Context) {
 		var loginForm LoginForm
-		if err := c.ShouldBind(&loginForm); err != nil {
-			errs, ok := err.(validator.ValidationErrors)
-			if !ok {
-				c.JSON(http.StatusOK, gin.H{
-					"msg": err.Error(),
-				})
-			}
-			fmt.Println(err.Error())
-			c.JSON(http.StatusBadRequest, gin.H{
-				"msg": removeTopStruct(errs.Translate(trans)),
-			})
+		if err := v.Bind(c, &loginForm); err != nil {
+			validate.Fail(c, err)
 			return
-
 		}
 
 		c.JSON(http.StatusOK, gin.H{
@@ -103,21 +67,19 @@ func main() {
 
 	router.POST("/signUP", func(c *gin.Context) {
 		var signForm SignUpForm
-		if error := c.ShouldBind(&signForm); error != nil {
-
-			_, ok := error.(validator.ValidationErrors)
-			if !ok {
-				c.JSON(http.StatusOK, gin.H{
-					"msg": error.Error(),
-				})
-				return
-			}
-			c.JSON(http.StatusBadRequest, gin.H{})
+		if err := v.Bind(c, &signForm); err != nil {
+			validate.Fail(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"msg": "success",
+		})
+	})
 
-			fmt.Println("err" + error.Error())
-			c.JSON(http.StatusBadRequest, gin.H{
-				"error": error.Error(),
-			})
+	router.POST("/transfer", func(c *gin.Context) {
+		var form TransferForm
+		if err := v.BindJSON(c, &form); err != nil {
+			validate.Fail(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, gin.H{
@@ -125,5 +87,7 @@ func main() {
 		})
 	})
 
-	router.Run(":8083")
+	if err := srv.Run(); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"untitled/proto"
+	"untitled/server"
+	"untitled/teacher"
+)
+
+// 同一个进程同时提供 gRPC(:50051) 和 HTTP/JSON(:8083)，HTTP 由 grpc-gateway 转发到 gRPC
+func main() {
+	cfg, err := server.LoadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	lis, err := net.Listen("tcp", ":50051")
+	if err != nil {
+		log.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	proto.RegisterTeacherServiceServer(grpcServer, teacher.NewServer())
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	conn, err := grpc.NewClient("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	srv := server.New(cfg)
+	router := srv.Router()
+	if err := teacher.MountGateway(context.Background(), router, conn); err != nil {
+		log.Fatal(err)
+	}
+	// HTTP 先停止，再关闭网关连接和 gRPC 服务
+	srv.OnShutdown(func(ctx context.Context) error {
+		conn.Close()
+		grpcServer.GracefulStop()
+		return nil
+	})
+
+	if err := srv.Run(); err != nil {
+		log.Fatal(err)
+	}
+	// curl -X POST localhost:8083/v1/teachers -d '{"name":"bobby","course":["go"]}'
+	// curl localhost:8083/v1/teachers
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"os"
+	"untitled/server"
+	"untitled/telemetry"
+)
+
+// 请求耗时、以太坊 RPC 调用耗时/错误数通过 /metrics 暴露，trace 从 HTTP 请求一直传到 RPC 调用
+// go run ch09_metrics.go -eth-rpc https://cloudflare-eth.com
+func main() {
+	cfg, err := server.LoadConfig(os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+	if cfg.EthRPC == "" {
+		cfg.EthRPC = "https://cloudflare-eth.com"
+	}
+
+	metrics := telemetry.NewMetrics()
+	tracing, err := telemetry.NewStdoutTracing(os.Stderr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	client, err := telemetry.DialEth(context.Background(), cfg.EthRPC, &telemetry.Transport{
+		Metrics: metrics,
+		Tracing: tracing,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// 中间件要在 server.New 注册 /healthz、/readyz 之前装上，否则这两个路由不会被记录
+	srv := server.New(cfg, tracing.Middleware(), metrics.Middleware())
+	srv.OnShutdown(func(ctx context.Context) error {
+		client.Close()
+		return tracing.Shutdown(ctx)
+	})
+	router := srv.Router()
+	router.GET("/metrics", metrics.Handler())
+	router.GET("/block", func(c *gin.Context) {
+		header, err := client.HeaderByNumber(c.Request.Context(), nil)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{
+				"msg": err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"number": header.Number.String(),
+			"hash":   header.Hash().Hex(),
+		})
+	})
+	router.GET("/balance/:address", func(c *gin.Context) {
+		address := c.Param("address")
+		if !common.IsHexAddress(address) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"msg": "invalid address",
+			})
+			return
+		}
+		balance, err := client.BalanceAt(c.Request.Context(), common.HexToAddress(address), nil)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{
+				"msg": err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"wei": balance.String(),
+		})
+	})
+
+	if err := srv.Run(); err != nil {
+		log.Fatal(err)
+	}
+}
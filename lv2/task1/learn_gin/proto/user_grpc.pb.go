@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: user.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TeacherService_CreateTeacher_FullMethodName = "/TeacherService/CreateTeacher"
+	TeacherService_GetTeacher_FullMethodName    = "/TeacherService/GetTeacher"
+	TeacherService_UpdateTeacher_FullMethodName = "/TeacherService/UpdateTeacher"
+	TeacherService_DeleteTeacher_FullMethodName = "/TeacherService/DeleteTeacher"
+	TeacherService_ListTeachers_FullMethodName  = "/TeacherService/ListTeachers"
+)
+
+// TeacherServiceClient is the client API for TeacherService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TeacherService 同时通过 gRPC 和 grpc-gateway 的 HTTP/JSON 对外提供服务，
+// HTTP 路由定义在 user_gateway.yaml 中
+type TeacherServiceClient interface {
+	CreateTeacher(ctx context.Context, in *CreateTeacherRequest, opts ...grpc.CallOption) (*Teacher, error)
+	GetTeacher(ctx context.Context, in *GetTeacherRequest, opts ...grpc.CallOption) (*Teacher, error)
+	UpdateTeacher(ctx context.Context, in *UpdateTeacherRequest, opts ...grpc.CallOption) (*Teacher, error)
+	DeleteTeacher(ctx context.Context, in *DeleteTeacherRequest, opts ...grpc.CallOption) (*DeleteTeacherResponse, error)
+	ListTeachers(ctx context.Context, in *ListTeachersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Teacher], error)
+}
+
+type teacherServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTeacherServiceClient(cc grpc.ClientConnInterface) TeacherServiceClient {
+	return &teacherServiceClient{cc}
+}
+
+func (c *teacherServiceClient) CreateTeacher(ctx context.Context, in *CreateTeacherRequest, opts ...grpc.CallOption) (*Teacher, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Teacher)
+	err := c.cc.Invoke(ctx, TeacherService_CreateTeacher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teacherServiceClient) GetTeacher(ctx context.Context, in *GetTeacherRequest, opts ...grpc.CallOption) (*Teacher, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Teacher)
+	err := c.cc.Invoke(ctx, TeacherService_GetTeacher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teacherServiceClient) UpdateTeacher(ctx context.Context, in *UpdateTeacherRequest, opts ...grpc.CallOption) (*Teacher, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Teacher)
+	err := c.cc.Invoke(ctx, TeacherService_UpdateTeacher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teacherServiceClient) DeleteTeacher(ctx context.Context, in *DeleteTeacherRequest, opts ...grpc.CallOption) (*DeleteTeacherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeacherResponse)
+	err := c.cc.Invoke(ctx, TeacherService_DeleteTeacher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teacherServiceClient) ListTeachers(ctx context.Context, in *ListTeachersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Teacher], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TeacherService_ServiceDesc.Streams[0], TeacherService_ListTeachers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListTeachersRequest, Teacher]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeacherService_ListTeachersClient = grpc.ServerStreamingClient[Teacher]
+
+// TeacherServiceServer is the server API for TeacherService service.
+// All implementations must embed UnimplementedTeacherServiceServer
+// for forward compatibility.
+//
+// TeacherService 同时通过 gRPC 和 grpc-gateway 的 HTTP/JSON 对外提供服务，
+// HTTP 路由定义在 user_gateway.yaml 中
+type TeacherServiceServer interface {
+	CreateTeacher(context.Context, *CreateTeacherRequest) (*Teacher, error)
+	GetTeacher(context.Context, *GetTeacherRequest) (*Teacher, error)
+	UpdateTeacher(context.Context, *UpdateTeacherRequest) (*Teacher, error)
+	DeleteTeacher(context.Context, *DeleteTeacherRequest) (*DeleteTeacherResponse, error)
+	ListTeachers(*ListTeachersRequest, grpc.ServerStreamingServer[Teacher]) error
+	mustEmbedUnimplementedTeacherServiceServer()
+}
+
+// UnimplementedTeacherServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTeacherServiceServer struct{}
+
+func (UnimplementedTeacherServiceServer) CreateTeacher(context.Context, *CreateTeacherRequest) (*Teacher, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeacher not implemented")
+}
+func (UnimplementedTeacherServiceServer) GetTeacher(context.Context, *GetTeacherRequest) (*Teacher, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeacher not implemented")
+}
+func (UnimplementedTeacherServiceServer) UpdateTeacher(context.Context, *UpdateTeacherRequest) (*Teacher, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeacher not implemented")
+}
+func (UnimplementedTeacherServiceServer) DeleteTeacher(context.Context, *DeleteTeacherRequest) (*DeleteTeacherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeacher not implemented")
+}
+func (UnimplementedTeacherServiceServer) ListTeachers(*ListTeachersRequest, grpc.ServerStreamingServer[Teacher]) error {
+	return status.Errorf(codes.Unimplemented, "method ListTeachers not implemented")
+}
+func (UnimplementedTeacherServiceServer) mustEmbedUnimplementedTeacherServiceServer() {}
+func (UnimplementedTeacherServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeTeacherServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TeacherServiceServer will
+// result in compilation errors.
+type UnsafeTeacherServiceServer interface {
+	mustEmbedUnimplementedTeacherServiceServer()
+}
+
+func RegisterTeacherServiceServer(s grpc.ServiceRegistrar, srv TeacherServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTeacherServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TeacherService_ServiceDesc, srv)
+}
+
+func _TeacherService_CreateTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeacherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherServiceServer).CreateTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherService_CreateTeacher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherServiceServer).CreateTeacher(ctx, req.(*CreateTeacherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeacherService_GetTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeacherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherServiceServer).GetTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherService_GetTeacher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherServiceServer).GetTeacher(ctx, req.(*GetTeacherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeacherService_UpdateTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeacherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherServiceServer).UpdateTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherService_UpdateTeacher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherServiceServer).UpdateTeacher(ctx, req.(*UpdateTeacherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeacherService_DeleteTeacher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeacherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherServiceServer).DeleteTeacher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherService_DeleteTeacher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherServiceServer).DeleteTeacher(ctx, req.(*DeleteTeacherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeacherService_ListTeachers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTeachersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TeacherServiceServer).ListTeachers(m, &grpc.GenericServerStream[ListTeachersRequest, Teacher]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TeacherService_ListTeachersServer = grpc.ServerStreamingServer[Teacher]
+
+// TeacherService_ServiceDesc is the grpc.ServiceDesc for TeacherService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TeacherService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "TeacherService",
+	HandlerType: (*TeacherServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTeacher",
+			Handler:    _TeacherService_CreateTeacher_Handler,
+		},
+		{
+			MethodName: "GetTeacher",
+			Handler:    _TeacherService_GetTeacher_Handler,
+		},
+		{
+			MethodName: "UpdateTeacher",
+			Handler:    _TeacherService_UpdateTeacher_Handler,
+		},
+		{
+			MethodName: "DeleteTeacher",
+			Handler:    _TeacherService_DeleteTeacher_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListTeachers",
+			Handler:       _TeacherService_ListTeachers_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "user.proto",
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config 是 HTTP 服务的启动配置，优先级：命令行参数 > 环境变量 > 配置文件 > 默认值
+type Config struct {
+	Addr            string        `yaml:"addr"`
+	ReadTimeout     time.Duration `yaml:"read_timeout"`
+	WriteTimeout    time.Duration `yaml:"write_timeout"`
+	IdleTimeout     time.Duration `yaml:"idle_timeout"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// DrainDelay 是收到退出信号后 /readyz 返回 503、但仍正常处理请求的时间，
+	// 应该大于负载均衡探测间隔 × 失败阈值，让上游先摘掉这个实例
+	DrainDelay time.Duration `yaml:"drain_delay"`
+	// EthRPC 不为空时 /readyz 会检查以太坊节点是否可用
+	EthRPC   string `yaml:"eth_rpc"`
+	LogLevel string `yaml:"log_level"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Addr:            ":8083",
+		ReadTimeout:     10 * time.Second,
+		WriteTimeout:    15 * time.Second,
+		IdleTimeout:     60 * time.Second,
+		ShutdownTimeout: 20 * time.Second,
+		DrainDelay:      5 * time.Second,
+		LogLevel:        "info",
+	}
+}
+
+// envPrefix 环境变量前缀，例如 APP_ADDR=:9090
+const envPrefix = "APP_"
+
+// LoadConfig 解析命令行参数（一般传 os.Args[1:]），-config 指定 yaml 配置文件
+func LoadConfig(args []string) (Config, error) {
+	cfg := DefaultConfig()
+
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	file := fs.String("config", "", "yaml config file")
+	flags := Config{}
+	fs.StringVar(&flags.Addr, "addr", cfg.Addr, "listen address")
+	fs.DurationVar(&flags.ReadTimeout, "read-timeout", cfg.ReadTimeout, "http read timeout")
+	fs.DurationVar(&flags.WriteTimeout, "write-timeout", cfg.WriteTimeout, "http write timeout")
+	fs.DurationVar(&flags.IdleTimeout, "idle-timeout", cfg.IdleTimeout, "http idle timeout")
+	fs.DurationVar(&flags.ShutdownTimeout, "shutdown-timeout", cfg.ShutdownTimeout, "graceful shutdown timeout")
+	fs.DurationVar(&flags.DrainDelay, "drain-delay", cfg.DrainDelay, "time /readyz reports draining before shutdown starts")
+	fs.StringVar(&flags.EthRPC, "eth-rpc", cfg.EthRPC, "ethereum node rpc url checked by /readyz")
+	fs.StringVar(&flags.LogLevel, "log-level", cfg.LogLevel, "debug, info, warn or error")
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
+	}
+
+	if *file != "" {
+		data, err := os.ReadFile(*file)
+		if err != nil {
+			return cfg, err
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return cfg, fmt.Errorf("parse %s: %w", *file, err)
+		}
+	}
+
+	if err := applyEnv(&cfg); err != nil {
+		return cfg, err
+	}
+
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		if err == nil && f.Name != "config" {
+			err = setField(&cfg, f.Name, f.Value.String())
+		}
+	})
+	return cfg, err
+}
+
+var envNames = map[string]string{
+	"addr":             envPrefix + "ADDR",
+	"read-timeout":     envPrefix + "READ_TIMEOUT",
+	"write-timeout":    envPrefix + "WRITE_TIMEOUT",
+	"idle-timeout":     envPrefix + "IDLE_TIMEOUT",
+	"shutdown-timeout": envPrefix + "SHUTDOWN_TIMEOUT",
+	"drain-delay":      envPrefix + "DRAIN_DELAY",
+	"eth-rpc":          envPrefix + "ETH_RPC",
+	"log-level":        envPrefix + "LOG_LEVEL",
+}
+
+func applyEnv(cfg *Config) error {
+	for name, env := range envNames {
+		if val, ok := os.LookupEnv(env); ok {
+			if err := setField(cfg, name, val); err != nil {
+				return fmt.Errorf("%s: %w", env, err)
+			}
+		}
+	}
+	return nil
+}
+
+func setField(cfg *Config, name, val string) error {
+	var err error
+	switch name {
+	case "addr":
+		cfg.Addr = val
+	case "read-timeout":
+		cfg.ReadTimeout, err = time.ParseDuration(val)
+	case "write-timeout":
+		cfg.WriteTimeout, err = time.ParseDuration(val)
+	case "idle-timeout":
+		cfg.IdleTimeout, err = time.ParseDuration(val)
+	case "shutdown-timeout":
+		cfg.ShutdownTimeout, err = time.ParseDuration(val)
+	case "drain-delay":
+		cfg.DrainDelay, err = time.ParseDuration(val)
+	case "eth-rpc":
+		cfg.EthRPC = val
+	case "log-level":
+		cfg.LogLevel = val
+	default:
+		err = fmt.Errorf("unknown config %q", name)
+	}
+	return err
+}
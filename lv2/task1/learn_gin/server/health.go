@@ -0,0 +1,110 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Check 是一项就绪检查，返回 nil 表示依赖可用
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+const checkTimeout = 3 * time.Second
+
+// AddReadiness 注册一项 /readyz 检查
+func (s *Server) AddReadiness(name string, check Check) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checks = append(s.checks, namedCheck{name: name, check: check})
+}
+
+// healthz 只表示进程存活
+func (s *Server) healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status": "ok",
+	})
+}
+
+// readyz 逐项执行就绪检查，任意一项失败或正在关闭时返回 503
+func (s *Server) readyz(c *gin.Context) {
+	if s.draining.Load() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status": "draining",
+		})
+		return
+	}
+
+	s.mu.Lock()
+	checks := s.checks
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), checkTimeout)
+	defer cancel()
+	code := http.StatusOK
+	results := gin.H{}
+	for _, nc := range checks {
+		if err := nc.check(ctx); err != nil {
+			code = http.StatusServiceUnavailable
+			results[nc.name] = err.Error()
+			continue
+		}
+		results[nc.name] = "ok"
+	}
+
+	status := "ok"
+	if code != http.StatusOK {
+		status = "unavailable"
+	}
+	c.JSON(code, gin.H{
+		"status": status,
+		"checks": results,
+	})
+}
+
+// EthNodeCheck 通过 JSON-RPC 调用 eth_blockNumber 检查以太坊节点是否可用
+func EthNodeCheck(rpcURL string) Check {
+	client := &http.Client{Timeout: checkTimeout}
+	return func(ctx context.Context) error {
+		body := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcURL, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("rpc status %d", resp.StatusCode)
+		}
+
+		var rsp struct {
+			Result string `json:"result"`
+			Error  *struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&rsp); err != nil {
+			return err
+		}
+		if rsp.Error != nil {
+			return fmt.Errorf("rpc error: %s", rsp.Error.Message)
+		}
+		if rsp.Result == "" {
+			return fmt.Errorf("empty eth_blockNumber result")
+		}
+		return nil
+	}
+}
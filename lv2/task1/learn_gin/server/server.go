@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Server 封装 gin 路由和 http.Server：带超时配置、健康检查、结构化请求日志，
+// 收到 SIGINT/SIGTERM 后停止接收新请求并等待已有请求处理完毕
+type Server struct {
+	cfg    Config
+	logger *slog.Logger
+	engine *gin.Engine
+	http   *http.Server
+
+	mu         sync.Mutex
+	checks     []namedCheck
+	onShutdown []func(context.Context) error
+	draining   atomic.Bool
+}
+
+// New 创建服务并注册 /healthz、/readyz。middleware 在这两个路由之前装上，
+// gin 只对之后注册的路由生效，所以 tracing、metrics 这类要覆盖所有路由的中间件要从这里传入
+func New(cfg Config, middleware ...gin.HandlerFunc) *Server {
+	logger := NewLogger(cfg.LogLevel)
+
+	engine := gin.New()
+	engine.Use(RequestLogger(logger), gin.Recovery())
+	engine.Use(middleware...)
+
+	s := &Server{
+		cfg:    cfg,
+		logger: logger,
+		engine: engine,
+	}
+	engine.GET("/healthz", s.healthz)
+	engine.GET("/readyz", s.readyz)
+	if cfg.EthRPC != "" {
+		s.AddReadiness("eth_node", EthNodeCheck(cfg.EthRPC))
+	}
+
+	s.http = &http.Server{
+		Addr:         cfg.Addr,
+		Handler:      engine,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+	return s
+}
+
+// Router 返回用于注册业务路由的 gin.Engine
+func (s *Server) Router() *gin.Engine {
+	return s.engine
+}
+
+func (s *Server) Logger() *slog.Logger {
+	return s.logger
+}
+
+// OnShutdown 注册在 HTTP 服务停止后执行的清理函数，例如关闭 gRPC 服务、数据库连接
+func (s *Server) OnShutdown(fn func(ctx context.Context) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onShutdown = append(s.onShutdown, fn)
+}
+
+// Run 启动服务并阻塞，直到收到退出信号并完成优雅关闭
+func (s *Server) Run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return s.RunContext(ctx)
+}
+
+// RunContext 与 Run 相同，ctx 结束时开始优雅关闭
+func (s *Server) RunContext(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		s.logger.Info("http server listening", "addr", s.cfg.Addr)
+		if err := s.http.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	// 先让 /readyz 失败，等 DrainDelay 让负载均衡摘掉流量，期间新请求照常处理，之后再关闭
+	s.draining.Store(true)
+	if s.cfg.DrainDelay > 0 {
+		s.logger.Info("draining", "delay", s.cfg.DrainDelay.String())
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(s.cfg.DrainDelay):
+		}
+	}
+	s.logger.Info("shutting down", "timeout", s.cfg.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+	defer cancel()
+
+	err := s.http.Shutdown(shutdownCtx)
+	s.mu.Lock()
+	hooks := s.onShutdown
+	s.mu.Unlock()
+	for _, fn := range hooks {
+		err = errors.Join(err, fn(shutdownCtx))
+	}
+	if err != nil {
+		s.logger.Error("shutdown failed", "err", err)
+		return err
+	}
+	s.logger.Info("server stopped")
+	return nil
+}
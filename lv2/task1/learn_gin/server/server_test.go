@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// freeAddr 找一个空闲端口，RunContext 自己监听所以只能先占再放
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	return lis.Addr().String()
+}
+
+func get(t *testing.T, url string) int {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestDrainDelay(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := DefaultConfig()
+	cfg.Addr = freeAddr(t)
+	cfg.DrainDelay = 300 * time.Millisecond
+	cfg.LogLevel = "error"
+	s := New(cfg)
+	s.Router().GET("/ping", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"msg": "pong"}) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.RunContext(ctx) }()
+
+	base := "http://" + cfg.Addr
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if resp, err := http.Get(base + "/readyz"); err == nil {
+			resp.Body.Close()
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("server did not start")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if code := get(t, base+"/readyz"); code != http.StatusOK {
+		t.Fatalf("readyz before shutdown = %d", code)
+	}
+
+	cancel()
+	for !s.draining.Load() {
+		time.Sleep(time.Millisecond)
+	}
+	// 摘流量期间 /readyz 失败，但业务请求仍然正常处理
+	if code := get(t, base+"/readyz"); code != http.StatusServiceUnavailable {
+		t.Fatalf("readyz while draining = %d", code)
+	}
+	if code := get(t, base+"/ping"); code != http.StatusOK {
+		t.Fatalf("ping while draining = %d", code)
+	}
+	select {
+	case err := <-done:
+		t.Fatalf("stopped before drain delay: %v", err)
+	default:
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("server did not stop")
+	}
+	if _, err := http.Get(base + "/ping"); err == nil {
+		t.Fatal("server still accepting after shutdown")
+	}
+}
+
+func TestLoadConfigDrainDelay(t *testing.T) {
+	tests := []struct {
+		name string
+		env  string
+		args []string
+		want time.Duration
+	}{
+		{"default", "", nil, 5 * time.Second},
+		{"env", "1s", nil, time.Second},
+		{"flag over env", "1s", []string{"-drain-delay", "0s"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.env != "" {
+				t.Setenv("APP_DRAIN_DELAY", tt.env)
+			}
+			cfg, err := LoadConfig(tt.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.DrainDelay != tt.want {
+				t.Fatalf("DrainDelay = %s, want %s", cfg.DrainDelay, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package teacher
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"untitled/proto"
+)
+
+// MountGateway 把 grpc-gateway 挂到 gin 路由上，HTTP/JSON 请求经 conn 转发给 gRPC 服务。
+// conn 可以是真实端口，也可以是 bufconn 之类的进程内连接
+func MountGateway(ctx context.Context, r gin.IRouter, conn *grpc.ClientConn) error {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+	)
+	if err := proto.RegisterTeacherServiceHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+
+	h := gin.WrapH(mux)
+	r.Any("/v1/teachers", h)
+	r.Any("/v1/teachers/:id", h)
+	return nil
+}
@@ -0,0 +1,123 @@
+package teacher
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"untitled/proto"
+)
+
+// Server 是 TeacherService 的内存实现，gRPC 和 grpc-gateway 共用同一个实例
+type Server struct {
+	proto.UnimplementedTeacherServiceServer
+
+	mu       sync.RWMutex
+	nextID   int64
+	teachers map[int64]*proto.Teacher
+}
+
+func NewServer() *Server {
+	return &Server{
+		nextID:   1,
+		teachers: map[int64]*proto.Teacher{},
+	}
+}
+
+func (s *Server) CreateTeacher(ctx context.Context, req *proto.CreateTeacherRequest) (*proto.Teacher, error) {
+	t := req.GetTeacher()
+	if err := checkTeacher(t); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved := &proto.Teacher{
+		Id:     s.nextID,
+		Name:   t.GetName(),
+		Course: slices.Clone(t.GetCourse()),
+	}
+	s.teachers[saved.Id] = saved
+	s.nextID++
+	return clone(saved), nil
+}
+
+func (s *Server) GetTeacher(ctx context.Context, req *proto.GetTeacherRequest) (*proto.Teacher, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	t, ok := s.teachers[req.GetId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "teacher %d not found", req.GetId())
+	}
+	return clone(t), nil
+}
+
+func (s *Server) UpdateTeacher(ctx context.Context, req *proto.UpdateTeacherRequest) (*proto.Teacher, error) {
+	t := req.GetTeacher()
+	if err := checkTeacher(t); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	saved, ok := s.teachers[t.GetId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "teacher %d not found", t.GetId())
+	}
+	saved.Name = t.GetName()
+	saved.Course = slices.Clone(t.GetCourse())
+	return clone(saved), nil
+}
+
+func (s *Server) DeleteTeacher(ctx context.Context, req *proto.DeleteTeacherRequest) (*proto.DeleteTeacherResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.teachers[req.GetId()]; !ok {
+		return nil, status.Errorf(codes.NotFound, "teacher %d not found", req.GetId())
+	}
+	delete(s.teachers, req.GetId())
+	return &proto.DeleteTeacherResponse{Id: req.GetId()}, nil
+}
+
+// ListTeachers 按 id 顺序逐条推送，先在锁内拍快照，避免发送过程中持有锁
+func (s *Server) ListTeachers(req *proto.ListTeachersRequest, stream grpc.ServerStreamingServer[proto.Teacher]) error {
+	s.mu.RLock()
+	list := make([]*proto.Teacher, 0, len(s.teachers))
+	for _, t := range s.teachers {
+		if req.GetCourse() == "" || slices.Contains(t.GetCourse(), req.GetCourse()) {
+			list = append(list, clone(t))
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Id < list[j].Id })
+	for _, t := range list {
+		if err := stream.Send(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkTeacher(t *proto.Teacher) error {
+	if t == nil {
+		return status.Error(codes.InvalidArgument, "teacher is required")
+	}
+	if strings.TrimSpace(t.GetName()) == "" {
+		return status.Error(codes.InvalidArgument, "teacher name is required")
+	}
+	return nil
+}
+
+func clone(t *proto.Teacher) *proto.Teacher {
+	return &proto.Teacher{
+		Id:     t.GetId(),
+		Name:   t.GetName(),
+		Course: slices.Clone(t.GetCourse()),
+	}
+}
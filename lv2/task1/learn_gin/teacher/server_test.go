@@ -0,0 +1,225 @@
+package teacher
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"untitled/proto"
+)
+
+// dial 在 bufconn 上启动 gRPC 服务，返回连接，测试结束时关闭
+func dial(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	proto.RegisterTeacherServiceServer(srv, NewServer())
+	go srv.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		srv.Stop()
+	})
+	return conn
+}
+
+func TestGRPC(t *testing.T) {
+	ctx := context.Background()
+	client := proto.NewTeacherServiceClient(dial(t))
+
+	bobby, err := client.CreateTeacher(ctx, &proto.CreateTeacherRequest{Teacher: &proto.Teacher{Name: "bobby", Course: []string{"go", "k8s"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice, err := client.CreateTeacher(ctx, &proto.CreateTeacherRequest{Teacher: &proto.Teacher{Name: "alice", Course: []string{"rust"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bobby.Id != 1 || alice.Id != 2 {
+		t.Fatalf("ids = %d, %d, want 1, 2", bobby.Id, alice.Id)
+	}
+
+	got, err := client.GetTeacher(ctx, &proto.GetTeacherRequest{Id: bobby.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "bobby" || strings.Join(got.Course, ",") != "go,k8s" {
+		t.Fatalf("get = %v", got)
+	}
+
+	updated, err := client.UpdateTeacher(ctx, &proto.UpdateTeacherRequest{Teacher: &proto.Teacher{Id: bobby.Id, Name: "bob", Course: []string{"go"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "bob" || len(updated.Course) != 1 {
+		t.Fatalf("update = %v", updated)
+	}
+
+	list := func(course string) []string {
+		stream, err := client.ListTeachers(ctx, &proto.ListTeachersRequest{Course: course})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for {
+			item, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return names
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			names = append(names, item.Name)
+		}
+	}
+	if got := strings.Join(list(""), ","); got != "bob,alice" {
+		t.Fatalf("list = %s", got)
+	}
+	if got := strings.Join(list("rust"), ","); got != "alice" {
+		t.Fatalf("list rust = %s", got)
+	}
+
+	if _, err := client.DeleteTeacher(ctx, &proto.DeleteTeacherRequest{Id: alice.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(list(""), ","); got != "bob" {
+		t.Fatalf("list after delete = %s", got)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{"get missing", func() error {
+			_, err := client.GetTeacher(ctx, &proto.GetTeacherRequest{Id: alice.Id})
+			return err
+		}, codes.NotFound},
+		{"delete missing", func() error {
+			_, err := client.DeleteTeacher(ctx, &proto.DeleteTeacherRequest{Id: 99})
+			return err
+		}, codes.NotFound},
+		{"update missing", func() error {
+			_, err := client.UpdateTeacher(ctx, &proto.UpdateTeacherRequest{Teacher: &proto.Teacher{Id: 99, Name: "x"}})
+			return err
+		}, codes.NotFound},
+		{"create without teacher", func() error {
+			_, err := client.CreateTeacher(ctx, &proto.CreateTeacherRequest{})
+			return err
+		}, codes.InvalidArgument},
+		{"create blank name", func() error {
+			_, err := client.CreateTeacher(ctx, &proto.CreateTeacherRequest{Teacher: &proto.Teacher{Name: "  "}})
+			return err
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(tt.call()); code != tt.code {
+				t.Fatalf("code = %s, want %s", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestGateway(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	if err := MountGateway(context.Background(), r, dial(t)); err != nil {
+		t.Fatal(err)
+	}
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	decode := func(w *httptest.ResponseRecorder) map[string]any {
+		var m map[string]any
+		if err := json.Unmarshal(w.Body.Bytes(), &m); err != nil {
+			t.Fatalf("%s: %v", w.Body, err)
+		}
+		return m
+	}
+
+	w := do(http.MethodPost, "/v1/teachers", `{"name":"bobby","course":["go"]}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("create: %d %s", w.Code, w.Body)
+	}
+	// int64 在 protojson 里编码成字符串
+	if m := decode(w); m["id"] != "1" || m["name"] != "bobby" {
+		t.Fatalf("create = %v", m)
+	}
+	do(http.MethodPost, "/v1/teachers", `{"name":"alice","course":["rust"]}`)
+
+	w = do(http.MethodPut, "/v1/teachers/1", `{"name":"bob","course":["go","grpc"]}`)
+	if m := decode(w); w.Code != http.StatusOK || m["name"] != "bob" || m["id"] != "1" {
+		t.Fatalf("update: %d %v", w.Code, m)
+	}
+	w = do(http.MethodGet, "/v1/teachers/1", "")
+	if m := decode(w); w.Code != http.StatusOK || len(m["course"].([]any)) != 2 {
+		t.Fatalf("get: %d %v", w.Code, m)
+	}
+
+	// 服务端流在网关上是一行一个 {"result": ...}
+	w = do(http.MethodGet, "/v1/teachers?course=rust", "")
+	var names []string
+	sc := bufio.NewScanner(w.Body)
+	for sc.Scan() {
+		var line struct {
+			Result struct {
+				Name string `json:"name"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal(sc.Bytes(), &line); err != nil {
+			t.Fatalf("%s: %v", sc.Text(), err)
+		}
+		names = append(names, line.Result.Name)
+	}
+	if strings.Join(names, ",") != "alice" {
+		t.Fatalf("list = %v", names)
+	}
+
+	if w = do(http.MethodDelete, "/v1/teachers/2", ""); w.Code != http.StatusOK {
+		t.Fatalf("delete: %d %s", w.Code, w.Body)
+	}
+
+	tests := []struct {
+		name         string
+		method, path string
+		body         string
+		code         int
+	}{
+		{"get deleted", http.MethodGet, "/v1/teachers/2", "", http.StatusNotFound},
+		{"delete missing", http.MethodDelete, "/v1/teachers/99", "", http.StatusNotFound},
+		{"blank name", http.MethodPost, "/v1/teachers", `{"name":""}`, http.StatusBadRequest},
+		{"bad id", http.MethodGet, "/v1/teachers/abc", "", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := do(tt.method, tt.path, tt.body); w.Code != tt.code {
+				t.Fatalf("code = %d, want %d: %s", w.Code, tt.code, w.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package telemetry
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics 持有 HTTP 和以太坊 RPC 的 Prometheus 指标，每个实例使用独立的 Registry
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpDuration *prometheus.HistogramVec
+	rpcDuration  *prometheus.HistogramVec
+	rpcErrors    *prometheus.CounterVec
+}
+
+func NewMetrics() *Metrics {
+	reg := prometheus.NewRegistry()
+	m := &Metrics{
+		registry: reg,
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request latency by route template.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "eth_rpc_duration_seconds",
+			Help:    "Ethereum JSON-RPC call latency by method.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method"}),
+		rpcErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "eth_rpc_errors_total",
+			Help: "Ethereum JSON-RPC call failures by method and kind (transport or rpc).",
+		}, []string{"method", "kind"}),
+	}
+	reg.MustRegister(
+		m.httpDuration, m.rpcDuration, m.rpcErrors,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// Registry 用于注册业务自己的指标
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// Middleware 按路由模板（/goods/:id 而不是 /goods/1）记录请求耗时和状态码，
+// 未匹配的路由统一记为 unmatched，避免标签数量失控
+func (m *Metrics) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		m.httpDuration.
+			WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
+
+// Handler 是 /metrics 的处理函数
+func (m *Metrics) Handler() gin.HandlerFunc {
+	h := promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+	return gin.WrapH(h)
+}
+
+func (m *Metrics) observeRPC(method string, d time.Duration) {
+	m.rpcDuration.WithLabelValues(method).Observe(d.Seconds())
+}
+
+func (m *Metrics) rpcError(method, kind string) {
+	m.rpcErrors.WithLabelValues(method, kind).Inc()
+}
@@ -0,0 +1,179 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Transport 是给以太坊 JSON-RPC（HTTP）用的 http.RoundTripper，
+// 按 method 记录耗时和错误数，并为每次调用创建子 span、向节点传递 traceparent。
+// Metrics 和 Tracing 都可以为 nil
+type Transport struct {
+	Base    http.RoundTripper
+	Metrics *Metrics
+	Tracing *Tracing
+}
+
+var _ http.RoundTripper = (*Transport)(nil)
+
+type rpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	calls := parseMessages(body)
+	methods := make([]string, 0, len(calls))
+	byID := map[string]string{}
+	for _, call := range calls {
+		methods = append(methods, call.Method)
+		byID[string(call.ID)] = call.Method
+	}
+	if len(methods) == 0 {
+		methods = append(methods, "unknown")
+	}
+	name := methods[0]
+	if len(methods) > 1 {
+		name = "batch"
+	}
+
+	ctx := req.Context()
+	var span trace.Span
+	if t.Tracing != nil {
+		ctx, span = t.Tracing.tracer.Start(ctx, "eth_rpc "+name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("rpc.system", "jsonrpc"),
+				attribute.StringSlice("rpc.method", methods),
+				attribute.String("server.address", req.URL.Host),
+			),
+		)
+		defer span.End()
+	}
+
+	out := req.Clone(ctx)
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	if t.Tracing != nil {
+		t.Tracing.propagator.Inject(ctx, propagation.HeaderCarrier(out.Header))
+	}
+
+	start := time.Now()
+	resp, err := t.base().RoundTrip(out)
+	elapsed := time.Since(start)
+	for _, m := range methods {
+		t.observe(m, elapsed)
+	}
+	if err != nil {
+		t.fail(span, methods, "transport", err)
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.fail(span, methods, "http", fmt.Errorf("http status %d", resp.StatusCode))
+		return resp, nil
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.fail(span, methods, "transport", err)
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	// 批量响应的顺序不一定与请求一致，按 id 对应回 method
+	for _, msg := range parseMessages(respBody) {
+		if len(msg.Error) == 0 || string(msg.Error) == "null" {
+			continue
+		}
+		method, ok := byID[string(msg.ID)]
+		if !ok {
+			method = "unknown"
+		}
+		t.fail(span, []string{method}, "rpc", fmt.Errorf("%s: %s", method, msg.Error))
+	}
+	return resp, nil
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+func (t *Transport) observe(method string, d time.Duration) {
+	if t.Metrics != nil {
+		t.Metrics.observeRPC(method, d)
+	}
+}
+
+func (t *Transport) fail(span trace.Span, methods []string, kind string, err error) {
+	if t.Metrics != nil {
+		for _, m := range methods {
+			t.Metrics.rpcError(m, kind)
+		}
+	}
+	if span != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return io.ReadAll(req.Body)
+}
+
+// parseMessages 解析单个或批量的 JSON-RPC 请求/响应，解析失败时返回 nil
+func parseMessages(body []byte) []rpcMessage {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var batch []rpcMessage
+		if err := json.Unmarshal(body, &batch); err != nil {
+			return nil
+		}
+		for i := range batch {
+			if batch[i].Method == "" {
+				batch[i].Method = "unknown"
+			}
+		}
+		return batch
+	}
+
+	var msg rpcMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil
+	}
+	if msg.Method == "" {
+		msg.Method = "unknown"
+	}
+	return []rpcMessage{msg}
+}
+
+// DialEth 用带埋点的 Transport 连接 HTTP(S) 节点；ws/ipc 连接不经过 http.Client，不会被记录
+func DialEth(ctx context.Context, url string, t *Transport) (*ethclient.Client, error) {
+	c, err := rpc.DialOptions(ctx, url, rpc.WithHTTPClient(&http.Client{Transport: t}))
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(c), nil
+}
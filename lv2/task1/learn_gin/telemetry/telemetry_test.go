@@ -0,0 +1,212 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"untitled/server"
+)
+
+// fakeNode 是只认 eth_blockNumber 的 JSON-RPC 节点，记下收到的 traceparent
+func fakeNode(t *testing.T) (*httptest.Server, *[]string) {
+	var parents []string
+	node := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parents = append(parents, r.Header.Get("traceparent"))
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &req); err != nil {
+			t.Errorf("node: %s: %v", body, err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if req.Method != "eth_blockNumber" {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"error":{"code":-32601,"message":"method not found"}}`))
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"0x10"}`))
+	}))
+	t.Cleanup(node.Close)
+	return node, &parents
+}
+
+func scrape(t *testing.T, r http.Handler) string {
+	t.Helper()
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("metrics: %d", w.Code)
+	}
+	return w.Body.String()
+}
+
+func TestServerRoutesInstrumented(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	exporter := tracetest.NewInMemoryExporter()
+	tracing := NewTracing(exporter)
+	metrics := NewMetrics()
+
+	cfg := server.DefaultConfig()
+	cfg.LogLevel = "error"
+	srv := server.New(cfg, tracing.Middleware(), metrics.Middleware())
+	router := srv.Router()
+	router.GET("/metrics", metrics.Handler())
+	router.GET("/goods/:id", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"msg": "ok"}) })
+
+	for _, path := range []string{"/healthz", "/readyz", "/goods/1", "/goods/2", "/missing"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	body := scrape(t, router)
+	for _, want := range []string{
+		`http_request_duration_seconds_count{method="GET",route="/healthz",status="200"} 1`,
+		`http_request_duration_seconds_count{method="GET",route="/readyz",status="200"} 1`,
+		`http_request_duration_seconds_count{method="GET",route="/goods/:id",status="200"} 2`,
+		`http_request_duration_seconds_count{method="GET",route="unmatched",status="404"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics missing %s", want)
+		}
+	}
+
+	var names []string
+	for _, span := range exporter.GetSpans() {
+		names = append(names, span.Name)
+	}
+	if got := strings.Join(names, ","); got != "GET /healthz,GET /readyz,GET /goods/:id,GET /goods/:id,GET unmatched,GET /metrics" {
+		t.Fatalf("spans = %s", got)
+	}
+}
+
+func TestRPCTrace(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	exporter := tracetest.NewInMemoryExporter()
+	tracing := NewTracing(exporter)
+	metrics := NewMetrics()
+	node, parents := fakeNode(t)
+
+	client, err := DialEth(context.Background(), node.URL, &Transport{Metrics: metrics, Tracing: tracing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	router := gin.New()
+	router.Use(tracing.Middleware(), metrics.Middleware())
+	router.GET("/metrics", metrics.Handler())
+	router.GET("/block", func(c *gin.Context) {
+		n, err := client.BlockNumber(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"number": n})
+	})
+	router.GET("/chain", func(c *gin.Context) {
+		if _, err := client.ChainID(c.Request.Context()); err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"msg": "ok"})
+	})
+
+	// 上游带了 traceparent，HTTP span 和 RPC span 都要挂在同一个 trace 下
+	const upstream = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	req := httptest.NewRequest(http.MethodGet, "/block", nil)
+	req.Header.Set("traceparent", upstream)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"number":16`) {
+		t.Fatalf("block: %d %s", w.Code, w.Body)
+	}
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/chain", nil))
+	if w.Code != http.StatusBadGateway {
+		t.Fatalf("chain: %d %s", w.Code, w.Body)
+	}
+
+	spans := exporter.GetSpans()
+	byName := map[string]tracetest.SpanStub{}
+	for _, span := range spans {
+		byName[span.Name] = span
+	}
+	httpSpan, rpcSpan := byName["GET /block"], byName["eth_rpc eth_blockNumber"]
+	if httpSpan.Name == "" || rpcSpan.Name == "" {
+		t.Fatalf("spans = %v", spans)
+	}
+	traceID := httpSpan.SpanContext.TraceID().String()
+	if traceID != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Fatalf("trace id = %s", traceID)
+	}
+	if rpcSpan.Parent.SpanID() != httpSpan.SpanContext.SpanID() || rpcSpan.SpanKind != trace.SpanKindClient {
+		t.Fatalf("rpc span parent = %s, kind = %s", rpcSpan.Parent.SpanID(), rpcSpan.SpanKind)
+	}
+	// 节点收到的 traceparent 指向 RPC span
+	if want := "00-" + traceID + "-" + rpcSpan.SpanContext.SpanID().String() + "-01"; (*parents)[0] != want {
+		t.Fatalf("traceparent = %s, want %s", (*parents)[0], want)
+	}
+	if failed := byName["eth_rpc eth_chainId"]; failed.Status.Code.String() != "Error" || len(failed.Events) == 0 {
+		t.Fatalf("chainId span status = %v, events = %d", failed.Status, len(failed.Events))
+	}
+	if !hasAttr(rpcSpan.Attributes, "server.address", strings.TrimPrefix(node.URL, "http://")) {
+		t.Fatalf("rpc attrs = %v", rpcSpan.Attributes)
+	}
+
+	body := scrape(t, router)
+	for _, want := range []string{
+		`eth_rpc_duration_seconds_count{method="eth_blockNumber"} 1`,
+		`eth_rpc_duration_seconds_count{method="eth_chainId"} 1`,
+		`eth_rpc_errors_total{kind="rpc",method="eth_chainId"} 1`,
+		`http_request_duration_seconds_count{method="GET",route="/chain",status="502"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics missing %s", want)
+		}
+	}
+	if strings.Contains(body, `eth_rpc_errors_total{kind="rpc",method="eth_blockNumber"}`) {
+		t.Error("eth_blockNumber counted as error")
+	}
+}
+
+func TestParseMessages(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{"single", `{"jsonrpc":"2.0","id":1,"method":"eth_call"}`, []string{"eth_call"}},
+		{"batch", ` [{"id":1,"method":"eth_call"},{"id":2,"method":"eth_getBalance"}]`, []string{"eth_call", "eth_getBalance"}},
+		{"response", `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, []string{"unknown"}},
+		{"garbage", `not json`, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, msg := range parseMessages([]byte(tt.body)) {
+				got = append(got, msg.Method)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") || len(got) != len(tt.want) {
+				t.Fatalf("methods = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func hasAttr(attrs []attribute.KeyValue, key, val string) bool {
+	for _, kv := range attrs {
+		if string(kv.Key) == key && kv.Value.Emit() == val {
+			return true
+		}
+	}
+	return false
+}
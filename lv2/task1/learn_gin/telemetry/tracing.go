@@ -0,0 +1,76 @@
+package telemetry
+
+import (
+	"context"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentation = "untitled/telemetry"
+
+// Tracing 持有 TracerProvider 和传播器，不修改 otel 的全局设置，便于在测试里并存多个实例
+type Tracing struct {
+	provider   *sdktrace.TracerProvider
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// NewTracing 用给定的 exporter 创建 Tracing，测试里可以传 tracetest.NewInMemoryExporter()
+func NewTracing(exporter sdktrace.SpanExporter) *Tracing {
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	return &Tracing{
+		provider:   provider,
+		tracer:     provider.Tracer(instrumentation),
+		propagator: propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}),
+	}
+}
+
+// NewStdoutTracing 把 span 以 JSON 输出到 w，适合本地调试
+func NewStdoutTracing(w io.Writer) (*Tracing, error) {
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(w), stdouttrace.WithPrettyPrint())
+	if err != nil {
+		return nil, err
+	}
+	return NewTracing(exporter), nil
+}
+
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	return t.provider.Shutdown(ctx)
+}
+
+// Middleware 从请求头（traceparent）继续或新建 trace，并把带 span 的 ctx 放回 c.Request，
+// handler 里把 c.Request.Context() 传给 ethclient 即可把 RPC 调用挂到同一个 trace 下
+func (t *Tracing) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := t.propagator.Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		ctx, span := t.tracer.Start(ctx, c.Request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", c.Request.Method),
+				attribute.String("http.route", route),
+				attribute.String("url.path", c.Request.URL.Path),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= 500 {
+			span.SetStatus(codes.Error, c.Errors.String())
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package validate
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/fr"
+	"github.com/go-playground/locales/ja"
+	"github.com/go-playground/locales/ko"
+	"github.com/go-playground/locales/zh"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	fr_translations "github.com/go-playground/validator/v10/translations/fr"
+	ja_translations "github.com/go-playground/validator/v10/translations/ja"
+	zh_translations "github.com/go-playground/validator/v10/translations/zh"
+	"golang.org/x/text/language"
+)
+
+const (
+	// LangParam 是查询参数和 cookie 中表示语言的名字，例如 ?lang=ja
+	LangParam = "lang"
+
+	translatorKey = "validate.translator"
+)
+
+// Locale 描述一种可注册的语言
+type Locale struct {
+	Translator locales.Translator
+	// Defaults 注册 validator 内置标签的翻译，validator 没有提供该语言时可以为 nil
+	Defaults func(v *validator.Validate, trans ut.Translator) error
+	// Messages 为自定义规则提供该语言的提示，key 为规则标签，带参数的提示用 "标签_param"
+	Messages map[string]string
+}
+
+func (l Locale) Name() string {
+	return l.Translator.Locale()
+}
+
+func English() Locale {
+	return Locale{Translator: en.New(), Defaults: en_translations.RegisterDefaultTranslations}
+}
+
+func Chinese() Locale {
+	return Locale{Translator: zh.New(), Defaults: zh_translations.RegisterDefaultTranslations}
+}
+
+func Japanese() Locale {
+	return Locale{
+		Translator: ja.New(),
+		Defaults:   ja_translations.RegisterDefaultTranslations,
+		Messages: map[string]string{
+			"eth_address":          "{0}は有効なEthereumアドレスでなければなりません",
+			"eth_checksum":         "{0}はEIP-55チェックサム付きのEthereumアドレスでなければなりません",
+			"decimal_amount":       "{0}は0より大きい10進数でなければなりません",
+			"decimal_amount_param": "{0}は0より大きく、小数点以下{1}桁以内の10進数でなければなりません",
+		},
+	}
+}
+
+// Korean validator 没有提供韩语的内置翻译，内置标签会退回原始错误信息
+func Korean() Locale {
+	return Locale{
+		Translator: ko.New(),
+		Messages: map[string]string{
+			"eth_address":          "{0}은(는) 유효한 이더리움 주소여야 합니다",
+			"eth_checksum":         "{0}은(는) EIP-55 체크섬 이더리움 주소여야 합니다",
+			"decimal_amount":       "{0}은(는) 0보다 큰 십진수여야 합니다",
+			"decimal_amount_param": "{0}은(는) 0보다 크고 소수점 이하 {1}자리 이내의 십진수여야 합니다",
+		},
+	}
+}
+
+func French() Locale {
+	return Locale{
+		Translator: fr.New(),
+		Defaults:   fr_translations.RegisterDefaultTranslations,
+		Messages: map[string]string{
+			"eth_address":          "{0} doit être une adresse Ethereum valide",
+			"eth_checksum":         "{0} doit être une adresse Ethereum avec somme de contrôle EIP-55",
+			"decimal_amount":       "{0} doit être un montant décimal positif",
+			"decimal_amount_param": "{0} doit être un montant décimal positif avec au plus {1} décimales",
+		},
+	}
+}
+
+// RegisterLocale 增加一种语言，同时补齐已注册自定义规则的翻译。
+// 与 RegisterRule 一样只应在启动阶段、开始处理请求之前调用
+func (v *Validator) RegisterLocale(l Locale) error {
+	if err := v.uni.AddTranslator(l.Translator, true); err != nil {
+		return err
+	}
+	trans, _ := v.uni.GetTranslator(l.Name())
+	if l.Defaults != nil {
+		if err := l.Defaults(v.engine, trans); err != nil {
+			return err
+		}
+	}
+	for _, r := range v.rules {
+		if err := v.registerRuleMessage(l, r); err != nil {
+			return err
+		}
+	}
+	v.locales = append(v.locales, l)
+	return nil
+}
+
+// Locale 返回按请求解析语言的中间件，解析出的翻译器存进 context 供 Bind 使用
+func (v *Validator) Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(translatorKey, v.Resolve(c))
+		c.Next()
+	}
+}
+
+// TranslatorFrom 取出 Locale 中间件存进 context 的翻译器
+func TranslatorFrom(c *gin.Context) (ut.Translator, bool) {
+	val, ok := c.Get(translatorKey)
+	if !ok {
+		return nil, false
+	}
+	trans, ok := val.(ut.Translator)
+	return trans, ok
+}
+
+// Resolve 依次按查询参数 lang、cookie lang、Accept-Language 解析语言，都不支持时使用 fallback
+func (v *Validator) Resolve(c *gin.Context) ut.Translator {
+	if trans, ok := v.find(c.Query(LangParam)); ok {
+		return trans
+	}
+	if cookie, err := c.Cookie(LangParam); err == nil {
+		if trans, ok := v.find(cookie); ok {
+			return trans
+		}
+	}
+	tags, _, _ := language.ParseAcceptLanguage(c.GetHeader("Accept-Language"))
+	for _, tag := range tags {
+		if trans, ok := v.lookup(tag); ok {
+			return trans
+		}
+	}
+	trans, _ := v.uni.GetTranslator(v.fallback)
+	return trans
+}
+
+func (v *Validator) find(lang string) (ut.Translator, bool) {
+	if lang == "" {
+		return nil, false
+	}
+	tag, err := language.Parse(lang)
+	if err != nil {
+		return nil, false
+	}
+	return v.lookup(tag)
+}
+
+// lookup 先精确匹配（zh-TW -> zh_TW），再退回基础语言（zh-TW -> zh）
+func (v *Validator) lookup(tag language.Tag) (ut.Translator, bool) {
+	if trans, ok := v.uni.GetTranslator(strings.ReplaceAll(tag.String(), "-", "_")); ok {
+		return trans, true
+	}
+	base, _ := tag.Base()
+	return v.uni.GetTranslator(base.String())
+}
@@ -0,0 +1,175 @@
+package validate
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// newWithLocales 创建注册了中英日韩法五种语言的校验器
+func newWithLocales(t *testing.T, fallback string) *Validator {
+	t.Helper()
+	v, err := New(fallback)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range []Locale{Japanese(), Korean(), French()} {
+		if err := v.RegisterLocale(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return v
+}
+
+func request(query, cookie, acceptLanguage string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if query != "" {
+		req.URL.RawQuery = LangParam + "=" + query
+	}
+	if cookie != "" {
+		req.AddCookie(&http.Cookie{Name: LangParam, Value: cookie})
+	}
+	if acceptLanguage != "" {
+		req.Header.Set("Accept-Language", acceptLanguage)
+	}
+	return req
+}
+
+func TestResolve(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	v := newWithLocales(t, "en")
+	tests := []struct {
+		name                          string
+		query, cookie, acceptLanguage string
+		want                          string
+	}{
+		{name: "nothing", want: "en"},
+		{name: "query first", query: "ja", cookie: "fr", acceptLanguage: "ko", want: "ja"},
+		{name: "cookie before header", cookie: "ko", acceptLanguage: "ja", want: "ko"},
+		{name: "header", acceptLanguage: "fr-FR,fr;q=0.9", want: "fr"},
+		{name: "unsupported query falls through", query: "de", cookie: "fr", want: "fr"},
+		{name: "invalid query falls through", query: "!!", acceptLanguage: "ja", want: "ja"},
+		{name: "unsupported cookie falls through", cookie: "de", acceptLanguage: "ko", want: "ko"},
+		{name: "header q-values", acceptLanguage: "ja;q=0.3, fr;q=0.9, ko;q=0.5", want: "fr"},
+		{name: "header skips unsupported", acceptLanguage: "de-DE, de;q=0.9, ko;q=0.8, ja;q=0.7", want: "ko"},
+		{name: "q=0 is not acceptable", acceptLanguage: "ja;q=0, de", want: "en"},
+		{name: "region falls back to base", acceptLanguage: "zh-TW", want: "zh"},
+		{name: "script and region fall back to base", acceptLanguage: "zh-Hant-TW,en;q=0.5", want: "zh"},
+		{name: "query region", query: "zh-CN", want: "zh"},
+		{name: "query underscore", query: "ja_JP", acceptLanguage: "fr", want: "ja"},
+		{name: "wildcard", acceptLanguage: "*", want: "en"},
+		{name: "unsupported header", acceptLanguage: "de, it;q=0.5", want: "en"},
+		{name: "broken header", acceptLanguage: ";;;q=x", want: "en"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = request(tt.query, tt.cookie, tt.acceptLanguage)
+			if got := v.Resolve(c).Locale(); got != tt.want {
+				t.Fatalf("Resolve = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	// 没有匹配时用 New 传入的 fallback
+	zhFirst := newWithLocales(t, "zh")
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = request("", "", "de")
+	if got := zhFirst.Resolve(c).Locale(); got != "zh" {
+		t.Fatalf("fallback = %s, want zh", got)
+	}
+	if _, err := New("de"); err == nil {
+		t.Fatal("New accepted an unregistered fallback")
+	}
+}
+
+// Locale 中间件把翻译器放进 context，之后的 Bind 按它翻译；自定义规则在后注册的语言里也有翻译
+func TestLocaleMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	saved := binding.Validator
+	t.Cleanup(func() { binding.Validator = saved })
+	v := newWithLocales(t, "en")
+	v.Install()
+
+	r := gin.New()
+	r.Use(v.Locale())
+	r.POST("/transfer", func(c *gin.Context) {
+		trans, ok := TranslatorFrom(c)
+		if !ok {
+			c.JSON(http.StatusInternalServerError, gin.H{"msg": "no translator"})
+			return
+		}
+		var form transferForm
+		err := v.BindJSON(c, &form)
+		var fields FieldErrors
+		if !errors.As(err, &fields) {
+			c.JSON(http.StatusInternalServerError, gin.H{"msg": "unexpected error"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"locale": trans.Locale(), "errors": fields})
+	})
+
+	body := `{"to":"0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266","amount":"1.1234567"}`
+	tests := []struct {
+		name           string
+		query          string
+		acceptLanguage string
+		locale         string
+		to, amount     string
+	}{
+		{name: "ja", acceptLanguage: "ja-JP", locale: "ja",
+			to:     "toはEIP-55チェックサム付きのEthereumアドレスでなければなりません",
+			amount: "amountは0より大きく、小数点以下6桁以内の10進数でなければなりません"},
+		{name: "ko", query: "ko", acceptLanguage: "ja", locale: "ko",
+			to:     "to은(는) EIP-55 체크섬 이더리움 주소여야 합니다",
+			amount: "amount은(는) 0보다 크고 소수점 이하 6자리 이내의 십진수여야 합니다"},
+		{name: "fr", acceptLanguage: "fr-CA", locale: "fr",
+			to:     "to doit être une adresse Ethereum avec somme de contrôle EIP-55",
+			amount: "amount doit être un montant décimal positif avec au plus 6 décimales"},
+		{name: "zh-TW", acceptLanguage: "zh-TW", locale: "zh", to: "to必须是符合 EIP-55 校验和的以太坊地址"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/transfer?lang="+tt.query, strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("status %d: %s", w.Code, w.Body)
+			}
+			got := w.Body.String()
+			if !strings.Contains(got, `"locale":"`+tt.locale+`"`) || !strings.Contains(got, tt.to) || !strings.Contains(got, tt.amount) {
+				t.Fatalf("body %s", got)
+			}
+		})
+	}
+
+	// validator 没有韩语的内置翻译，内置标签退回原始错误信息
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/transfer?lang=ko", strings.NewReader(`{}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	var form transferForm
+	var fields FieldErrors
+	if err := v.BindJSON(c, &form); !errors.As(err, &fields) || !strings.Contains(fields["to"], "failed on the 'required' tag") {
+		t.Fatalf("ko required: %v", err)
+	}
+}
+
+func TestFieldErrorsError(t *testing.T) {
+	e := FieldErrors{"to": "bad address", "amount": "too many decimals", "memo": "too long", "items[0].id": "required"}
+	want := "amount: too many decimals; items[0].id: required; memo: too long; to: bad address"
+	for range 10 { // map 遍历顺序随机，多试几次
+		if got := e.Error(); got != want {
+			t.Fatalf("Error() = %q, want %q", got, want)
+		}
+	}
+	if got := (FieldErrors{}).Error(); got != "" {
+		t.Fatalf("empty = %q", got)
+	}
+}
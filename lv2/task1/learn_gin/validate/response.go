@@ -0,0 +1,23 @@
+package validate
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Fail 统一写出绑定/校验失败的响应，只写一次：
+// 校验错误返回 {"msg": {字段: 信息}}，其他错误返回 {"msg": err.Error()}
+func Fail(c *gin.Context, err error) {
+	var fields FieldErrors
+	if errors.As(err, &fields) {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+			"msg": fields,
+		})
+		return
+	}
+	c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+		"msg": err.Error(),
+	})
+}
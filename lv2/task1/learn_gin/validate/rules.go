@@ -0,0 +1,156 @@
+package validate
+
+import (
+	"encoding/hex"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/crypto/sha3"
+)
+
+// Rule 是一条自定义校验规则，Messages 按语言给出提示模板，{0} 为字段名，{1} 为规则参数
+type Rule struct {
+	Tag      string
+	Func     validator.Func
+	Messages map[string]string
+	// ParamMessages 在规则带参数时使用，例如 decimal_amount=6
+	ParamMessages map[string]string
+}
+
+var defaultRules = []Rule{
+	{
+		Tag:  "eth_address",
+		Func: isEthAddress,
+		Messages: map[string]string{
+			"en": "{0} must be a valid Ethereum address",
+			"zh": "{0}必须是有效的以太坊地址",
+		},
+	},
+	{
+		Tag:  "eth_checksum",
+		Func: isEthChecksumAddress,
+		Messages: map[string]string{
+			"en": "{0} must be an EIP-55 checksummed Ethereum address",
+			"zh": "{0}必须是符合 EIP-55 校验和的以太坊地址",
+		},
+	},
+	{
+		Tag:  "decimal_amount",
+		Func: isDecimalAmount,
+		Messages: map[string]string{
+			"en": "{0} must be a positive decimal amount",
+			"zh": "{0}必须是大于0的十进制数",
+		},
+		ParamMessages: map[string]string{
+			"en": "{0} must be a positive decimal amount with at most {1} decimal places",
+			"zh": "{0}必须是大于0且最多{1}位小数的十进制数",
+		},
+	},
+}
+
+// RegisterRule 注册自定义校验规则及其在所有已注册语言下的翻译，
+// 优先使用 Rule 自带的提示，其次是 Locale.Messages，最后退回英文
+func (v *Validator) RegisterRule(r Rule) error {
+	if err := v.engine.RegisterValidation(r.Tag, r.Func); err != nil {
+		return err
+	}
+	for _, l := range v.locales {
+		if err := v.registerRuleMessage(l, r); err != nil {
+			return err
+		}
+	}
+	v.rules = append(v.rules, r)
+	return nil
+}
+
+func (v *Validator) registerRuleMessage(l Locale, r Rule) error {
+	trans, _ := v.uni.GetTranslator(l.Name())
+	msg := pick(r.Messages, l.Messages, l.Name(), r.Tag)
+	paramMsg := pick(r.ParamMessages, l.Messages, l.Name(), r.Tag+"_param")
+	return v.registerMessage(trans, r.Tag, msg, paramMsg)
+}
+
+func (v *Validator) registerMessage(trans ut.Translator, tag, msg, paramMsg string) error {
+	return v.engine.RegisterTranslation(tag, trans, func(ut ut.Translator) error {
+		if err := ut.Add(tag, msg, true); err != nil {
+			return err
+		}
+		if paramMsg == "" {
+			return nil
+		}
+		return ut.Add(tag+"_param", paramMsg, true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		if fe.Param() != "" && paramMsg != "" {
+			t, _ := ut.T(tag+"_param", fe.Field(), fe.Param())
+			return t
+		}
+		t, _ := ut.T(tag, fe.Field())
+		return t
+	})
+}
+
+func pick(ruleMessages, localeMessages map[string]string, locale, key string) string {
+	if msg, ok := ruleMessages[locale]; ok {
+		return msg
+	}
+	if msg, ok := localeMessages[key]; ok {
+		return msg
+	}
+	return ruleMessages["en"]
+}
+
+var (
+	addressRegex = regexp.MustCompile("^0x[0-9a-fA-F]{40}$")
+	decimalRegex = regexp.MustCompile(`^\d+(\.\d+)?$`)
+)
+
+func isEthAddress(fl validator.FieldLevel) bool {
+	return addressRegex.MatchString(fl.Field().String())
+}
+
+// isEthChecksumAddress 按 EIP-55 校验大小写：地址小写形式的 Keccak256 哈希中，
+// 对应的半字节 >= 8 时该位字母必须大写，否则必须小写
+func isEthChecksumAddress(fl validator.FieldLevel) bool {
+	addr := fl.Field().String()
+	if !addressRegex.MatchString(addr) {
+		return false
+	}
+	return addr == toChecksumAddress(addr)
+}
+
+func toChecksumAddress(addr string) string {
+	lower := strings.ToLower(addr[2:])
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(lower))
+	digest := hex.EncodeToString(hash.Sum(nil))
+
+	out := []byte(lower)
+	for i, c := range out {
+		if c >= 'a' && c <= 'f' && digest[i] >= '8' {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(out)
+}
+
+// isDecimalAmount 校验字符串形式的金额，例如 "1.5"；参数为允许的最大小数位数
+func isDecimalAmount(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if !decimalRegex.MatchString(s) || strings.Trim(s, "0.") == "" {
+		return false
+	}
+	if fl.Param() == "" {
+		return true
+	}
+	precision, err := strconv.Atoi(fl.Param())
+	if err != nil {
+		return false
+	}
+	if i := strings.Index(s, "."); i >= 0 {
+		return len(s)-i-1 <= precision
+	}
+	return true
+}
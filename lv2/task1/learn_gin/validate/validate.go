@@ -0,0 +1,167 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldErrors 是翻译后的校验错误，key 为去掉顶层结构体名的字段路径（json 名），value 为提示信息
+type FieldErrors map[string]string
+
+// Error 按字段名排序拼接，同样的错误每次输出都相同
+func (e FieldErrors) Error() string {
+	parts := make([]string, 0, len(e))
+	for _, field := range slices.Sorted(maps.Keys(e)) {
+		parts = append(parts, field+": "+e[field])
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Validator 持有自己的 validator 引擎，负责绑定 DTO 并把校验错误翻译成 FieldErrors。
+// 它实现了 binding.StructValidator，Install 之后 gin 的 ShouldBind 系列也用它校验
+type Validator struct {
+	engine   *validator.Validate
+	uni      *ut.UniversalTranslator
+	fallback string
+	locales  []Locale
+	rules    []Rule
+}
+
+var _ binding.StructValidator = (*Validator)(nil)
+
+// errNotInstalled 表示 Bind 时 gin 用的还是别的校验器，自定义规则和 json 字段名都不会生效
+var errNotInstalled = errors.New("validate: validator is not installed, call Install at startup")
+
+// New 创建一个独立的校验引擎，注册 json 字段名、自定义规则以及中英文翻译，
+// fallback 为 Accept-Language 无法匹配时使用的语言。New 不修改任何全局状态，用 Install 接到 gin 上
+func New(fallback string) (*Validator, error) {
+	engine := validator.New()
+	engine.SetTagName("binding") // 和 gin 默认校验器一样读 binding 标签
+	engine.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return fld.Name
+		}
+		return name
+	})
+
+	enT := en.New()
+	v := &Validator{engine: engine, uni: ut.New(enT), fallback: fallback}
+	for _, l := range []Locale{English(), Chinese()} {
+		if err := v.RegisterLocale(l); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range defaultRules {
+		if err := v.RegisterRule(r); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := v.uni.GetTranslator(fallback); !ok {
+		return nil, fmt.Errorf("uni.GetTranslator(%s)", fallback)
+	}
+	return v, nil
+}
+
+// Install 把 v 设为 gin 的 binding.Validator，只应在启动阶段、开始处理请求之前调用一次
+func (v *Validator) Install() {
+	binding.Validator = v
+}
+
+// ValidateStruct 实现 binding.StructValidator，和 gin 默认校验器一样支持指针、结构体和切片
+func (v *Validator) ValidateStruct(obj any) error {
+	if obj == nil {
+		return nil
+	}
+	value := reflect.ValueOf(obj)
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.Elem().Kind() != reflect.Struct {
+			return v.ValidateStruct(value.Elem().Interface())
+		}
+		return v.engine.Struct(obj)
+	case reflect.Struct:
+		return v.engine.Struct(obj)
+	case reflect.Slice, reflect.Array:
+		var errs binding.SliceValidationError
+		for i := 0; i < value.Len(); i++ {
+			if err := v.ValidateStruct(value.Index(i).Interface()); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+		return errs
+	default:
+		return nil
+	}
+}
+
+// Engine 实现 binding.StructValidator，返回底层的 *validator.Validate
+func (v *Validator) Engine() any {
+	return v.engine
+}
+
+// Bind 按请求的 Content-Type 绑定 obj，校验失败时返回按 Accept-Language 翻译后的 FieldErrors，
+// 其他错误（如 JSON 格式错误）原样返回
+func (v *Validator) Bind(c *gin.Context, obj any) error {
+	return v.bind(c, func() error { return c.ShouldBind(obj) })
+}
+
+// BindJSON 与 Bind 相同，但总是按 JSON 解析请求体
+func (v *Validator) BindJSON(c *gin.Context, obj any) error {
+	return v.bind(c, func() error { return c.ShouldBindJSON(obj) })
+}
+
+// BindUri 绑定路径参数
+func (v *Validator) BindUri(c *gin.Context, obj any) error {
+	return v.bind(c, func() error { return c.ShouldBindUri(obj) })
+}
+
+// bind 先确认 gin 用的是 v，否则别的校验器遇到自定义规则会直接 panic
+func (v *Validator) bind(c *gin.Context, bind func() error) error {
+	if binding.Validator != binding.StructValidator(v) {
+		return errNotInstalled
+	}
+	err := bind()
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return err
+	}
+	return v.Translate(errs, v.Translator(c))
+}
+
+// Translate 把校验错误翻译成 FieldErrors，字段路径去掉顶层结构体名，例如 LoginForm.user -> user
+func (v *Validator) Translate(errs validator.ValidationErrors, trans ut.Translator) FieldErrors {
+	rsp := FieldErrors{}
+	for _, fe := range errs {
+		field := fe.Namespace()
+		if i := strings.Index(field, "."); i >= 0 {
+			field = field[i+1:]
+		}
+		rsp[field] = fe.Translate(trans)
+	}
+	return rsp
+}
+
+// Translator 优先使用 Locale 中间件放进 context 的翻译器，没有时按请求自行解析
+func (v *Validator) Translator(c *gin.Context) ut.Translator {
+	if trans, ok := TranslatorFrom(c); ok {
+		return trans
+	}
+	return v.Resolve(c)
+}
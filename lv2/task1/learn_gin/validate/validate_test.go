@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+type transferForm struct {
+	To     string `json:"to" binding:"required,eth_checksum"`
+	Amount string `json:"amount" binding:"required,decimal_amount=6"`
+}
+
+func bindTransfer(t *testing.T, v *Validator, lang, body string) error {
+	t.Helper()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/transfer?lang="+lang, strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	var form transferForm
+	return v.BindJSON(c, &form)
+}
+
+func TestInstall(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	saved := binding.Validator
+	t.Cleanup(func() { binding.Validator = saved })
+
+	first, err := New("en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// New 不改全局状态，第二次 New 也不会在共享引擎上重复注册
+	second, err := New("zh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if binding.Validator != saved {
+		t.Fatal("New replaced binding.Validator")
+	}
+	if first.engine == second.engine {
+		t.Fatal("validators share an engine")
+	}
+
+	body := `{"to":"0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266","amount":"1.1234567"}`
+	if err := bindTransfer(t, first, "en", body); !errors.Is(err, errNotInstalled) {
+		t.Fatalf("bind before Install = %v", err)
+	}
+
+	first.Install()
+	tests := []struct {
+		lang string
+		want map[string]string
+	}{
+		{"en", map[string]string{
+			"to":     "to must be an EIP-55 checksummed Ethereum address",
+			"amount": "amount must be a positive decimal amount with at most 6 decimal places",
+		}},
+		{"zh", map[string]string{
+			"to": "to必须是符合 EIP-55 校验和的以太坊地址",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lang, func(t *testing.T) {
+			var fields FieldErrors
+			if err := bindTransfer(t, first, tt.lang, body); !errors.As(err, &fields) {
+				t.Fatalf("err = %v", err)
+			}
+			for field, msg := range tt.want {
+				if fields[field] != msg {
+					t.Errorf("%s = %q, want %q", field, fields[field], msg)
+				}
+			}
+		})
+	}
+
+	ok := `{"to":"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266","amount":"1.5"}`
+	if err := bindTransfer(t, first, "en", ok); err != nil {
+		t.Fatal(err)
+	}
+	// 装的是 first，second 绑定时会报错而不是用错引擎
+	if err := bindTransfer(t, second, "zh", ok); !errors.Is(err, errNotInstalled) {
+		t.Fatalf("bind with uninstalled validator = %v", err)
+	}
+}
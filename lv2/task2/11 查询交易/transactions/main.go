@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"ethkit/sigdb"
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -26,6 +27,9 @@ func main() {
 		log.Fatal(err)
 	}
 
+	//sigdb 收录了 ERC20/ERC721 和仓库里合约的方法签名，用来把 tx.Data() 解码成方法名和参数
+	db := sigdb.Builtin()
+
 	//block.Transactions() 返回区块中的所有交易，通过遍历每个交易 tx，代码输出交易的关键信息，
 	//如哈希值、金额、gas、gas 价格、nonce 值、附加数据和接收方地址。
 	for _, tx := range block.Transactions() {
@@ -35,6 +39,9 @@ func main() {
 		fmt.Println(tx.GasPrice().Uint64()) // 102000000000
 		fmt.Println(tx.Nonce())             // 110644
 		fmt.Println(tx.Data())              // []
+		if call, err := db.DecodeCalldata(tx.Data()); err == nil {
+			fmt.Println(call.Signature, call.Args) // transfer(address,uint256) [0x4592D8f8D7B001e72Cb26A73e4Fa1806a51aC79d 1000000000000000000]
+		}
 		fmt.Println(tx.To().Hex())          // 0x55fE59D8Ad77035154dDd0AD0388D09Dd4047A8e
 
 		//获取交易的发送者地址
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"ethkit/preflight"
 	"fmt"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -36,29 +39,37 @@ func main() {
 	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
 	//获取交易发送者地址的 nonce，这是该账户发出的未确认交易数。
 	//nonce 用于防止交易重放，每一笔交易的 nonce 必须唯一且按顺序递增。
-	nonce, err := client.PendingnoceAt(context.Backgound(), fromAddress)
+	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
 	if err != nil {
 		log.Fatal(err)
 	}
 	//设置交易金额 value，这里为 1 ETH，单位是 wei（1 ETH = 10^18 wei）。
-	//gasLimit 表示这笔交易的最大 Gas 消耗，这里是 21,000 单位，通常用于简单的转账交易。
 	//gasPrice 是交易的 Gas 价格，使用客户端建议的 Gas 价格。
 	value := big.NewInt(1000000000000000000)
-	gasLimit := uint64(21000)
-	gasPrice, err := client.SuggestGasPrice(context.Backgound())
+	gasPrice, err := client.SuggestGasPrice(context.Background())
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	//toAddress 是接收者地址。
+	//gasLimit 表示这笔交易的最大 Gas 消耗。收款方是合约时 receive 里也要花 gas，不能写死 21,000，
+	//preflight.Check 按估算值加余量，同时检查余额够不够付金额和手续费，收款方 revert 时也会报错。
+	toAddress := common.HexToAddress("0x4592d8f8d7b001e72cb26a73e4fa1806a51ac79d")
+	report, err := preflight.Check(context.Background(), client, ethereum.CallMsg{From: fromAddress, To: &toAddress, Value: value, GasPrice: gasPrice}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := report.Err(); err != nil {
+		log.Fatal(err)
+	}
+	gasLimit := report.GasLimit
 	//tx 表示构造的未签名交易，包括发送者地址的 nonce、接收者地址、交易金额、gasLimit、gasPrice 和 data。
 	//data 通常用于智能合约交互，这里为空，因为这是一次普通的转账。
-	toAddress := common.HexToAddress("0x4592d8f8d7b001e72cb26a73e4fa1806a51ac79d")
 	var data []byte
 	tx := types.NewTransaction(nonce, toAddress, value, gasLimit, gasPrice, data)
 
 	//通过 client.NetworkID 获取网络的链 ID（Rinkeby 测试网有自己的链 ID），这用于 EIP-155 防重放攻击机制。
-	chainId, err := client.NetworkID(context.Backgound())
+	chainId, err := client.NetworkID(context.Background())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -69,7 +80,7 @@ func main() {
 	}
 	//使用 SendTransaction 将签名后的交易发送到以太坊网络。
 	//输出交易哈希（交易 ID），用于追踪这笔交易的状态。
-	if err := client.SendTransaction(context.Backgound(), signedTx); err != nil {
+	if err := client.SendTransaction(context.Background(), signedTx); err != nil {
 		log.Fatal(err)
 	}
 	//连接节点：使用 Infura 连接到 Rinkeby 网络。
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"crypto/ecdsa"
+	"ethkit/sigdb"
 	"fmt"
 	"log"
 	"math/big"
@@ -40,14 +41,13 @@ func main() {
 	toKenAddress := common.HextToAddress("0x28b149020d2152179873ec60bed6bf7cd705775d")
 
 	//构造 transfer 方法的调用数据
-	//构造代币合约中 transfer 方法的函数签名 transfer(address,uint256)，
-	//并通过 Keccak-256 哈希得到该方法的 methodID（前四个字节），
-	//这是调用智能合约时用于标识方法的标识符。
-	transferFnSignature := []byte("transfer(address,uint256)")
-	hash := crypto.Keccak256Hash(transferFnSignature)
-	hash.Write(transferFnSignature)
-	methodID := hash.Sum(nil)[:4]
-	fmt.Println(hexutil.Encode(methodID)) // 0xa9059cbb
+	//方法 ID（methodID）是函数签名 transfer(address,uint256) 经 Keccak-256 哈希后的前四个字节，
+	//是调用智能合约时用于标识方法的标识符。sigdb.ID 按同样的规则计算，签名里的 uint 会规范成 uint256，写错时报错。
+	methodID, err := sigdb.ID("transfer(address,uint256)")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(methodID) // 0xa9059cbb
 
 	//将接收者地址 toAddress 和转账数额（1000 个代币，转换为最小单位）进行左填充，确保每个参数占用 32 字节。
 	paddedAddress := common.LeftPadBytes(toAddress.Bytes(), 32)
@@ -60,10 +60,17 @@ func main() {
 
 	//将方法 ID、地址和金额拼接在一起，组成交易数据 data。这是传递给智能合约的完整数据。
 	var data []byte
-	data = append(data, methodID...)
+	data = append(data, common.FromHex(methodID)...)
 	data = append(data, paddedAddress...)
 	data = append(data, paddedAmount...)
 
+	//用 sigdb 把拼好的 data 解码回来，确认选择器和参数都对
+	call, err := sigdb.Builtin().DecodeCalldata(data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(call.Signature, call.Args) // transfer(address,uint256) [0x4592D8f8D7B001e72Cb26A73e4Fa1806a51aC79d 1000000000000000000]
+
 	gasLimit, err := client.EstimateGas(context.Background(), ethclient.CallMsg{
 
 		To: &toKenAddress,
@@ -4,7 +4,13 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/hex"
+	"ethkit/preflight"
 	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"log"
 	"math/big"
 )
@@ -33,15 +39,22 @@ func main() {
 	}
 
 	value := big.NewInt(1000000000000000000) // in wei (1 eth)
-	gasLimit := uint64(21000)
 	//在以太坊网络中，每个交易都有一个递增的 nonce 值。这个值确保交易的唯一性，并避免双重支出。这行代码从网络中获取了发送地址的当前 nonce 值。
-	//in units
 	gasPrice, err := client.SuggestGasPrice(context.Background())
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	toAddress := common.HexToAddress("0x4592d8f8d7b001e72cb26a73e4fa1806a51ac79d")
+	//gas 上限不写死 21000，收款方可能是合约；preflight.Check 按估算值加余量，并检查余额够不够
+	report, err := preflight.Check(context.Background(), client, ethereum.CallMsg{From: fromAddress, To: &toAddress, Value: value, GasPrice: gasPrice}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := report.Err(); err != nil {
+		log.Fatal(err)
+	}
+	gasLimit := report.GasLimit // in units
 	var data []byte
 	tx := types.NewTransaction(nonce, toAddress, value, gasLimit, gasPrice, data)
 
@@ -54,14 +67,15 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	//将签名的交易转换为 RLP 编码
-	ts := types.Transactions{signedTx}
-	//ts.GetRlp(0)：将签名的交易转换为 RLP（Recursive Length Prefix）编码
+	//signedTx.MarshalBinary()：将签名的交易转换为 RLP（Recursive Length Prefix）编码
 	//，RLP 是以太坊中用于编码对象的标准格式。
-	rawTxBytes := ts.GetRlp(0)
+	rawTxBytes, err := signedTx.MarshalBinary()
+	if err != nil {
+		log.Fatal(err)
+	}
 	//将 RLP 编码后的字节转换为十六进制字符串。
 	rawTxHex := hex.EncodeToString(rawTxBytes)
 
 	//这个字符串可以直接用于广播到以太坊网络。
-	fmt.Printf(rawTxHex) // f86...772
+	fmt.Println(rawTxHex) // f86...772
 }
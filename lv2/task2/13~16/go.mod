@@ -1 +1,10 @@
 module 13_transfer_tokens
+
+require ethkit v0.0.0
+
+// ethkit 和它依赖的 store、readerc 都在仓库里
+replace (
+	ethkit => ../ethkit
+	readerc => ../05
+	store => ../17/store
+)
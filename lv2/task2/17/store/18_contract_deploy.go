@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"flag"
 	"fmt"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -10,69 +10,49 @@ import (
 	"log"
 	"math/big"
 	store "store/contracts"
+	"store/deploy"
 )
 
+// 部署结果按链 ID 记录在 deployments/<chainID>.json，重复运行时如果合约已经部署且链上代码一致就直接跳过
+// go run 18_contract_deploy.go -rpc http://127.0.0.1:8545 -key ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80
 func main() {
-	client, err := ethclient.Dial("https://sepolia.infura.io/v3/eba825833e774dd582d26c47a78184bb")
+	rpcURL := flag.String("rpc", "https://sepolia.infura.io/v3/eba825833e774dd582d26c47a78184bb", "JSON-RPC endpoint")
+	key := flag.String("key", "fad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19", "deployer private key")
+	dir := flag.String("deployments", "deployments", "deployment manifest directory")
+	flag.Parse()
+
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
 	if err != nil {
 		log.Fatal(err)
-
 	}
 
-	privateKey, err := crypto.HexToECDSA("fad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19")
+	privateKey, err := crypto.HexToECDSA(*key)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		log.Fatal("cannot assert type: publicKey is not of type *ecdsa.PublicKey")
-	}
-
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-	//  // 获取待处理的 nonce 值（即当前交易数量）
-	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
+	manager, err := deploy.NewManager(ctx, client, *dir)
 	if err != nil {
 		log.Fatal(err)
 	}
-	gasPrice, err := client.SuggestGasPrice(context.Background())
+	// 创建一个带有私钥的交易授权者。gas 限制不写死，Manager 部署前用 preflight 估算并加余量，
+	// 构造函数会 revert 或余额不够时不发送
+	chainID := new(big.Int).SetUint64(manager.Manifest().ChainID)
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
 	if err != nil {
 		log.Fatal(err)
 	}
-	// 创建一个带有私钥的交易授权者
-	auth := bind.NewKeyedTransactor(privateKey)
-	auth.Nonce = big.NewInt(int64(nonce)) // 设置 nonce
-	auth.Value = big.NewInt(0)            // 设置发送的以太值（这里为0）
-	auth.GasLimit = uint64(300000)        // 设置 gas 限制
-	auth.GasPrice = gasPrice              // 设置 gas 价格
-	//余额
-	balance, err := client.BalanceAt(context.Background(), fromAddress, nil)
+
+	// 部署合约，"1.0" 是构造函数的参数
+	rec, fresh, err := manager.Deploy(ctx, auth, deploy.FromMetaData("Store", store.StoreMetaData), "1.0")
 	if err != nil {
 		log.Fatal(err)
 	}
-	fmt.Printf("Balance: %s wei\n", balance.String())
-
-	// 计算交易费用
-	estimatedGasCost := new(big.Int).Mul(big.NewInt(int64(auth.GasLimit)), gasPrice)
-	// 打印账户余额和估算的交易费用
-	fmt.Printf("Balance: %s wei\n", balance.String())
-	fmt.Printf("Estimated Gas Cost: %s wei\n", estimatedGasCost.String())
-
-	if balance.Cmp(estimatedGasCost) < 0 {
-		log.Fatal("Insufficient funds for gas * price")
-	}
-
-	// 部署合约，input 是构造函数的参数
-	input := "1.0"
-	address, tx, instance, err := store.DeployStore(auth, client, input)
-	if err != nil {
-		log.Fatal(err) // 如果部署合约失败，则记录错误并终止程序
+	if !fresh {
+		fmt.Println("already deployed, skipped")
 	}
 	// 输出合约地址和交易哈希
-	fmt.Println(address.Hex())   // 0x147B8eb97fD247D06C4006D269c90C1908Fb5D54
-	fmt.Println(tx.Hash().Hex()) // 0xdae8ba5444eefdc99f4d45cd0c4f24056cba6a02cefbf78066ef9f4188ff7dc0
-
-	// 保存合约实例，供后续使用
-	_ = instance
+	fmt.Println(rec.Address.Hex()) // 0x147B8eb97fD247D06C4006D269c90C1908Fb5D54
+	fmt.Println(rec.TxHash.Hex())  // 0xdae8ba5444eefdc99f4d45cd0c4f24056cba6a02cefbf78066ef9f4188ff7dc0
 }
@@ -1,27 +1,41 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"log"
 	store "store/contracts"
+	"store/deploy"
 )
 
 //加载智能合约
 
 func main() {
-	client, err := ethclient.Dial("https://sepolia.infura.io/v3/eba825833e774dd582d26c47a78184bb")
+	rpcURL := flag.String("rpc", "https://sepolia.infura.io/v3/eba825833e774dd582d26c47a78184bb", "JSON-RPC endpoint")
+	dir := flag.String("deployments", "deployments", "deployment manifest directory")
+	flag.Parse()
+
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
 	if err != nil {
 		panic(err)
 	}
-	//将智能合约的地址从字符串形式转换为以太坊地址的格式。
-	address := common.HexToAddress("0x147B8eb97fD247D06C4006D269c90C1908Fb5D54")
-	instance, err := store.NewStore(address, client)
+	manager, err := deploy.NewManager(ctx, client, *dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	//按名字从部署清单里取地址，同时校验链上代码和 Store 的字节码一致
+	rec, err := manager.Verify(ctx, deploy.FromMetaData("Store", store.StoreMetaData))
+	if err != nil {
+		log.Fatal(err)
+	}
+	instance, err := store.NewStore(rec.Address, client)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	fmt.Println("contract is loaded")
+	fmt.Println("contract is loaded:", rec.Address.Hex())
 	_ = instance
 }
@@ -1,21 +1,34 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"log"
 	store "store/contracts"
+	"store/deploy"
 )
 
 func main() {
+	rpcURL := flag.String("rpc", "https://sepolia.infura.io/v3/eba825833e774dd582d26c47a78184bb", "JSON-RPC endpoint")
+	dir := flag.String("deployments", "deployments", "deployment manifest directory")
+	flag.Parse()
 
-	client, err := ethclient.Dial("https://sepolia.infura.io/v3/eba825833e774dd582d26c47a78184bb")
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
 	if err != nil {
 		panic(err)
 	}
+	manager, err := deploy.NewManager(ctx, client, *dir)
+	if err != nil {
+		log.Fatal(err)
+	}
 	//合约地址
-	address := common.HexToAddress("0x147B8eb97fD247D06C4006D269c90C1908Fb5D54")
+	address, err := manager.Address(ctx, "Store")
+	if err != nil {
+		log.Fatal(err)
+	}
 	instance, err := store.NewStore(address, client)
 	if err != nil {
 		log.Fatal(err)
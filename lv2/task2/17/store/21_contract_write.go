@@ -2,55 +2,70 @@ package main
 
 import (
 	"context"
-	"crypto/ecdsa"
+	"ethkit/abicall"
+	"ethkit/preflight"
+	"flag"
 	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"log"
 	"math/big"
 	store "store/contracts"
+	"store/deploy"
 )
 
 func main() {
-	client, err := ethclient.Dial("https://sepolia.infura.io/v3/eba825833e774dd582d26c47a78184bb")
+	rpcURL := flag.String("rpc", "https://sepolia.infura.io/v3/eba825833e774dd582d26c47a78184bb", "JSON-RPC endpoint")
+	keyHex := flag.String("key", "fad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19", "sender private key")
+	dir := flag.String("deployments", "deployments", "deployment manifest directory")
+	flag.Parse()
+
+	client, err := ethclient.Dial(*rpcURL)
 	if err != nil {
 		log.Fatal(err)
 	}
-	privateKey, err := crypto.HexToECDSA("fad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19")
+	privateKey, err := crypto.HexToECDSA(*keyHex)
 	if err != nil {
 		log.Fatal(err)
 	}
-	publickey := privateKey.Public()
-	publickeyECDSA, ok := publickey.(*ecdsa.PublicKey)
-	if !ok {
-		log.Fatal("cannot assert type: publickey is not of type *ecdsa.PublicKey")
-	}
-	fromAddress := crypto.PubkeyToAddress(*publickeyECDSA)
-	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
+	//创建交易授权对象
+	//使用 bind.NewKeyedTransactorWithChainID 创建一个授权对象 auth，该对象包含了交易的发送者身份信息（私钥）。
+	//带上链 ID 签名（EIP-155），本地 devnet 等节点会拒绝没有重放保护的交易。
+	//Value 是发送的以太币数量，这里为 0；Nonce 和 gas 单价留空，由绑定代码向节点查询。
+	chainID, err := client.ChainID(context.Background())
 	if err != nil {
 		log.Fatal(err)
 	}
-	gasPrice, err := client.SuggestGasPrice(context.Background())
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
 	if err != nil {
 		log.Fatal(err)
 	}
-	//创建交易授权对象
-	//使用 bind.NewKeyedTransactor 创建一个授权对象 auth，该对象包含了交易的发送者身份信息（私钥）。
-	//还设置了交易的 Nonce，Value（发送的以太币数量，这里为 0），GasLimit（最大 gas 量），以及 GasPrice。
-	auth := bind.NewKeyedTransactor(privateKey)
-	auth.Nonce = big.NewInt(int64(nonce))
-	auth.Value = big.NewInt(0)     // in wei
-	auth.GasLimit = uint64(300000) // in units
-	auth.GasPrice = gasPrice
+	auth.Value = big.NewInt(0) // in wei
 
-	//加载智能合约实例  地址
-	address := common.HexToAddress("0x147B8eb97fD247D06C4006D269c90C1908Fb5D54")
+	//加载智能合约实例  地址从部署清单里按名字取
+	manager, err := deploy.NewManager(context.Background(), client, *dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	address, err := manager.Address(context.Background(), "Store")
+	if err != nil {
+		log.Fatal(err)
+	}
 	instance, err := store.NewStore(address, client)
 	if err != nil {
 		log.Fatal(err)
 	}
+	//合约 revert 时节点只返回 "execution reverted" 和一段 revert 数据，
+	//abicall 把它解码成 *abicall.RevertError：Error(string) 的消息、Panic 的错误码，或者按 ABI 解码的自定义错误
+	parsed, err := store.StoreMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err)
+	}
+	//GasLimit 不写死，preflight.Guard 在签名前估算 gas 并加余量，会 revert 或余额不够时交易不发出
+	auth = preflight.Guard(auth, client, preflight.Options{ABIs: []abi.ABI{*parsed}})
 
 	//设置数据（调用智能合约中的 SetItem 方法）
 	key := [32]byte{}
@@ -59,17 +74,27 @@ func main() {
 	copy(value[:], []byte("bar"))
 	//准备好 key 和 value，并调用合约的 SetItem 方法，将这些数据写入合约存储中。
 	//SetItem 方法会发送一笔交易，交易会被广播到网络中，tx.Hash().Hex() 打印出该交易的哈希值，方便追踪。
+	//发送前检查就 revert 的，err 是已经解码好的 *abicall.RevertError
 	tx, err := instance.SetItem(auth, key, value)
 	if err != nil {
-		log.Fatal(err)
+		log.Fatal(abicall.WrapRevert(err, *parsed))
 	}
 
-	fmt.Printf("tx sent: %s", tx.Hash().Hex()) // tx sent: 0x8d490e535678e9a24360e955d75b27ad307bdfb97a1dca51d0f3035dcee3e870
+	fmt.Printf("tx sent: %s\n", tx.Hash().Hex()) // tx sent: 0x8d490e535678e9a24360e955d75b27ad307bdfb97a1dca51d0f3035dcee3e870
 
-	result, err := instance.Items(nil, key)
+	//等交易上链。上链后失败的交易回执里没有原因，在父区块上重放一次取回 revert 数据
+	receipt, err := bind.WaitMined(context.Background(), client, tx)
 	if err != nil {
 		log.Fatal(err)
 	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		log.Fatal(abicall.ReplayRevert(context.Background(), client, tx, receipt, *parsed))
+	}
+
+	result, err := instance.Items(nil, key)
+	if err != nil {
+		log.Fatal(abicall.WrapRevert(err, *parsed))
+	}
 
 	fmt.Println(string(result[:])) // "bar"
 }
@@ -0,0 +1,208 @@
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"ethkit/preflight"
+	"ethkit/signer"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	ErrNotDeployed  = errors.New("contract not deployed")
+	ErrCodeMismatch = errors.New("on-chain code does not match artifact")
+)
+
+// Backend 是部署、发送前检查和校验需要的链接口，*ethclient.Client 和 simulated.Client 都满足
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Contract 是要部署的合约产物
+type Contract struct {
+	Name    string
+	ABI     string
+	Bin     string // 创建字节码
+	Runtime string // 运行时字节码，为空时校验链上代码是否包含在创建字节码里
+}
+
+// FromMetaData 由 abigen 生成的 MetaData 构造 Contract
+func FromMetaData(name string, md *bind.MetaData) Contract {
+	return Contract{Name: name, ABI: md.ABI, Bin: md.Bin}
+}
+
+// Manager 负责部署合约并把结果记录到对应链 ID 的清单里
+type Manager struct {
+	backend  Backend
+	manifest *Manifest
+
+	// WaitMined 等待交易上链，默认是 bind.WaitMined；模拟链上可以换成直接出块
+	WaitMined func(ctx context.Context, tx *types.Transaction) (*types.Receipt, error)
+	// Preflight 是部署交易发送前检查的选项，合约自己的 ABI 会自动加进 ABIs
+	Preflight preflight.Options
+}
+
+// NewManager 查询链 ID 和创世块，读取 dir 下对应的清单
+func NewManager(ctx context.Context, backend Backend, dir string) (*Manager, error) {
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	genesis, err := backend.HeaderByNumber(ctx, big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := LoadManifest(dir, chainID.Uint64(), genesis.Hash())
+	if err != nil {
+		return nil, err
+	}
+	m := &Manager{backend: backend, manifest: manifest}
+	m.WaitMined = func(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+		return bind.WaitMined(ctx, backend, tx)
+	}
+	return m, nil
+}
+
+func (m *Manager) Manifest() *Manifest {
+	return m.manifest
+}
+
+// Deploy 部署合约。清单里已经有字节码和构造参数都相同、且链上代码校验通过的记录时直接返回，
+// fresh 为 false；链被重置（地址上没有代码）或字节码、参数变化时重新部署
+func (m *Manager) Deploy(ctx context.Context, auth *bind.TransactOpts, c Contract, args ...any) (rec Record, fresh bool, err error) {
+	parsed, err := abi.JSON(strings.NewReader(c.ABI))
+	if err != nil {
+		return Record{}, false, err
+	}
+	argsData, err := parsed.Pack("", args...)
+	if err != nil {
+		return Record{}, false, fmt.Errorf("%s constructor: %w", c.Name, err)
+	}
+	bin := common.FromHex(c.Bin)
+	bytecodeHash := crypto.Keccak256Hash(bin)
+
+	if old, ok := m.manifest.Contracts[c.Name]; ok && old.BytecodeHash == bytecodeHash && bytes.Equal(old.ArgsData, argsData) {
+		_, err := m.Verify(ctx, c)
+		if err == nil {
+			return old, false, nil
+		}
+		if !errors.Is(err, ErrNotDeployed) {
+			return Record{}, false, err
+		}
+	}
+
+	// gas 按估算值加余量，构造函数会 revert 或余额不够时不发送；检查和发送都用 ctx
+	opts := m.Preflight
+	opts.ABIs = append([]abi.ABI{parsed}, opts.ABIs...)
+	guarded := signer.WithContext(ctx, preflight.Guard(auth, m.backend, opts))
+	address, tx, _, err := bind.DeployContract(guarded, parsed, bin, m.backend, args...)
+	if err != nil {
+		return Record{}, false, err
+	}
+	receipt, err := m.WaitMined(ctx, tx)
+	if err != nil {
+		return Record{}, false, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return Record{}, false, fmt.Errorf("%s: deploy tx %s reverted", c.Name, tx.Hash().Hex())
+	}
+	code, err := m.backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return Record{}, false, err
+	}
+	if !matches(c, code) {
+		return Record{}, false, fmt.Errorf("%s at %s: %w", c.Name, address.Hex(), ErrCodeMismatch)
+	}
+
+	now := time.Now().UTC()
+	rec = Record{
+		Address:      address,
+		TxHash:       tx.Hash(),
+		Block:        receipt.BlockNumber.Uint64(),
+		Deployer:     auth.From,
+		Args:         args,
+		ArgsData:     argsData,
+		BytecodeHash: bytecodeHash,
+		RuntimeHash:  crypto.Keccak256Hash(code),
+		DeployedAt:   &now,
+	}
+	m.manifest.Contracts[c.Name] = rec
+	if err := m.manifest.Save(); err != nil {
+		return rec, true, err
+	}
+	return rec, true, nil
+}
+
+// Verify 校验清单里 c.Name 对应地址上的代码：代码存在、和部署时记录的哈希一致、和产物匹配
+func (m *Manager) Verify(ctx context.Context, c Contract) (Record, error) {
+	rec, code, err := m.code(ctx, c.Name)
+	if err != nil {
+		return Record{}, err
+	}
+	if !matches(c, code) {
+		return Record{}, fmt.Errorf("%s at %s: %w", c.Name, rec.Address.Hex(), ErrCodeMismatch)
+	}
+	return rec, nil
+}
+
+// Address 按名字取合约地址，并确认地址上的代码和部署时一致
+func (m *Manager) Address(ctx context.Context, name string) (common.Address, error) {
+	rec, _, err := m.code(ctx, name)
+	return rec.Address, err
+}
+
+func (m *Manager) code(ctx context.Context, name string) (Record, []byte, error) {
+	rec, ok := m.manifest.Contracts[name]
+	if !ok {
+		return Record{}, nil, fmt.Errorf("%s: %w on chain %d", name, ErrNotDeployed, m.manifest.ChainID)
+	}
+	code, err := m.backend.CodeAt(ctx, rec.Address, nil)
+	if err != nil {
+		return Record{}, nil, err
+	}
+	if len(code) == 0 {
+		return Record{}, nil, fmt.Errorf("%s: %w at %s", name, ErrNotDeployed, rec.Address.Hex())
+	}
+	if rec.RuntimeHash != (common.Hash{}) && crypto.Keccak256Hash(code) != rec.RuntimeHash {
+		return Record{}, nil, fmt.Errorf("%s at %s: %w", name, rec.Address.Hex(), ErrCodeMismatch)
+	}
+	return rec, code, nil
+}
+
+// matches 比较链上代码和产物。没有运行时字节码时，利用 solc 的创建字节码里原样包含运行时代码
+// （包括末尾的 metadata 哈希）这一点来判断。带 immutable 变量的合约部署时会把值写进运行时代码里
+// 预留的全 0 位置，所以有运行时字节码时只要求长度相同、产物里非 0 的字节一致；
+// 没有运行时字节码的 immutable 合约无法校验
+func matches(c Contract, code []byte) bool {
+	if c.Runtime != "" {
+		return sameCode(code, common.FromHex(c.Runtime))
+	}
+	return len(code) > 0 && bytes.Contains(common.FromHex(c.Bin), code)
+}
+
+// sameCode 比较链上代码和运行时字节码，runtime 里为 0 的字节可能是 immutable 的占位，不参与比较
+func sameCode(code, runtime []byte) bool {
+	if len(code) != len(runtime) {
+		return false
+	}
+	for i, b := range runtime {
+		if b != 0 && code[i] != b {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,338 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"ethkit/contracts/token"
+	"ethkit/preflight"
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	store "store/contracts"
+)
+
+var (
+	storeContract = FromMetaData("Store", store.StoreMetaData)
+	tokenContract = FromMetaData("Token", token.TokenMetaData)
+)
+
+// newManager 在模拟链上创建 Manager，WaitMined 换成直接出块
+func newManager(t *testing.T, chain *simchain.Chain, dir string) *Manager {
+	t.Helper()
+	m, err := NewManager(context.Background(), chain.Client, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.WaitMined = func(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+		chain.Commit()
+		return chain.Client.TransactionReceipt(ctx, tx.Hash())
+	}
+	return m
+}
+
+func newChain(t *testing.T, n int) *simchain.Chain {
+	t.Helper()
+	chain, err := simchain.New(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	return chain
+}
+
+func TestDeploy(t *testing.T) {
+	chain := newChain(t, 2)
+	ctx := context.Background()
+	dir := t.TempDir()
+	m := newManager(t, chain, dir)
+	auth := chain.Transactor(0)
+
+	rec, fresh, err := m.Deploy(ctx, auth, storeContract, "1.0")
+	if err != nil || !fresh {
+		t.Fatalf("first deploy: fresh %v, %v", fresh, err)
+	}
+	if rec.Deployer != auth.From || rec.Block == 0 || rec.BytecodeHash != crypto.Keccak256Hash(common.FromHex(storeContract.Bin)) ||
+		rec.RuntimeHash == (common.Hash{}) || rec.DeployedAt == nil {
+		t.Fatalf("record %+v", rec)
+	}
+	s, err := store.NewStore(rec.Address, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := s.Version(nil); err != nil || v != "1.0" {
+		t.Fatalf("version = %q, %v", v, err)
+	}
+
+	nonce := func() uint64 {
+		n, err := chain.Client.PendingNonceAt(ctx, auth.From)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+	before := nonce()
+
+	// 字节码和参数都没变：不发交易，返回原来的记录
+	again, fresh, err := m.Deploy(ctx, auth, storeContract, "1.0")
+	if err != nil || fresh || again.Address != rec.Address || again.TxHash != rec.TxHash {
+		t.Fatalf("second deploy: %+v fresh %v, %v", again, fresh, err)
+	}
+	if nonce() != before {
+		t.Fatal("second deploy sent a transaction")
+	}
+	// 重新打开清单文件也一样
+	if again, fresh, err := newManager(t, chain, dir).Deploy(ctx, auth, storeContract, "1.0"); err != nil || fresh || again.Address != rec.Address {
+		t.Fatalf("deploy after reload: fresh %v, %v", fresh, err)
+	}
+
+	// 构造参数变了：重新部署
+	v2, fresh, err := m.Deploy(ctx, auth, storeContract, "2.0")
+	if err != nil || !fresh || v2.Address == rec.Address {
+		t.Fatalf("new args: %+v fresh %v, %v", v2, fresh, err)
+	}
+	if got := m.Manifest().Contracts["Store"]; got.Address != v2.Address || got.Args[0] != "2.0" {
+		t.Fatalf("manifest %+v", got)
+	}
+
+	// 字节码变了（重新编译，创建字节码末尾多了数据）：重新部署，运行时代码不变也一样
+	tok, fresh, err := m.Deploy(ctx, auth, tokenContract)
+	if err != nil || !fresh {
+		t.Fatalf("token: fresh %v, %v", fresh, err)
+	}
+	rebuilt := tokenContract
+	rebuilt.Bin += "00"
+	tok2, fresh, err := m.Deploy(ctx, auth, rebuilt)
+	if err != nil || !fresh || tok2.Address == tok.Address || tok2.BytecodeHash == tok.BytecodeHash || tok2.RuntimeHash != tok.RuntimeHash {
+		t.Fatalf("rebuilt token: %+v fresh %v, %v", tok2, fresh, err)
+	}
+
+	// 构造参数打包失败、构造参数个数不对时不发送
+	if _, _, err := m.Deploy(ctx, auth, storeContract, 1); err == nil {
+		t.Fatal("deployed with a wrong constructor argument")
+	}
+}
+
+// 清单里的地址上没有代码（链被重置）时重新部署
+func TestDeployAfterReset(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	chain := newChain(t, 2)
+	rec, _, err := newManager(t, chain, dir).Deploy(ctx, chain.Transactor(0), storeContract, "1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Close()
+
+	// 同样的账户，创世块相同；部署账户 nonce 从 0 开始，地址也相同
+	chain = newChain(t, 2)
+	m := newManager(t, chain, dir)
+	if _, err := m.Verify(ctx, storeContract); !errors.Is(err, ErrNotDeployed) {
+		t.Fatalf("verify after reset: %v", err)
+	}
+	again, fresh, err := m.Deploy(ctx, chain.Transactor(0), storeContract, "1.0")
+	if err != nil || !fresh || again.TxHash == (common.Hash{}) || again.Address != rec.Address {
+		t.Fatalf("deploy after reset: %+v fresh %v, %v", again, fresh, err)
+	}
+}
+
+// 链 ID 相同、创世块不同的链不使用这份清单，也不覆盖它
+func TestOtherChain(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	chain := newChain(t, 2)
+	if _, _, err := newManager(t, chain, dir).Deploy(ctx, chain.Transactor(0), storeContract, "1.0"); err != nil {
+		t.Fatal(err)
+	}
+	path := ManifestPath(dir, chain.ChainID.Uint64())
+	saved, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other := newChain(t, 3)
+	if other.ChainID.Cmp(chain.ChainID) != 0 {
+		t.Fatalf("chain IDs %s and %s", other.ChainID, chain.ChainID)
+	}
+	if _, err := NewManager(ctx, other.Client, dir); !errors.Is(err, ErrOtherChain) {
+		t.Fatalf("other genesis: %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != string(saved) {
+		t.Fatal("manifest changed")
+	}
+	// 另一条链用自己的目录
+	if _, _, err := newManager(t, other, t.TempDir()).Deploy(ctx, other.Transactor(0), storeContract, "1.0"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	chain := newChain(t, 2)
+	ctx := context.Background()
+	m := newManager(t, chain, t.TempDir())
+	rec, _, err := m.Deploy(ctx, chain.Transactor(0), storeContract, "1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := m.Verify(ctx, storeContract); err != nil || got.Address != rec.Address {
+		t.Fatalf("verify: %v", err)
+	}
+	if addr, err := m.Address(ctx, "Store"); err != nil || addr != rec.Address {
+		t.Fatalf("address: %s, %v", addr.Hex(), err)
+	}
+	if _, err := m.Verify(ctx, tokenContract); !errors.Is(err, ErrNotDeployed) {
+		t.Fatalf("verify undeployed: %v", err)
+	}
+
+	// 产物和链上代码不一致：名字相同、字节码不同
+	wrong := tokenContract
+	wrong.Name = "Store"
+	if _, err := m.Verify(ctx, wrong); !errors.Is(err, ErrCodeMismatch) {
+		t.Fatalf("verify other artifact: %v", err)
+	}
+	// 有运行时字节码时按它比较
+	code, err := chain.Client.CodeAt(ctx, rec.Address, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withRuntime := storeContract
+	withRuntime.Runtime = common.Bytes2Hex(code)
+	if _, err := m.Verify(ctx, withRuntime); err != nil {
+		t.Fatalf("verify runtime: %v", err)
+	}
+	withRuntime.Runtime = common.Bytes2Hex(code[:len(code)-1])
+	if _, err := m.Verify(ctx, withRuntime); !errors.Is(err, ErrCodeMismatch) {
+		t.Fatalf("verify short runtime: %v", err)
+	}
+
+	// 链上代码和部署时记录的哈希不同，Deploy 也不会当成已部署，报错而不是重复部署
+	tampered := m.Manifest().Contracts["Store"]
+	tampered.RuntimeHash = common.HexToHash("0x01")
+	m.Manifest().Contracts["Store"] = tampered
+	if _, err := m.Verify(ctx, storeContract); !errors.Is(err, ErrCodeMismatch) {
+		t.Fatalf("verify changed runtime hash: %v", err)
+	}
+	if _, err := m.Address(ctx, "Store"); !errors.Is(err, ErrCodeMismatch) {
+		t.Fatalf("address with changed runtime hash: %v", err)
+	}
+	if _, _, err := m.Deploy(ctx, chain.Transactor(0), storeContract, "1.0"); !errors.Is(err, ErrCodeMismatch) {
+		t.Fatalf("deploy with changed runtime hash: %v", err)
+	}
+}
+
+// 部署交易发送前检查：余额不够时不发送，清单不变
+func TestDeployPreflight(t *testing.T) {
+	chain := newChain(t, 1)
+	ctx := context.Background()
+	m := newManager(t, chain, t.TempDir())
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Accounts = append(chain.Accounts, simchain.Account{Key: key, Address: crypto.PubkeyToAddress(key.PublicKey)})
+	if _, _, err := m.Deploy(ctx, chain.Transactor(1), storeContract, "1.0"); !errors.Is(err, preflight.ErrInsufficientFunds) {
+		t.Fatalf("deploy without funds: %v", err)
+	}
+	if len(m.Manifest().Contracts) != 0 {
+		t.Fatalf("manifest %+v", m.Manifest().Contracts)
+	}
+}
+
+func TestManifest(t *testing.T) {
+	dir := t.TempDir()
+	genesis := common.HexToHash("0x1234")
+	m, err := LoadManifest(dir, 1337, genesis)
+	if err != nil || len(m.Contracts) != 0 {
+		t.Fatalf("new manifest: %+v, %v", m, err)
+	}
+	rec := Record{
+		Address:      common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3"),
+		TxHash:       common.HexToHash("0xaa"),
+		Block:        7,
+		Deployer:     common.HexToAddress("0x01"),
+		Args:         []any{"1.0", big.NewInt(5)},
+		ArgsData:     []byte{1, 2, 3},
+		BytecodeHash: common.HexToHash("0xbb"),
+		RuntimeHash:  common.HexToHash("0xcc"),
+	}
+	m.Contracts["Store"] = rec
+	if err := m.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(ManifestPath(dir, 1337) + ".tmp"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("temp file left: %v", err)
+	}
+
+	loaded, err := LoadManifest(dir, 1337, genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := loaded.Contracts["Store"]
+	if loaded.ChainID != 1337 || loaded.Genesis != genesis || got.Address != rec.Address || got.TxHash != rec.TxHash ||
+		got.Block != 7 || got.Deployer != rec.Deployer || string(got.ArgsData) != string(rec.ArgsData) ||
+		got.BytecodeHash != rec.BytecodeHash || got.RuntimeHash != rec.RuntimeHash || got.DeployedAt != nil {
+		t.Fatalf("loaded %+v", got)
+	}
+	// 构造参数按 JSON 读回来，大整数是数字
+	if len(got.Args) != 2 || got.Args[0] != "1.0" || got.Args[1] != float64(5) {
+		t.Fatalf("args %#v", got.Args)
+	}
+
+	if _, err := LoadManifest(dir, 1337, common.HexToHash("0x5678")); !errors.Is(err, ErrOtherChain) {
+		t.Fatalf("other genesis: %v", err)
+	}
+	// 别的链 ID 是另一个文件
+	if m, err := LoadManifest(dir, 5, genesis); err != nil || len(m.Contracts) != 0 {
+		t.Fatalf("other chain ID: %+v, %v", m, err)
+	}
+
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 旧文件没有 genesis：按当前链补上
+	write("31337.json", `{"chainId": 31337, "contracts": {"Store": {"address": "0x5FbDB2315678afecb367f032d93F642f64180aa3"}}}`)
+	old, err := LoadManifest(dir, 31337, genesis)
+	if err != nil || old.Genesis != genesis || old.Contracts["Store"].Address != rec.Address {
+		t.Fatalf("legacy manifest: %+v, %v", old, err)
+	}
+	write("10.json", `{"chainId": 11, "contracts": {}}`)
+	if _, err := LoadManifest(dir, 10, genesis); err == nil {
+		t.Fatal("loaded a manifest of another chain ID")
+	}
+	write("20.json", `{"chainId": 20}`)
+	if m, err := LoadManifest(dir, 20, genesis); err != nil || m.Contracts == nil {
+		t.Fatalf("manifest without contracts: %+v, %v", m, err)
+	}
+	write("30.json", `{`)
+	if _, err := LoadManifest(dir, 30, genesis); err == nil {
+		t.Fatal("loaded a broken manifest")
+	}
+}
+
+func TestSameCode(t *testing.T) {
+	tests := []struct {
+		name          string
+		code, runtime []byte
+		want          bool
+	}{
+		{"equal", []byte{1, 2, 3}, []byte{1, 2, 3}, true},
+		{"immutable placeholder", []byte{1, 9, 9, 3}, []byte{1, 0, 0, 3}, true},
+		{"different byte", []byte{1, 2, 4}, []byte{1, 2, 3}, false},
+		{"shorter", []byte{1, 2}, []byte{1, 2, 3}, false},
+		{"longer", []byte{1, 2, 3, 0}, []byte{1, 2, 3}, false},
+	}
+	for _, tt := range tests {
+		if got := sameCode(tt.code, tt.runtime); got != tt.want {
+			t.Errorf("%s: sameCode = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package deploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Record 是一次部署的记录
+type Record struct {
+	Address      common.Address `json:"address"`
+	TxHash       common.Hash    `json:"txHash"`
+	Block        uint64         `json:"block,omitempty"`
+	Deployer     common.Address `json:"deployer"`
+	Args         []any          `json:"constructorArgs"`
+	ArgsData     hexutil.Bytes  `json:"constructorArgsData"` // ABI 编码后的构造参数，用来判断参数是否变化
+	BytecodeHash common.Hash    `json:"bytecodeHash"`        // keccak256(创建字节码)
+	RuntimeHash  common.Hash    `json:"runtimeHash"`         // keccak256(链上代码)，全 0 表示未知
+	DeployedAt   *time.Time     `json:"deployedAt,omitempty"`
+}
+
+// ErrOtherChain 表示记录文件属于链 ID 相同、但创世块不同的另一条链，比如 geth --dev 和本地模拟链都用 1337
+var ErrOtherChain = errors.New("deployment records belong to another chain with the same chain ID")
+
+// Manifest 是某条链上所有合约的部署记录，按链 ID 分文件保存：<dir>/<chainID>.json
+type Manifest struct {
+	ChainID uint64 `json:"chainId"`
+	// Genesis 是创世块哈希，链 ID 相同的不同链靠它区分；旧文件里没有时按当前链补上
+	Genesis   common.Hash       `json:"genesis"`
+	Contracts map[string]Record `json:"contracts"`
+
+	path string
+}
+
+func ManifestPath(dir string, chainID uint64) string {
+	return filepath.Join(dir, strconv.FormatUint(chainID, 10)+".json")
+}
+
+// LoadManifest 读取 dir 下 chainID 的部署记录，文件不存在时返回空的 Manifest。
+// 文件里记录的创世块和 genesis 不同时返回 ErrOtherChain，不会把另一条链的记录覆盖掉
+func LoadManifest(dir string, chainID uint64, genesis common.Hash) (*Manifest, error) {
+	m := &Manifest{ChainID: chainID, Genesis: genesis, Contracts: map[string]Record{}, path: ManifestPath(dir, chainID)}
+	data, err := os.ReadFile(m.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("manifest %s: %w", m.path, err)
+	}
+	if m.ChainID != chainID {
+		return nil, fmt.Errorf("manifest %s is for chain %d, not %d", m.path, m.ChainID, chainID)
+	}
+	if m.Genesis == (common.Hash{}) {
+		m.Genesis = genesis
+	}
+	if m.Genesis != genesis {
+		return nil, fmt.Errorf("manifest %s: %w (genesis %s, connected to %s)", m.path, ErrOtherChain, m.Genesis.Hex(), genesis.Hex())
+	}
+	if m.Contracts == nil {
+		m.Contracts = map[string]Record{}
+	}
+	return m, nil
+}
+
+// Save 先写临时文件再改名，避免写到一半中断把清单写坏
+func (m *Manifest) Save() error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(m.path), 0o755); err != nil {
+		return err
+	}
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.path)
+}
@@ -0,0 +1,287 @@
+package abicall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"ethkit/artifact"
+	"ethkit/signer"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Backend 是调用和部署合约需要的链接口，*ethclient.Client 和 simulated.Client 都满足。
+// 其他包的客户端在它的基础上按需加上读区块之类的方法
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Contract 只靠 ABI 调用任意合约，不需要 abigen 生成的绑定
+type Contract struct {
+	Address common.Address
+	ABI     abi.ABI
+
+	backend Backend
+	bound   *bind.BoundContract
+}
+
+func New(address common.Address, parsed abi.ABI, backend Backend) *Contract {
+	return &Contract{
+		Address: address,
+		ABI:     parsed,
+		backend: backend,
+		bound:   bind.NewBoundContract(address, parsed, backend, backend, backend),
+	}
+}
+
+// Value 是一个解码后的返回值或事件参数
+type Value struct {
+	Name  string
+	Type  string
+	Value any
+}
+
+func (v Value) String() string {
+	return Format(v.Value)
+}
+
+// MarshalJSON 输出格式化后的值，大整数不会在 JSON 里丢精度
+func (v Value) MarshalJSON() ([]byte, error) {
+	text, ok := v.Value.(string)
+	if !ok {
+		text = Format(v.Value)
+	}
+	return json.Marshal(struct {
+		Name  string `json:"name"`
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}{v.Name, v.Type, text})
+}
+
+// Method 按名称、完整签名 transfer(address,uint256) 或 4 字节选择器 0xa9059cbb 查找方法。
+// 重载的方法只能用签名或选择器指定
+func (c *Contract) Method(name string) (abi.Method, error) {
+	if strings.HasPrefix(name, "0x") && len(name) == 10 {
+		id, err := hexutil.Decode(name)
+		if err != nil {
+			return abi.Method{}, err
+		}
+		m, err := c.ABI.MethodById(id)
+		if err != nil {
+			return abi.Method{}, fmt.Errorf("abicall: no method with selector %s", name)
+		}
+		return *m, nil
+	}
+	if strings.Contains(name, "(") {
+		for _, m := range c.ABI.Methods {
+			if m.Sig == strings.ReplaceAll(name, " ", "") {
+				return m, nil
+			}
+		}
+		return abi.Method{}, fmt.Errorf("abicall: no method %s", name)
+	}
+	var found []abi.Method
+	for _, m := range c.ABI.Methods {
+		if m.RawName == name {
+			found = append(found, m)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return abi.Method{}, fmt.Errorf("abicall: no method %s", name)
+	case 1:
+		return found[0], nil
+	}
+	sigs := make([]string, len(found))
+	for i, m := range found {
+		sigs[i] = m.Sig
+	}
+	sort.Strings(sigs)
+	return abi.Method{}, fmt.Errorf("abicall: %s is overloaded, use one of %s", name, strings.Join(sigs, ", "))
+}
+
+// Methods 返回所有方法，按签名排序
+func (c *Contract) Methods() []abi.Method {
+	out := make([]abi.Method, 0, len(c.ABI.Methods))
+	for _, m := range c.ABI.Methods {
+		out = append(out, m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Sig < out[j].Sig })
+	return out
+}
+
+// Call 用字符串参数 eth_call 一个方法并解码返回值，block 为 nil 表示最新区块。
+// 合约 revert 时返回 *RevertError
+func (c *Contract) Call(ctx context.Context, from common.Address, method string, args []string, block *big.Int) ([]Value, error) {
+	m, err := c.Method(method)
+	if err != nil {
+		return nil, err
+	}
+	input, err := c.pack(m, args)
+	if err != nil {
+		return nil, err
+	}
+	to := c.Address
+	output, err := c.backend.CallContract(ctx, ethereum.CallMsg{From: from, To: &to, Data: input}, block)
+	if err != nil {
+		return nil, c.wrap(err)
+	}
+	if len(output) == 0 && len(m.Outputs) > 0 {
+		if code, err := c.backend.CodeAt(ctx, c.Address, block); err == nil && len(code) == 0 {
+			return nil, bind.ErrNoCode
+		}
+	}
+	values, err := m.Outputs.Unpack(output)
+	if err != nil {
+		return nil, fmt.Errorf("abicall: decode %s output: %w", m.Sig, err)
+	}
+	return named(m.Outputs, values), nil
+}
+
+// Send 用字符串参数发送交易，value 为附带的 wei。估算 gas 时 revert 返回 *RevertError
+func (c *Contract) Send(ctx context.Context, auth *bind.TransactOpts, method string, args []string, value *big.Int) (*types.Transaction, error) {
+	m, err := c.Method(method)
+	if err != nil {
+		return nil, err
+	}
+	if value != nil && value.Sign() > 0 && !m.Payable {
+		return nil, fmt.Errorf("abicall: %s is not payable", m.Sig)
+	}
+	input, err := c.pack(m, args)
+	if err != nil {
+		return nil, err
+	}
+	opts := signer.WithContext(ctx, auth)
+	opts.Value = value
+	tx, err := c.bound.RawTransact(opts, input)
+	if err != nil {
+		return nil, c.wrap(err)
+	}
+	return tx, nil
+}
+
+func (c *Contract) pack(m abi.Method, args []string) ([]byte, error) {
+	parsed, err := artifact.ParseArgs(m.Inputs, args)
+	if err != nil {
+		return nil, fmt.Errorf("abicall: %s: %w", m.Sig, err)
+	}
+	packed, err := m.Inputs.Pack(parsed...)
+	if err != nil {
+		return nil, fmt.Errorf("abicall: %s: %w", m.Sig, err)
+	}
+	return append(append([]byte{}, m.ID...), packed...), nil
+}
+
+// Event 是一条解码后的日志
+type Event struct {
+	Name   string
+	Sig    string
+	Args   []Value
+	Block  uint64
+	TxHash common.Hash
+	Index  uint
+}
+
+// Events 读取 [from, to] 区间内合约的日志并按 ABI 解码，name 为空时返回所有事件。
+// ABI 里没有的日志用 Name 为空、Args 为空的 Event 表示
+func (c *Contract) Events(ctx context.Context, name string, from, to *big.Int) ([]Event, error) {
+	q := ethereum.FilterQuery{FromBlock: from, ToBlock: to, Addresses: []common.Address{c.Address}}
+	if name != "" {
+		e, ok := c.ABI.Events[name]
+		if !ok {
+			return nil, fmt.Errorf("abicall: no event %s", name)
+		}
+		q.Topics = [][]common.Hash{{e.ID}}
+	}
+	logs, err := c.backend.FilterLogs(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Event, 0, len(logs))
+	for _, l := range logs {
+		e, err := c.DecodeLog(l)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+// DecodeLog 按 ABI 解码一条日志，包括 indexed 参数
+func (c *Contract) DecodeLog(l types.Log) (Event, error) {
+	ev := Event{Block: l.BlockNumber, TxHash: l.TxHash, Index: l.Index}
+	if len(l.Topics) == 0 {
+		return ev, nil
+	}
+	e, err := c.ABI.EventByID(l.Topics[0])
+	if err != nil {
+		return ev, nil // ABI 里没有这个事件
+	}
+	ev.Name, ev.Sig = e.Name, e.Sig
+	if ev.Args, err = DecodeEvent(*e, l); err != nil {
+		return ev, fmt.Errorf("abicall: tx %s: %w", l.TxHash.Hex(), err)
+	}
+	return ev, nil
+}
+
+// DecodeEvent 按事件定义解码日志的全部参数，包括 indexed 参数，顺序和定义一致。
+// topic 数量和定义里 indexed 参数的数量不一致时返回错误
+func DecodeEvent(e abi.Event, l types.Log) ([]Value, error) {
+	data, err := e.Inputs.NonIndexed().Unpack(l.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s: %w", e.Sig, err)
+	}
+	if len(l.Topics) == 0 {
+		return nil, fmt.Errorf("decode %s: no topics", e.Sig)
+	}
+	topics := l.Topics[1:]
+	args := make([]Value, 0, len(e.Inputs))
+	for i, arg := range e.Inputs {
+		var v any
+		if arg.Indexed {
+			if len(topics) == 0 {
+				return nil, fmt.Errorf("decode %s: missing topic for %s", e.Sig, argName(arg, i))
+			}
+			// 逐个解码，匿名参数也能取到值；string、bytes 等动态类型的 topic 只是哈希，原样返回
+			field := map[string]any{}
+			one := abi.Arguments{{Name: "v", Type: arg.Type, Indexed: true}}
+			if err := abi.ParseTopicsIntoMap(field, one, topics[:1]); err != nil {
+				return nil, fmt.Errorf("decode %s topics: %w", e.Sig, err)
+			}
+			v, topics = field["v"], topics[1:]
+		} else {
+			v, data = data[0], data[1:]
+		}
+		args = append(args, Value{Name: argName(arg, i), Type: arg.Type.String(), Value: v})
+	}
+	if len(topics) > 0 {
+		return nil, fmt.Errorf("decode %s: %d extra topics", e.Sig, len(topics))
+	}
+	return args, nil
+}
+
+func named(args abi.Arguments, values []any) []Value {
+	out := make([]Value, len(values))
+	for i, v := range values {
+		out[i] = Value{Name: argName(args[i], i), Type: args[i].Type.String(), Value: v}
+	}
+	return out
+}
+
+func argName(arg abi.Argument, i int) string {
+	if arg.Name == "" {
+		return fmt.Sprintf("[%d]", i)
+	}
+	return arg.Name
+}
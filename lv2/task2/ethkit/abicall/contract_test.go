@@ -0,0 +1,215 @@
+package abicall_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"ethkit/abicall"
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	store "store/contracts"
+)
+
+func TestFormat(t *testing.T) {
+	addr := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	var nilInt *big.Int
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{"nil", nil, "<nil>"},
+		{"nil pointer", nilInt, "<nil>"},
+		{"big int", new(big.Int).Lsh(big.NewInt(1), 100), "1267650600228229401496703205376"},
+		{"negative big int", big.NewInt(-5), "-5"},
+		{"uint8", uint8(255), "255"},
+		{"int64", int64(-1), "-1"},
+		{"bool", true, "true"},
+		{"address", addr, addr.Hex()},
+		{"hash", common.HexToHash("0x01"), "0x" + strings.Repeat("0", 63) + "1"},
+		{"bytes", []byte{0xde, 0xad}, "0xdead"},
+		{"empty bytes", []byte{}, "0x"},
+		{"bytes4", [4]byte{1, 2, 3, 4}, "0x01020304"},
+		{"string", "a \"b\"", `"a \"b\""`},
+		{"slice", []*big.Int{big.NewInt(1), big.NewInt(2)}, "[1, 2]"},
+		{"fixed array", [2]common.Address{addr, {}}, "[" + addr.Hex() + ", " + common.Address{}.Hex() + "]"},
+		{"nested", [][]uint16{{1}, {2, 3}}, "[[1], [2, 3]]"},
+		{"bytes list", [][]byte{{1}, {2, 3}}, "[0x01, 0x0203]"},
+		{"struct", struct {
+			To     common.Address
+			Amount *big.Int
+			Memo   []byte
+		}{addr, big.NewInt(7), []byte{1}}, "{To: " + addr.Hex() + ", Amount: 7, Memo: 0x01}"},
+		{"pointer to struct", &struct{ ID uint8 }{3}, "{ID: 3}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := abicall.Format(tt.v); got != tt.want {
+				t.Fatalf("Format = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	// JSON 里大整数按字符串输出，不丢精度
+	out, err := json.Marshal(abicall.Value{Name: "amount", Type: "uint256", Value: new(big.Int).Lsh(big.NewInt(1), 100)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"name":"amount","type":"uint256","value":"1267650600228229401496703205376"}` {
+		t.Fatalf("json = %s", out)
+	}
+	if out, _ := json.Marshal(abicall.Value{Name: "memo", Type: "string", Value: "hi"}); string(out) != `{"name":"memo","type":"string","value":"hi"}` {
+		t.Fatalf("string json = %s", out)
+	}
+}
+
+const overloaded = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"}],"outputs":[]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+func TestMethod(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(overloaded))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := abicall.New(common.Address{}, parsed, nil)
+	tests := []struct {
+		name, want, err string
+	}{
+		{name: "balanceOf", want: "balanceOf(address)"},
+		{name: "transfer(address,uint256)", want: "transfer(address,uint256)"},
+		{name: "transfer(address, uint256)", want: "transfer(address,uint256)"},
+		{name: "0x1a695230", want: "transfer(address)"},
+		{name: "transfer", err: "abicall: transfer is overloaded, use one of transfer(address), transfer(address,uint256)"},
+		{name: "approve", err: "abicall: no method approve"},
+		{name: "transfer(uint256)", err: "abicall: no method transfer(uint256)"},
+		{name: "0xdeadbeef", err: "abicall: no method with selector 0xdeadbeef"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := c.Method(tt.name)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil || m.Sig != tt.want {
+				t.Fatalf("method %s, %v; want %s", m.Sig, err, tt.want)
+			}
+		})
+	}
+	var sigs []string
+	for _, m := range c.Methods() {
+		sigs = append(sigs, m.Sig)
+	}
+	if got := strings.Join(sigs, " "); got != "balanceOf(address) transfer(address) transfer(address,uint256)" {
+		t.Fatalf("Methods = %s", got)
+	}
+}
+
+// 只用 ABI 和字符串参数走一遍 Store：写入、读回、查事件，结果和 abigen 绑定读到的一致
+func TestStoreRoundTrip(t *testing.T) {
+	chain, err := simchain.New(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	address, binding, _, err := chain.DeployStore(0, "1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := store.StoreMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := abicall.New(address, *parsed, chain.Client)
+	ctx := context.Background()
+	from := chain.Accounts[0].Address
+
+	version, err := c.Call(ctx, from, "version", nil, nil)
+	if err != nil || len(version) != 1 || version[0].Value != "1.0" || version[0].Name != "[0]" || version[0].Type != "string" {
+		t.Fatalf("version = %+v, %v", version, err)
+	}
+
+	key := "0x" + strings.Repeat("0", 62) + "01"
+	tests := []struct {
+		name   string
+		method string
+		args   []string
+		value  string
+		err    string
+	}{
+		{name: "by name", method: "setItem", args: []string{key, "0x6162"}, value: "0x6162" + strings.Repeat("0", 60)},
+		{name: "by signature", method: "setItem(bytes32,bytes32)", args: []string{key, "0xff"}, value: "0xff" + strings.Repeat("0", 62)},
+		{name: "by selector", method: "0xf56256c7", args: []string{key, "0x"}, value: "0x" + strings.Repeat("0", 64)},
+		{name: "too many bytes", method: "setItem", args: []string{key, "0x" + strings.Repeat("11", 33)},
+			err: "abicall: setItem(bytes32,bytes32): argument value (bytes32): 33 bytes do not fit in bytes32"},
+		{name: "missing argument", method: "setItem", args: []string{key},
+			err: "abicall: setItem(bytes32,bytes32): want 2 arguments, got 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := c.Send(ctx, chain.Transactor(0), tt.method, tt.args, nil)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := chain.Mine(tx); err != nil {
+				t.Fatal(err)
+			}
+			got, err := c.Call(ctx, from, "items", []string{key}, nil)
+			if err != nil || len(got) != 1 || got[0].String() != tt.value {
+				t.Fatalf("items = %v, %v; want %s", got, err, tt.value)
+			}
+			var k [32]byte
+			copy(k[:], common.FromHex(key))
+			want, err := binding.Items(&bind.CallOpts{}, k)
+			if err != nil || abicall.Format(want) != tt.value {
+				t.Fatalf("binding items = %x, %v", want, err)
+			}
+		})
+	}
+
+	// 不是 payable 的方法不能带 value
+	if _, err := c.Send(ctx, chain.Transactor(0), "setItem", []string{key, "0x01"}, big.NewInt(1)); err == nil ||
+		err.Error() != "abicall: setItem(bytes32,bytes32) is not payable" {
+		t.Fatalf("send value: %v", err)
+	}
+
+	events, err := c.Events(ctx, "ItemSet", big.NewInt(0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("%d events", len(events))
+	}
+	last := events[2]
+	if last.Name != "ItemSet" || last.Sig != "ItemSet(bytes32,bytes32)" || len(last.Args) != 2 ||
+		last.Args[0].Name != "key" || last.Args[0].String() != key || last.Args[1].String() != "0x"+strings.Repeat("0", 64) {
+		t.Fatalf("event = %+v", last)
+	}
+	if _, err := c.Events(ctx, "Missing", nil, nil); err == nil {
+		t.Fatal("unknown event accepted")
+	}
+
+	// 地址上没有合约时返回 bind.ErrNoCode，而不是解码错误
+	empty := abicall.New(common.HexToAddress("0x000000000000000000000000000000000000dEaD"), *parsed, chain.Client)
+	if _, err := empty.Call(ctx, from, "version", nil, nil); !errors.Is(err, bind.ErrNoCode) {
+		t.Fatalf("call without code: %v", err)
+	}
+}
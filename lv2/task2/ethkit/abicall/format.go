@@ -0,0 +1,58 @@
+package abicall
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Format 把 ABI 解码出来的值转成便于阅读的字符串：整数十进制，地址带校验和，
+// bytes 和 bytesN 十六进制，数组 [a, b]，结构体 {name: value}
+func Format(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return "<nil>"
+	case *big.Int:
+		return x.String()
+	case common.Address:
+		return x.Hex()
+	case common.Hash:
+		return x.Hex()
+	case []byte:
+		return hexutil.Encode(x)
+	case string:
+		return fmt.Sprintf("%q", x)
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		items := make([]string, rv.Len())
+		for i := range items {
+			items[i] = Format(rv.Index(i).Interface())
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case reflect.Struct:
+		fields := make([]string, rv.NumField())
+		for i := range fields {
+			fields[i] = fmt.Sprintf("%s: %s", rv.Type().Field(i).Name, Format(rv.Field(i).Interface()))
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return "<nil>"
+		}
+		return Format(rv.Elem().Interface())
+	}
+	return fmt.Sprint(v)
+}
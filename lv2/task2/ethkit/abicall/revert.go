@@ -0,0 +1,204 @@
+package abicall
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RevertKind 是 revert 数据的种类
+type RevertKind string
+
+const (
+	RevertEmpty   RevertKind = ""       // 没有返回数据：revert()、require 不带信息、gas 不足等
+	RevertString  RevertKind = "Error"  // Error(string)，require / revert("...")
+	RevertPanic   RevertKind = "Panic"  // Panic(uint256)，assert、溢出、除零、越界等
+	RevertCustom  RevertKind = "Custom" // ABI 里声明的自定义错误
+	RevertUnknown RevertKind = "Unknown"
+)
+
+var (
+	errorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
+
+// RevertError 是合约 revert 的错误。按 Kind 取对应字段：
+// Error(string) 看 Message，Panic(uint256) 看 Code，自定义错误看 Name 和 Args
+type RevertError struct {
+	Kind    RevertKind
+	Message string   // Error(string) 的信息
+	Code    *big.Int // Panic 的错误码，比如 0x11 溢出
+	Name    string   // 自定义错误名
+	Args    []Value  // 自定义错误的参数
+	Reason  string   // 解码后的可读原因，认不出来时是十六进制原文
+	Data    []byte   // revert 返回的原始数据，节点没有返回时为空
+
+	TxHash common.Hash // 重放已上链交易时才有
+	Block  *big.Int    // 重放时使用的区块，eth_call 时为空
+	Err    error
+}
+
+func (r *RevertError) Error() string {
+	msg := "execution reverted"
+	if r.Reason != "" {
+		msg += ": " + r.Reason
+	}
+	if r.TxHash != (common.Hash{}) {
+		msg = "tx " + r.TxHash.Hex() + " " + msg
+	}
+	return msg
+}
+
+func (r *RevertError) Unwrap() error {
+	return r.Err
+}
+
+// Arg 返回自定义错误里名为 name 的参数
+func (r *RevertError) Arg(name string) (any, bool) {
+	for _, a := range r.Args {
+		if a.Name == name {
+			return a.Value, true
+		}
+	}
+	return nil, false
+}
+
+// DecodeRevert 解码 revert 数据，自定义错误在 abis 里按选择器查找
+func DecodeRevert(data []byte, abis ...abi.ABI) *RevertError {
+	r := &RevertError{Data: data}
+	r.decode(abis)
+	return r
+}
+
+func (r *RevertError) decode(abis []abi.ABI) {
+	r.Kind, r.Message, r.Code, r.Name, r.Args, r.Reason = RevertEmpty, "", nil, "", nil, ""
+	data := r.Data
+	if len(data) == 0 {
+		return
+	}
+	r.Kind, r.Reason = RevertUnknown, hexutil.Encode(data)
+	if len(data) < 4 {
+		return
+	}
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		if msg, err := abi.UnpackRevert(data); err == nil {
+			r.Kind, r.Message, r.Reason = RevertString, msg, msg
+		}
+		return
+	case bytes.Equal(data[:4], panicSelector):
+		if len(data) == 36 {
+			reason, _ := abi.UnpackRevert(data)
+			r.Kind, r.Code = RevertPanic, new(big.Int).SetBytes(data[4:])
+			r.Reason = fmt.Sprintf("panic %#x: %s", r.Code, reason)
+		}
+		return
+	}
+	for _, parsed := range abis {
+		for _, e := range parsed.Errors {
+			if !bytes.Equal(e.ID[:4], data[:4]) {
+				continue
+			}
+			values, err := e.Inputs.Unpack(data[4:])
+			if err != nil {
+				continue
+			}
+			r.Kind, r.Name = RevertCustom, e.Name
+			r.Args = make([]Value, len(values))
+			text := make([]string, len(values))
+			for i, v := range values {
+				r.Args[i] = Value{Name: e.Inputs[i].Name, Type: e.Inputs[i].Type.String(), Value: v}
+				text[i] = Format(v)
+			}
+			r.Reason = fmt.Sprintf("%s(%s)", e.Name, strings.Join(text, ", "))
+			return
+		}
+	}
+}
+
+// Redecode 用其他 ABI 重新解码，用于 revert 来自被调用的另一个合约的情况
+func (r *RevertError) Redecode(abis ...abi.ABI) {
+	r.decode(abis)
+}
+
+// RevertData 取出节点错误里带的 revert 数据，err 不是 revert 时 ok 为 false
+func RevertData(err error) (data []byte, ok bool) {
+	if err == nil {
+		return nil, false
+	}
+	var rpcErr rpc.Error
+	if !(errors.As(err, &rpcErr) && rpcErr.ErrorCode() == 3) && !strings.Contains(err.Error(), "execution reverted") {
+		return nil, false
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if s, ok := dataErr.ErrorData().(string); ok {
+			data, _ = hexutil.Decode(s)
+		}
+	}
+	return data, true
+}
+
+// Reverted 判断 eth_call / EstimateGas 是否因为合约 revert 失败，而不是网络错误
+func Reverted(err error) bool {
+	_, ok := RevertData(err)
+	return ok
+}
+
+// WrapRevert 把 eth_call / EstimateGas 返回的 revert 错误转成 *RevertError，其他错误原样返回，
+// 已经是 *RevertError 的（比如 preflight 检查时解码过的）也原样返回
+func WrapRevert(err error, abis ...abi.ABI) error {
+	var decoded *RevertError
+	if errors.As(err, &decoded) {
+		return err
+	}
+	data, ok := RevertData(err)
+	if !ok {
+		return err
+	}
+	r := DecodeRevert(data, abis...)
+	r.Err = err
+	return r
+}
+
+func (c *Contract) wrap(err error) error {
+	return WrapRevert(err, c.ABI)
+}
+
+// ReplayRevert 在交易所在区块的父区块状态上重放一笔失败的交易，取回 revert 原因。
+// 同一区块里排在它前面的交易不会被重放，结果可能和链上不一致；需要节点保留父区块的状态
+func ReplayRevert(ctx context.Context, backend ethereum.ContractCaller, tx *types.Transaction, receipt *types.Receipt, abis ...abi.ABI) error {
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return err
+	}
+	msg := ethereum.CallMsg{From: from, To: tx.To(), Gas: tx.Gas(), Value: tx.Value(), Data: tx.Data()}
+	parent := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	_, err = backend.CallContract(ctx, msg, parent)
+	data, ok := RevertData(err)
+	if !ok {
+		if err != nil {
+			return fmt.Errorf("replay tx %s on block %s: %w", tx.Hash().Hex(), parent, err)
+		}
+		// 重放成功说明失败和同区块排在前面的交易有关
+		return &RevertError{Kind: RevertUnknown, Reason: "succeeds when replayed on the parent block", TxHash: tx.Hash(), Block: parent}
+	}
+	r := DecodeRevert(data, abis...)
+	r.TxHash, r.Block, r.Err = tx.Hash(), parent, err
+	return r
+}
+
+// ReplayRevert 同包级的 ReplayRevert，自定义错误按合约的 ABI 解码
+func (c *Contract) ReplayRevert(ctx context.Context, tx *types.Transaction, receipt *types.Receipt) error {
+	return ReplayRevert(ctx, c.backend, tx, receipt, c.ABI)
+}
@@ -0,0 +1,257 @@
+package abicall_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"ethkit/abicall"
+	"ethkit/contracts/token"
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func tokenABI(t *testing.T) abi.ABI {
+	t.Helper()
+	parsed, err := token.TokenMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *parsed
+}
+
+// pack 按签名拼出 revert 数据：4 字节选择器加 ABI 编码的参数
+func pack(t *testing.T, sig string, types []string, args ...any) []byte {
+	t.Helper()
+	var inputs abi.Arguments
+	for _, s := range types {
+		typ, err := abi.NewType(s, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs = append(inputs, abi.Argument{Type: typ})
+	}
+	data, err := inputs.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(crypto.Keccak256([]byte(sig))[:4], data...)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	parsed := tokenABI(t)
+	sender := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	balance := pack(t, "ERC20InsufficientBalance(address,uint256,uint256)", []string{"address", "uint256", "uint256"},
+		sender, big.NewInt(1), big.NewInt(2))
+	errorString := pack(t, "Error(string)", []string{"string"}, "not owner")
+
+	tests := []struct {
+		name   string
+		data   []byte
+		abis   []abi.ABI
+		kind   abicall.RevertKind
+		reason string
+	}{
+		{name: "empty", kind: abicall.RevertEmpty},
+		{name: "error string", data: errorString, kind: abicall.RevertString, reason: "not owner"},
+		{name: "error string truncated", data: errorString[:40], kind: abicall.RevertUnknown, reason: hexutil.Encode(errorString[:40])},
+		{name: "panic unknown code", data: pack(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x99)),
+			kind: abicall.RevertPanic, reason: "panic 0x99: unknown panic code: 0x99"},
+		{name: "panic wrong length", data: pack(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(1))[:20],
+			kind: abicall.RevertUnknown, reason: hexutil.Encode(pack(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(1))[:20])},
+		{name: "custom error", data: balance, abis: []abi.ABI{parsed}, kind: abicall.RevertCustom,
+			reason: "ERC20InsufficientBalance(" + sender.Hex() + ", 1, 2)"},
+		{name: "custom error without abi", data: balance, kind: abicall.RevertUnknown, reason: hexutil.Encode(balance)},
+		{name: "custom error bad args", data: balance[:30], abis: []abi.ABI{parsed}, kind: abicall.RevertUnknown, reason: hexutil.Encode(balance[:30])},
+		{name: "short", data: []byte{0x08, 0xc3}, kind: abicall.RevertUnknown, reason: "0x08c3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := abicall.DecodeRevert(tt.data, tt.abis...)
+			if r.Kind != tt.kind || r.Reason != tt.reason {
+				t.Fatalf("kind %q, reason %q; want %q, %q", r.Kind, r.Reason, tt.kind, tt.reason)
+			}
+		})
+	}
+
+	r := abicall.DecodeRevert(errorString)
+	if r.Message != "not owner" || r.Error() != "execution reverted: not owner" {
+		t.Fatalf("Error(string): message %q, error %q", r.Message, r.Error())
+	}
+	r = abicall.DecodeRevert(balance, parsed)
+	if r.Name != "ERC20InsufficientBalance" || len(r.Args) != 3 || r.Args[0].Type != "address" {
+		t.Fatalf("custom error: %+v", r)
+	}
+	if v, ok := r.Arg("needed"); !ok || v.(*big.Int).Int64() != 2 {
+		t.Fatalf("needed = %v, %v", v, ok)
+	}
+	// 先用别的 ABI 解码不出来，换成 revert 所在合约的 ABI 重新解码
+	r = abicall.DecodeRevert(balance)
+	r.Redecode(parsed)
+	if r.Kind != abicall.RevertCustom || r.Name != "ERC20InsufficientBalance" {
+		t.Fatalf("redecode: %+v", r)
+	}
+}
+
+// 每个 Panic 错误码都解码出错误码和 Solidity 文档里的说明
+func TestDecodePanic(t *testing.T) {
+	codes := map[int64]string{
+		0x00: "generic panic",
+		0x01: "assert(false)",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "enum overflow",
+		0x22: "invalid encoded storage byte array accessed",
+		0x31: "out-of-bounds array access; popping on an empty array",
+		0x32: "out-of-bounds access of an array or bytesN",
+		0x41: "out of memory",
+		0x51: "uninitialized function",
+	}
+	for code, text := range codes {
+		t.Run(fmt.Sprintf("%#x", code), func(t *testing.T) {
+			r := abicall.DecodeRevert(pack(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(code)))
+			if want := fmt.Sprintf("panic %#x: %s", code, text); r.Kind != abicall.RevertPanic || r.Code.Int64() != code || r.Reason != want {
+				t.Fatalf("kind %q, code %v, reason %q; want %q", r.Kind, r.Code, r.Reason, want)
+			}
+		})
+	}
+}
+
+// rpcError 模拟节点返回的 JSON-RPC 错误，revert 时错误码为 3，data 是十六进制的返回数据
+type rpcError struct {
+	code int
+	msg  string
+	data any
+}
+
+func (e *rpcError) Error() string          { return e.msg }
+func (e *rpcError) ErrorCode() int         { return e.code }
+func (e *rpcError) ErrorData() interface{} { return e.data }
+
+func TestWrapRevert(t *testing.T) {
+	parsed := tokenABI(t)
+	errorString := pack(t, "Error(string)", []string{"string"}, "paused")
+	decoded := abicall.DecodeRevert(errorString)
+	network := errors.New("dial tcp: connection refused")
+
+	tests := []struct {
+		name   string
+		err    error
+		same   bool // 不是 revert 或者已经解码过，原样返回
+		kind   abicall.RevertKind
+		reason string
+	}{
+		{name: "nil", err: nil, same: true},
+		{name: "network error", err: network, same: true},
+		{name: "rpc error that is not a revert", err: &rpcError{code: -32000, msg: "nonce too low"}, same: true},
+		{name: "revert with data", err: &rpcError{code: 3, msg: "execution reverted: paused", data: hexutil.Encode(errorString)},
+			kind: abicall.RevertString, reason: "paused"},
+		{name: "revert code without message", err: &rpcError{code: 3, msg: "reverted", data: hexutil.Encode(errorString)},
+			kind: abicall.RevertString, reason: "paused"},
+		{name: "revert without data", err: errors.New("execution reverted"), kind: abicall.RevertEmpty},
+		{name: "custom error", err: &rpcError{code: 3, msg: "execution reverted", data: hexutil.Encode(
+			pack(t, "ERC20InvalidReceiver(address)", []string{"address"}, common.Address{}))},
+			kind: abicall.RevertCustom, reason: "ERC20InvalidReceiver(" + common.Address{}.Hex() + ")"},
+		{name: "already decoded", err: fmt.Errorf("transfer: %w", decoded), same: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := abicall.WrapRevert(tt.err, parsed)
+			if tt.same {
+				if err != tt.err {
+					t.Fatalf("WrapRevert changed %v to %v", tt.err, err)
+				}
+				return
+			}
+			var r *abicall.RevertError
+			if !errors.As(err, &r) {
+				t.Fatalf("not a RevertError: %v", err)
+			}
+			if r.Kind != tt.kind || r.Reason != tt.reason || !errors.Is(err, tt.err) {
+				t.Fatalf("kind %q, reason %q, unwraps to original %v", r.Kind, r.Reason, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
+
+// 链上失败的交易：按父区块重放取回自定义错误；成功的交易重放也成功
+func TestReplayRevert(t *testing.T) {
+	chain, err := simchain.New(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	addr, tok, _, err := chain.DeployToken(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed := tokenABI(t)
+	ctx := context.Background()
+
+	// 先用 eth_call 确认会 revert：账户 1 没有代币
+	to := chain.Accounts[0].Address
+	input, err := parsed.Pack("transfer", to, big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = chain.Client.CallContract(ctx, ethereum.CallMsg{From: chain.Accounts[1].Address, To: &addr, Data: input}, nil)
+	var call *abicall.RevertError
+	if !errors.As(abicall.WrapRevert(err, parsed), &call) || call.Name != "ERC20InsufficientBalance" {
+		t.Fatalf("eth_call: %v", err)
+	}
+
+	// 固定 GasLimit 跳过估算，交易上链后失败
+	auth := chain.Transactor(1)
+	auth.GasLimit = 100000
+	tx, err := tok.Transfer(auth, to, big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+	receipt, err := chain.Client.TransactionReceipt(ctx, tx.Hash())
+	if err != nil || receipt.Status != types.ReceiptStatusFailed {
+		t.Fatalf("receipt %+v, %v", receipt, err)
+	}
+
+	var r *abicall.RevertError
+	if err := abicall.ReplayRevert(ctx, chain.Client, tx, receipt, parsed); !errors.As(err, &r) {
+		t.Fatalf("replay: %v", err)
+	}
+	parent := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	if r.Kind != abicall.RevertCustom || r.Name != "ERC20InsufficientBalance" || r.TxHash != tx.Hash() || r.Block.Cmp(parent) != 0 {
+		t.Fatalf("replay: %+v", r)
+	}
+	if sender, _ := r.Arg("sender"); sender != chain.Accounts[1].Address {
+		t.Fatalf("sender = %v", sender)
+	}
+	if !strings.HasPrefix(r.Error(), "tx "+tx.Hash().Hex()+" execution reverted: ERC20InsufficientBalance(") {
+		t.Fatalf("error text %q", r.Error())
+	}
+	// simchain.Mine 遇到失败的交易也返回同样的错误，不带 ABI 时是未解码的十六进制
+	if err := abicall.ReplayRevert(ctx, chain.Client, tx, receipt); !errors.As(err, &r) || r.Kind != abicall.RevertUnknown {
+		t.Fatalf("replay without abi: %v", err)
+	}
+
+	// 成功的交易在父区块上重放也成功，说明失败和同区块前面的交易有关
+	ok, err := tok.Transfer(chain.Transactor(0), chain.Accounts[1].Address, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	okReceipt, err := chain.Mine(ok)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := abicall.ReplayRevert(ctx, chain.Client, ok, okReceipt, parsed); !errors.As(err, &r) ||
+		r.Kind != abicall.RevertUnknown || r.Reason != "succeeds when replayed on the parent block" {
+		t.Fatalf("replay successful tx: %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package artifact
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ParseArgs 把字符串参数按 ABI 参数类型转换成 abi.Pack 需要的 Go 值
+func ParseArgs(inputs abi.Arguments, args []string) ([]any, error) {
+	if len(args) != len(inputs) {
+		return nil, fmt.Errorf("want %d arguments, got %d", len(inputs), len(args))
+	}
+	out := make([]any, len(args))
+	for i, input := range inputs {
+		v, err := ParseArg(input.Type, args[i])
+		if err != nil {
+			name := input.Name
+			if name == "" {
+				name = strconv.Itoa(i)
+			}
+			return nil, fmt.Errorf("argument %s (%s): %w", name, input.Type, err)
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+// ParseArg 把一个字符串转换成 t 对应的 Go 值：
+// 整数支持十进制和 0x 十六进制，bytes 用 0x 十六进制，数组用 JSON 数组或逗号分隔，
+// tuple 用 JSON 数组按顺序给出各字段，或者用 JSON 对象按字段名给出
+func ParseArg(t abi.Type, s string) (any, error) {
+	v, err := parseValue(t, strings.TrimSpace(s))
+	if err != nil {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+func parseValue(t abi.Type, s string) (reflect.Value, error) {
+	switch t.T {
+	case abi.StringTy:
+		return reflect.ValueOf(s), nil
+	case abi.BoolTy:
+		b, err := strconv.ParseBool(s)
+		return reflect.ValueOf(b), err
+	case abi.AddressTy:
+		if !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("invalid address %q", s)
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+	case abi.IntTy, abi.UintTy:
+		return parseInt(t, s)
+	case abi.BytesTy:
+		b, err := hexutil.Decode(s)
+		return reflect.ValueOf(b), err
+	case abi.FixedBytesTy:
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(b) > t.Size {
+			return reflect.Value{}, fmt.Errorf("%d bytes do not fit in bytes%d", len(b), t.Size)
+		}
+		v := reflect.New(t.GetType()).Elem()
+		reflect.Copy(v, reflect.ValueOf(b))
+		return v, nil
+	case abi.SliceTy, abi.ArrayTy:
+		items, err := splitList(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if t.T == abi.ArrayTy && len(items) != t.Size {
+			return reflect.Value{}, fmt.Errorf("want %d elements, got %d", t.Size, len(items))
+		}
+		v := reflect.New(t.GetType()).Elem()
+		if t.T == abi.SliceTy {
+			v = reflect.MakeSlice(t.GetType(), len(items), len(items))
+		}
+		for i, item := range items {
+			elem, err := parseValue(*t.Elem, item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("element %d: %w", i, err)
+			}
+			v.Index(i).Set(elem)
+		}
+		return v, nil
+	case abi.TupleTy:
+		items, err := splitTuple(t, s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v := reflect.New(t.GetType()).Elem()
+		for i, item := range items {
+			field, err := parseValue(*t.TupleElems[i], item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("field %s: %w", t.TupleRawNames[i], err)
+			}
+			v.Field(i).Set(field)
+		}
+		return v, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported type %s", t)
+	}
+}
+
+func parseInt(t abi.Type, s string) (reflect.Value, error) {
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("invalid integer %q", s)
+	}
+	if t.T == abi.UintTy && n.Sign() < 0 {
+		return reflect.Value{}, fmt.Errorf("negative value %s for uint%d", n, t.Size)
+	}
+	bits := t.Size
+	if t.T == abi.IntTy {
+		bits-- // 符号位
+	}
+	abs := new(big.Int).Abs(n)
+	if n.Sign() < 0 {
+		abs.Sub(abs, big.NewInt(1)) // intN 的最小值是 -2^(N-1)
+	}
+	if abs.BitLen() > bits {
+		return reflect.Value{}, fmt.Errorf("%s overflows %s", n, t)
+	}
+
+	typ := t.GetType()
+	if typ == reflect.TypeOf(&big.Int{}) {
+		return reflect.ValueOf(n), nil
+	}
+	v := reflect.New(typ).Elem()
+	if t.T == abi.UintTy {
+		v.SetUint(n.Uint64())
+	} else {
+		v.SetInt(n.Int64())
+	}
+	return v, nil
+}
+
+// splitList 解析 ["a","b"] 或 a,b 形式的列表
+func splitList(s string) ([]string, error) {
+	if s == "" || s == "[]" {
+		return nil, nil
+	}
+	if strings.HasPrefix(s, "[") {
+		var raw []json.RawMessage
+		if err := json.Unmarshal([]byte(s), &raw); err != nil {
+			return nil, err
+		}
+		items := make([]string, len(raw))
+		for i, r := range raw {
+			items[i] = rawString(r)
+		}
+		return items, nil
+	}
+	return strings.Split(s, ","), nil
+}
+
+// splitTuple 按字段顺序取出 tuple 各字段的字符串，JSON 对象里缺字段或者多了未知字段都报错
+func splitTuple(t abi.Type, s string) ([]string, error) {
+	if !strings.HasPrefix(s, "{") {
+		items, err := splitList(s)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) != len(t.TupleElems) {
+			return nil, fmt.Errorf("want %d fields, got %d", len(t.TupleElems), len(items))
+		}
+		return items, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s), &fields); err != nil {
+		return nil, err
+	}
+	items := make([]string, len(t.TupleRawNames))
+	for i, name := range t.TupleRawNames {
+		r, ok := fields[name]
+		if !ok {
+			return nil, fmt.Errorf("missing field %s", name)
+		}
+		items[i] = rawString(r)
+		delete(fields, name)
+	}
+	for name := range fields {
+		return nil, fmt.Errorf("unknown field %s", name)
+	}
+	return items, nil
+}
+
+// rawString 是 JSON 字符串时取字符串内容，数字、数组、对象等保留原文
+func rawString(r json.RawMessage) string {
+	var str string
+	if json.Unmarshal(r, &str) == nil {
+		return str
+	}
+	return string(r)
+}
@@ -0,0 +1,153 @@
+package artifact
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func newType(t *testing.T, s string, components ...abi.ArgumentMarshaling) abi.Type {
+	t.Helper()
+	typ, err := abi.NewType(s, "", components)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return typ
+}
+
+const (
+	alice = "0x00000000000000000000000000000000000000AA"
+	bob   = "0x00000000000000000000000000000000000000bb"
+)
+
+func TestParseArg(t *testing.T) {
+	transfer := []abi.ArgumentMarshaling{{Name: "to", Type: "address"}, {Name: "amount", Type: "uint256"}}
+	nested := []abi.ArgumentMarshaling{{Name: "id", Type: "uint8"}, {Name: "transfer", Type: "tuple", Components: transfer}}
+	aliceHex, bobHex := common.HexToAddress(alice).Hex(), common.HexToAddress(bob).Hex()
+
+	tests := []struct {
+		typ  abi.Type
+		in   string
+		want string // fmt.Sprint 的结果
+		err  string // 不为空时要求返回包含它的错误
+	}{
+		// uintN / intN：小于 64 位的是 Go 的定长整数，更大的是 *big.Int
+		{typ: newType(t, "uint8"), in: "255", want: "255"},
+		{typ: newType(t, "uint8"), in: "0xff", want: "255"},
+		{typ: newType(t, "uint8"), in: " 7 ", want: "7"},
+		{typ: newType(t, "uint8"), in: "256", err: "256 overflows uint8"},
+		{typ: newType(t, "uint8"), in: "-1", err: "negative value -1 for uint8"},
+		{typ: newType(t, "uint64"), in: "18446744073709551615", want: "18446744073709551615"},
+		{typ: newType(t, "uint256"), in: "0x" + strings.Repeat("f", 64), want: "115792089237316195423570985008687907853269984665640564039457584007913129639935"},
+		{typ: newType(t, "uint256"), in: "0x1" + strings.Repeat("0", 64), err: "overflows uint256"},
+		{typ: newType(t, "int8"), in: "-128", want: "-128"},
+		{typ: newType(t, "int8"), in: "127", want: "127"},
+		{typ: newType(t, "int8"), in: "128", err: "128 overflows int8"},
+		{typ: newType(t, "int8"), in: "-129", err: "-129 overflows int8"},
+		{typ: newType(t, "int16"), in: "-0x10", want: "-16"},
+		{typ: newType(t, "int256"), in: "-57896044618658097711785492504343953926634992332820282019728792003956564819968",
+			want: "-57896044618658097711785492504343953926634992332820282019728792003956564819968"},
+		{typ: newType(t, "int256"), in: "57896044618658097711785492504343953926634992332820282019728792003956564819968", err: "overflows int256"},
+		{typ: newType(t, "uint256"), in: "1e18", err: `invalid integer "1e18"`},
+
+		// address 输出带校验和
+		{typ: newType(t, "address"), in: strings.ToLower(alice), want: aliceHex},
+		{typ: newType(t, "address"), in: "0x1234", err: `invalid address "0x1234"`},
+
+		{typ: newType(t, "bool"), in: "true", want: "true"},
+		{typ: newType(t, "bool"), in: "0", want: "false"},
+		{typ: newType(t, "bool"), in: "yes", err: "invalid syntax"},
+		{typ: newType(t, "string"), in: "hello", want: "hello"},
+
+		// bytesN 不足的部分右侧补 0
+		{typ: newType(t, "bytes4"), in: "0x01020304", want: "[1 2 3 4]"},
+		{typ: newType(t, "bytes4"), in: "0x0102", want: "[1 2 0 0]"},
+		{typ: newType(t, "bytes4"), in: "0x0102030405", err: "5 bytes do not fit in bytes4"},
+		{typ: newType(t, "bytes32"), in: "abc", err: "hex string without 0x prefix"},
+		{typ: newType(t, "bytes"), in: "0xdead", want: "[222 173]"},
+		{typ: newType(t, "bytes"), in: "0xzz", err: "invalid hex string"},
+
+		// 数组：JSON 数组或者逗号分隔
+		{typ: newType(t, "uint256[]"), in: `["1", "2"]`, want: "[1 2]"},
+		{typ: newType(t, "uint256[]"), in: "[1,2,3]", want: "[1 2 3]"},
+		{typ: newType(t, "uint256[]"), in: "4,5", want: "[4 5]"},
+		{typ: newType(t, "uint256[]"), in: "[]", want: "[]"},
+		{typ: newType(t, "uint8[]"), in: "1,300", err: "element 1: 300 overflows uint8"},
+		{typ: newType(t, "address[2]"), in: fmt.Sprintf(`["%s","%s"]`, alice, bob), want: fmt.Sprintf("[%s %s]", aliceHex, bobHex)},
+		{typ: newType(t, "address[2]"), in: alice, err: "want 2 elements, got 1"},
+		{typ: newType(t, "uint8[2][]"), in: "[[1,2],[3,4]]", want: "[[1 2] [3 4]]"},
+		{typ: newType(t, "uint8[]"), in: "[1,", err: "unexpected end of JSON input"},
+
+		// tuple：JSON 数组按顺序，JSON 对象按字段名
+		{typ: newType(t, "tuple", transfer...), in: fmt.Sprintf(`["%s", "5"]`, alice), want: fmt.Sprintf("{%s 5}", aliceHex)},
+		{typ: newType(t, "tuple", transfer...), in: fmt.Sprintf(`{"amount": 5, "to": "%s"}`, bob), want: fmt.Sprintf("{%s 5}", bobHex)},
+		{typ: newType(t, "tuple", transfer...), in: fmt.Sprintf(`{"to": "%s"}`, bob), err: "missing field amount"},
+		{typ: newType(t, "tuple", transfer...), in: fmt.Sprintf(`{"to": "%s", "amount": 1, "memo": "x"}`, bob), err: "unknown field memo"},
+		{typ: newType(t, "tuple", transfer...), in: `["1"]`, err: "want 2 fields, got 1"},
+		{typ: newType(t, "tuple", transfer...), in: `["0x12", "1"]`, err: `field to: invalid address "0x12"`},
+		{typ: newType(t, "tuple", nested...), in: fmt.Sprintf(`[1, {"to": "%s", "amount": "2"}]`, alice), want: fmt.Sprintf("{1 {%s 2}}", aliceHex)},
+		{typ: newType(t, "tuple", nested...), in: `[1, ["0x12", "2"]]`, err: `field transfer: field to: invalid address "0x12"`},
+		{typ: newType(t, "tuple[]", transfer...), in: fmt.Sprintf(`[["%s", 1], {"to": "%s", "amount": 2}]`, alice, bob),
+			want: fmt.Sprintf("[{%s 1} {%s 2}]", aliceHex, bobHex)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ.String()+" "+tt.in, func(t *testing.T) {
+			v, err := ParseArg(tt.typ, tt.in)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 类型要和 abi.Pack 期望的一致
+			if reflect.TypeOf(v) != tt.typ.GetType() {
+				t.Fatalf("type %T, want %s", v, tt.typ.GetType())
+			}
+			if got := fmt.Sprint(v); got != tt.want {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+			if _, err := (abi.Arguments{{Type: tt.typ}}).Pack(v); err != nil {
+				t.Fatalf("pack: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseArgs(t *testing.T) {
+	inputs := abi.Arguments{{Name: "to", Type: newType(t, "address")}, {Type: newType(t, "uint8")}}
+	tests := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{name: "ok", args: []string{alice, "1"}},
+		{name: "too few", args: []string{alice}, err: "want 2 arguments, got 1"},
+		{name: "too many", args: []string{alice, "1", "2"}, err: "want 2 arguments, got 3"},
+		{name: "named argument", args: []string{"bob", "1"}, err: `argument to (address): invalid address "bob"`},
+		{name: "unnamed argument", args: []string{alice, "256"}, err: "argument 1 (uint8): 256 overflows uint8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := ParseArgs(inputs, tt.args)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := inputs.Pack(values...); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
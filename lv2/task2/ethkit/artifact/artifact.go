@@ -0,0 +1,147 @@
+package artifact
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Artifact 是编译产物里部署和调用合约需要的部分
+type Artifact struct {
+	Name    string
+	ABI     abi.ABI
+	RawABI  json.RawMessage
+	Bin     []byte // 创建字节码，只有 ABI 的产物为空
+	Runtime []byte // 运行时字节码，产物里没有时为空
+}
+
+// Load 读取编译产物，支持三种格式：
+//   - solc --abi --bin --bin-runtime 输出的 X.abi，同目录下的 X.bin、X.bin-runtime 可选
+//   - hardhat 的 artifacts/**/X.json（abi、bytecode、deployedBytecode）
+//   - Remix 的 artifacts/X.json（abi、data.bytecode.object）
+func Load(path string) (*Artifact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	var a *Artifact
+	switch filepath.Ext(path) {
+	case ".abi":
+		a, err = fromABI(name, data, strings.TrimSuffix(path, ".abi"))
+	case ".json":
+		a, err = fromJSON(name, data)
+	default:
+		err = errors.New("unknown artifact format")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("artifact %s: %w", path, err)
+	}
+	return a, nil
+}
+
+func fromABI(name string, data []byte, base string) (*Artifact, error) {
+	a, err := parse(name, data)
+	if err != nil {
+		return nil, err
+	}
+	if a.Bin, err = readHex(base + ".bin"); err != nil {
+		return nil, err
+	}
+	if a.Runtime, err = readHex(base + ".bin-runtime"); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// readHex 读取十六进制的字节码文件，文件不存在时返回 nil
+func readHex(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return common.FromHex(string(bytes.TrimSpace(data))), nil
+}
+
+func fromJSON(name string, data []byte) (*Artifact, error) {
+	var raw struct {
+		ContractName     string          `json:"contractName"`
+		ABI              json.RawMessage `json:"abi"`
+		Bytecode         json.RawMessage `json:"bytecode"`
+		DeployedBytecode json.RawMessage `json:"deployedBytecode"`
+		Data             *struct {
+			Bytecode         struct{ Object string } `json:"bytecode"`
+			DeployedBytecode struct{ Object string } `json:"deployedBytecode"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if len(raw.ABI) == 0 {
+		return nil, errors.New("no abi field")
+	}
+	if raw.ContractName != "" {
+		name = raw.ContractName
+	}
+	a, err := parse(name, raw.ABI)
+	if err != nil {
+		return nil, err
+	}
+	if raw.Data != nil {
+		a.Bin = common.FromHex(raw.Data.Bytecode.Object)
+		a.Runtime = common.FromHex(raw.Data.DeployedBytecode.Object)
+		return a, nil
+	}
+	a.Bin = common.FromHex(hexField(raw.Bytecode))
+	a.Runtime = common.FromHex(hexField(raw.DeployedBytecode))
+	return a, nil
+}
+
+// hexField 兼容 "0x..." 和 truffle/foundry 风格的 {"object": "0x..."}
+func hexField(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	var obj struct{ Object string }
+	if json.Unmarshal(raw, &obj) == nil {
+		return obj.Object
+	}
+	return ""
+}
+
+func parse(name string, rawABI []byte) (*Artifact, error) {
+	parsed, err := abi.JSON(bytes.NewReader(rawABI))
+	if err != nil {
+		return nil, err
+	}
+	return &Artifact{Name: name, ABI: parsed, RawABI: json.RawMessage(rawABI)}, nil
+}
+
+// Deployable 判断产物是否带有创建字节码
+func (a *Artifact) Deployable() bool {
+	return len(a.Bin) > 0
+}
+
+// Pack 把构造参数编码后拼到创建字节码后面，得到部署交易的 data
+func (a *Artifact) Pack(args ...any) ([]byte, error) {
+	if !a.Deployable() {
+		return nil, fmt.Errorf("artifact %s: no bytecode", a.Name)
+	}
+	input, err := a.ABI.Pack("", args...)
+	if err != nil {
+		return nil, fmt.Errorf("artifact %s: constructor: %w", a.Name, err)
+	}
+	return append(append([]byte{}, a.Bin...), input...), nil
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+
+	"ethkit/abicall"
+	"ethkit/artifact"
+	"ethkit/internal/cli"
+	"ethkit/preflight"
+	"ethkit/sigdb"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const usage = `usage: abicall -abi <file> -contract <address> [flags] <command>
+
+commands:
+  methods                       list methods, events and errors in the ABI
+  call   <method> [args...]     eth_call and decode the outputs
+  send   <method> [args...]     send a transaction, wait for it and decode its events
+  events [name]                 decode the contract's logs between -from-block and -to-block
+
+method is a name, a full signature such as transfer(address,uint256) or a selector such as 0xa9059cbb.
+Integers accept decimal or 0x hex, bytes use 0x hex, arrays use JSON ["a","b"] or a,b,
+tuples use a JSON array in field order or an object keyed by field name.
+`
+
+// 只凭 ABI 文件调用任意合约，不需要先跑 abigen
+// go run ./cmd/abicall -abi ../17/store/Store_sol_Store.abi -contract 0x... call version
+// go run ./cmd/abicall -abi ../04/erc20_sol_ERC20.abi -contract 0x... -keystore ./keys -from 0x... send transfer 0x... 1000
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	abiPath := flag.String("abi", "", "ABI file (.abi, hardhat or Remix artifact json)")
+	contractAddr := flag.String("contract", "", "contract address")
+	block := flag.Int64("block", -1, "call: block number, -1 for latest")
+	caller := flag.String("caller", "", "call: msg.sender for the call")
+	value := flag.String("value", "0", "send: wei to attach")
+	fromBlock := flag.Uint64("from-block", 0, "events: first block")
+	toBlock := flag.Int64("to-block", -1, "events: last block, -1 for latest")
+	keystoreDir := flag.String("keystore", "", "keystore directory for signing")
+	from := flag.String("from", "", "sender address in the keystore")
+	passwordFile := flag.String("password-file", "", "file containing the keystore passphrase")
+	dryRun := flag.Bool("dry-run", false, "estimate gas and fees, check balance and simulate, but do not send")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 || *abiPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	a, err := artifact.Load(*abiPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	args := flag.Args()
+	if args[0] == "methods" {
+		printABI(a)
+		return
+	}
+	if !common.IsHexAddress(*contractAddr) {
+		log.Fatal("-contract is required")
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	contract := abicall.New(common.HexToAddress(*contractAddr), a.ABI, client)
+
+	switch args[0] {
+	case "call":
+		need(args, 2)
+		var at *big.Int
+		if *block >= 0 {
+			at = big.NewInt(*block)
+		}
+		var sender common.Address
+		if *caller != "" {
+			sender = address(*caller)
+		}
+		values, err := contract.Call(ctx, sender, args[1], args[2:], at)
+		if err != nil {
+			fail(err)
+		}
+		for _, v := range values {
+			fmt.Printf("%s (%s): %s\n", v.Name, v.Type, v)
+		}
+	case "send":
+		need(args, 2)
+		amount, ok := new(big.Int).SetString(*value, 10)
+		if !ok || amount.Sign() < 0 {
+			log.Fatalf("invalid value %s", *value)
+		}
+		tx, err := contract.Send(ctx, cli.Transactor(ctx, client, *keystoreDir, *from, *passwordFile, *dryRun, a.ABI), args[1], args[2:], amount)
+		if err != nil {
+			fail(err)
+		}
+		fmt.Println("tx:", tx.Hash().Hex())
+		receipt, err := bind.WaitMined(ctx, client, tx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("mined in block %s, gas used %d\n", receipt.BlockNumber, receipt.GasUsed)
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			fail(contract.ReplayRevert(ctx, tx, receipt))
+		}
+		var db *sigdb.DB
+		for _, l := range receipt.Logs {
+			if l.Address != contract.Address {
+				// 其他合约发出的日志（比如被调用的代币）用签名库解码
+				if db == nil {
+					db = sigdb.Builtin()
+				}
+				if decoded, err := db.DecodeLog(*l); err == nil {
+					printEvent(abicall.Event{Name: decoded.Name + " @ " + decoded.Address.Hex(), Args: decoded.Args, Block: decoded.Block, TxHash: decoded.TxHash, Index: decoded.Index})
+				}
+				continue
+			}
+			e, err := contract.DecodeLog(*l)
+			if err != nil {
+				log.Fatal(err)
+			}
+			printEvent(e)
+		}
+	case "events":
+		var name string
+		if len(args) > 1 {
+			name = args[1]
+		}
+		var to *big.Int
+		if *toBlock >= 0 {
+			to = big.NewInt(*toBlock)
+		}
+		events, err := contract.Events(ctx, name, new(big.Int).SetUint64(*fromBlock), to)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, e := range events {
+			printEvent(e)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func printABI(a *artifact.Artifact) {
+	c := abicall.New(common.Address{}, a.ABI, nil)
+	for _, m := range c.Methods() {
+		fmt.Printf("%s  %-40s %s", hexutil.Encode(m.ID), m.Sig, m.StateMutability)
+		if len(m.Outputs) > 0 {
+			types := make([]string, len(m.Outputs))
+			for i, out := range m.Outputs {
+				types[i] = out.Type.String()
+			}
+			fmt.Printf(" returns (%s)", strings.Join(types, ","))
+		}
+		fmt.Println()
+	}
+	for _, e := range a.ABI.Events {
+		fmt.Printf("event %s  %s\n", e.Sig, e.ID.Hex())
+	}
+	for _, e := range a.ABI.Errors {
+		fmt.Printf("error %s  %s\n", e.Sig, hexutil.Encode(e.ID[:4]))
+	}
+}
+
+func printEvent(e abicall.Event) {
+	if e.Name == "" {
+		fmt.Printf("block %d tx %s log %d: unknown event\n", e.Block, e.TxHash.Hex(), e.Index)
+		return
+	}
+	fmt.Printf("block %d tx %s log %d: %s\n", e.Block, e.TxHash.Hex(), e.Index, e.Name)
+	for _, arg := range e.Args {
+		fmt.Printf("  %s (%s): %s\n", arg.Name, arg.Type, arg)
+	}
+}
+
+// fail 打印 revert 原因后退出，dry run 打印检查报告
+func fail(err error) {
+	if errors.Is(err, preflight.ErrDryRun) {
+		cli.Fatal(err)
+	}
+	var revert *abicall.RevertError
+	if !errors.As(err, &revert) {
+		log.Fatal(err)
+	}
+	switch revert.Kind {
+	case abicall.RevertCustom:
+		fmt.Fprintf(os.Stderr, "custom error %s\n", revert.Name)
+		for _, arg := range revert.Args {
+			fmt.Fprintf(os.Stderr, "  %s (%s): %s\n", arg.Name, arg.Type, arg)
+		}
+	case abicall.RevertPanic:
+		fmt.Fprintf(os.Stderr, "panic code %#x\n", revert.Code)
+	}
+	if len(revert.Data) > 0 {
+		log.Fatalf("%v (data %s)", revert, hexutil.Encode(revert.Data))
+	}
+	log.Fatal(revert)
+}
+
+func need(args []string, n int) {
+	if len(args) < n {
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func address(s string) common.Address {
+	if !common.IsHexAddress(s) {
+		log.Fatalf("invalid address %s", s)
+	}
+	return common.HexToAddress(s)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"ethkit/history"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const usage = `usage: blockat [flags] [time...]
+
+Prints the first block at or after each time. A time is an RFC 3339 timestamp
+(2024-01-01T00:00:00Z), a date (2024-01-01, midnight UTC) or @unix-seconds.
+`
+
+// 按时间找区块，比如报表里的“零点余额”先用它换成区块号
+// go run ./cmd/blockat 2024-01-01 2024-02-01T08:00:00+08:00
+// go run ./cmd/blockat -daily 7
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	daily := flag.Int("daily", 0, "also look up the last N midnights (UTC)")
+	cache := flag.Int("cache", 4096, "number of block headers to cache")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var times []time.Time
+	for _, arg := range flag.Args() {
+		t, err := parseTime(arg)
+		if err != nil {
+			log.Fatal(err)
+		}
+		times = append(times, t)
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	for i := *daily - 1; i >= 0; i-- {
+		times = append(times, today.AddDate(0, 0, -i))
+	}
+	if len(times) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	finder := history.NewBlockFinder(client, *cache)
+	for _, t := range times {
+		header, err := finder.Find(ctx, uint64(t.Unix()))
+		if errors.Is(err, history.ErrFutureTime) {
+			fmt.Printf("%s  no block yet\n", t.Format(time.RFC3339))
+			continue
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s  block %s  %s  (block time %s)\n", t.Format(time.RFC3339), header.Number, header.Hash().Hex(),
+			time.Unix(int64(header.Time), 0).UTC().Format(time.RFC3339))
+	}
+}
+
+func parseTime(s string) (time.Time, error) {
+	if strings.HasPrefix(s, "@") {
+		sec, err := strconv.ParseInt(s[1:], 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid unix time %s", s)
+		}
+		return time.Unix(sec, 0).UTC(), nil
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %s: want 2024-01-01, 2024-01-01T00:00:00Z or @unix", s)
+	}
+	return t, nil
+}
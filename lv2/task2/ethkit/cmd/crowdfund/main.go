@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"os/signal"
+	"strconv"
+	"time"
+
+	"ethkit/artifact"
+	"ethkit/crowdfund"
+	"ethkit/internal/cli"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gin-gonic/gin"
+)
+
+const usage = `usage: crowdfund [flags] <command>
+
+commands:
+  deploy  <beneficiary> <goal-wei>   deploy a campaign (bytecode from -artifact or the bundled build)
+  track   <address> [from-block]     track an existing campaign, replaying from its deploy block if given
+  status  [address]                  progress of tracked campaigns
+  sync                               scan new blocks once
+  serve                              keep syncing and serve the HTTP API on -addr
+`
+
+// CrowdFunding（lv1/task5/CrowdFunding.sol）众筹服务：部署、按交易统计每个捐款人的金额、达到目标后自动 close
+// go run ./cmd/crowdfund -keystore ./keys -from 0x... deploy 0x... 1000000000000000000
+// go run ./cmd/crowdfund -keystore ./keys -from 0x... -auto-close serve
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	statePath := flag.String("state", "crowdfund.json", "state file")
+	artifactPath := flag.String("artifact", "", "CrowdFunding artifact (.abi with .bin, hardhat or Remix json) to deploy instead of the bundled build")
+	keystoreDir := flag.String("keystore", "", "keystore directory for signing")
+	from := flag.String("from", "", "sender address in the keystore")
+	passwordFile := flag.String("password-file", "", "file containing the keystore passphrase")
+	dryRun := flag.Bool("dry-run", false, "estimate gas and fees, check balance and simulate, but do not send")
+	autoClose := flag.Bool("auto-close", false, "call close once a campaign reaches its goal")
+	interval := flag.Duration("interval", 5*time.Second, "poll interval for serve")
+	addr := flag.String("addr", ":8080", "listen address for serve")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	cfg := crowdfund.Config{AutoClose: *autoClose, Interval: *interval, StatePath: *statePath}
+	if *artifactPath != "" {
+		a, err := artifact.Load(*artifactPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !a.Deployable() {
+			log.Fatalf("%s has no bytecode", *artifactPath)
+		}
+		cfg.Bin = a.Bin
+	}
+	if *keystoreDir != "" {
+		cfg.Auth = cli.Transactor(ctx, client, *keystoreDir, *from, *passwordFile, *dryRun)
+	}
+	svc, err := crowdfund.New(ctx, cfg, client)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	args := flag.Args()
+	switch args[0] {
+	case "deploy":
+		need(args, 3)
+		goal, ok := new(big.Int).SetString(args[2], 10)
+		if !ok || goal.Sign() <= 0 {
+			log.Fatalf("invalid goal %s", args[2])
+		}
+		c, err := svc.Deploy(ctx, address(args[1]), goal)
+		if err != nil {
+			cli.Fatal(err)
+		}
+		fmt.Println("campaign:", c.Address.Hex())
+	case "track":
+		need(args, 2)
+		var fromBlock uint64
+		if len(args) > 2 {
+			if fromBlock, err = strconv.ParseUint(args[2], 10, 64); err != nil {
+				log.Fatalf("invalid block %s", args[2])
+			}
+		}
+		c, err := svc.Track(ctx, address(args[1]), fromBlock)
+		if err != nil {
+			log.Fatal(err)
+		}
+		printCampaign(c)
+	case "status":
+		if len(args) > 1 {
+			c, err := svc.Campaign(address(args[1]))
+			if err != nil {
+				log.Fatal(err)
+			}
+			printCampaign(c)
+			for funder, amount := range c.Funders {
+				fmt.Printf("  %s %s\n", funder.Hex(), amount)
+			}
+			return
+		}
+		for _, c := range svc.Campaigns() {
+			printCampaign(c)
+		}
+	case "sync":
+		if err := svc.Sync(ctx); err != nil {
+			log.Fatal(err)
+		}
+		for _, c := range svc.Campaigns() {
+			printCampaign(c)
+		}
+	case "serve":
+		go svc.Run(ctx)
+		router := gin.Default()
+		crowdfund.Register(router, svc)
+		log.Fatal(router.Run(*addr))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func printCampaign(c crowdfund.Campaign) {
+	state := "open"
+	if c.Closed {
+		state = "closed"
+	}
+	fmt.Printf("%s  raised %s/%s (%.1f%%) funders=%d %s\n",
+		c.Address.Hex(), c.Raised, c.Goal, c.Progress()*100, len(c.Funders), state)
+}
+
+func need(args []string, n int) {
+	if len(args) < n {
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func address(s string) common.Address {
+	if !common.IsHexAddress(s) {
+		log.Fatalf("invalid address %s", s)
+	}
+	return common.HexToAddress(s)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"ethkit/devnet"
+)
+
+// 本地开发链：不需要 Sepolia 测试币和 Infura，启动后自动部署项目合约并写出地址清单
+// go run ./cmd/devnet -contracts devnet/contracts.json -manifest devnet.json
+func main() {
+	cfg := devnet.DefaultConfig()
+	flag.StringVar(&cfg.Host, "host", cfg.Host, "RPC listen host")
+	flag.IntVar(&cfg.HTTPPort, "http-port", cfg.HTTPPort, "HTTP JSON-RPC port")
+	flag.IntVar(&cfg.WSPort, "ws-port", cfg.WSPort, "WebSocket JSON-RPC port")
+	flag.StringVar(&cfg.Mnemonic, "mnemonic", cfg.Mnemonic, "mnemonic for pre-funded accounts")
+	flag.IntVar(&cfg.Accounts, "accounts", cfg.Accounts, "number of pre-funded accounts")
+	flag.DurationVar(&cfg.BlockTime, "block-time", cfg.BlockTime, "how often to mine pending transactions")
+	flag.StringVar(&cfg.Deployments, "deployments", cfg.Deployments, "deployment records directory, one file per chain ID")
+	spec := flag.String("contracts", "devnet/contracts.json", "contracts to deploy on start")
+	manifest := flag.String("manifest", "devnet.json", "where to write deployed addresses")
+	flag.Parse()
+
+	entries, err := devnet.LoadSpec(*spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	net, err := devnet.Start(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer net.Close()
+
+	if err := net.DeployAll(entries); err != nil {
+		log.Fatal(err)
+	}
+	m := net.Manifest()
+	if err := m.Write(*manifest); err != nil {
+		log.Fatal(err)
+	}
+	for i, a := range m.Accounts {
+		log.Printf("account %d: %s", i, a.Address.Hex())
+	}
+	log.Printf("chain %d listening on %s and %s, manifest written to %s", m.ChainID, m.HTTP, m.WS, *manifest)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := net.Run(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"ethkit/history"
+	"ethkit/units"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const usage = `usage: history [flags] <command>
+
+commands:
+  archive                        report whether the node keeps historical state
+  block   <ref>                  resolve a block reference
+  balance <address> <ref>        ETH balance, or -token balance, at a block
+  sync    <address>...           sync the local transfer ledger up to the latest block
+
+ref is latest, a block number, a block hash, an RFC 3339 time (2024-01-01T00:00:00Z)
+or @unix-seconds; times resolve to the first block at or after them.
+With -ledger, balances whose state is pruned are reconstructed from the synced ledger.
+`
+
+// 查询历史区块上的余额，节点不是归档节点时给出明确的错误，或者用本地账本推算
+// go run ./cmd/history balance 0x71c7656ec7ab88b098defb751b7401b5f6d8976f 5532993
+// go run ./cmd/history -ledger ledger.json -ledger-from 19000000 sync 0x...
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	tokenAddr := flag.String("token", "", "ERC-20 token for balance, ETH when empty")
+	ledgerPath := flag.String("ledger", "", "local transfer ledger file")
+	ledgerFrom := flag.Uint64("ledger-from", 0, "first block of a new ledger")
+	ledgerTokens := flag.String("ledger-tokens", "", "comma separated ERC-20 tokens the ledger tracks")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	hist := history.New(client)
+	args := flag.Args()
+	switch args[0] {
+	case "archive":
+		ok, err := hist.Archive(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if ok {
+			fmt.Println("archive: historical state is available")
+		} else {
+			fmt.Println("not an archive node: state of old blocks is pruned")
+		}
+	case "block":
+		need(args, 2)
+		header, err := hist.Header(ctx, ref(args[1]))
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("block %s %s at %s\n", header.Number, header.Hash().Hex(), time.Unix(int64(header.Time), 0).UTC().Format(time.RFC3339))
+	case "balance":
+		need(args, 3)
+		account := address(args[1])
+		if *ledgerPath != "" {
+			// 已有的账本沿用它跟踪的账户和代币，没有时新建一个只跟踪这个账户的
+			var accounts, tracked []common.Address
+			if _, err := os.Stat(*ledgerPath); errors.Is(err, os.ErrNotExist) {
+				accounts, tracked = []common.Address{account}, tokens(*ledgerTokens)
+				if *tokenAddr != "" && len(tracked) == 0 {
+					tracked = []common.Address{address(*tokenAddr)}
+				}
+			}
+			if hist.Ledger, err = history.OpenLedger(*ledgerPath, accounts, tracked, *ledgerFrom); err != nil {
+				log.Fatal(err)
+			}
+		}
+		var b *history.Balance
+		if *tokenAddr == "" {
+			b, err = hist.BalanceAt(ctx, account, ref(args[2]))
+		} else {
+			b, err = hist.TokenBalanceAt(ctx, address(*tokenAddr), account, ref(args[2]))
+		}
+		var pruned *history.PrunedError
+		if errors.As(err, &pruned) {
+			log.Fatalf("%v\nre-run against an archive node, or with -ledger synced from before block %d", err, pruned.Block)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		formatted := units.Format(b.Value, 18) + " ETH"
+		if *tokenAddr != "" {
+			formatted = b.Value.String()
+		}
+		fmt.Printf("block %d (%s): %s [%s]\n", b.Block, b.Hash.Hex(), formatted, b.Source)
+	case "sync":
+		need(args, 2)
+		if *ledgerPath == "" {
+			log.Fatal("-ledger is required")
+		}
+		var accounts []common.Address
+		for _, a := range args[1:] {
+			accounts = append(accounts, address(a))
+		}
+		ledger, err := history.OpenLedger(*ledgerPath, accounts, tokens(*ledgerTokens), *ledgerFrom)
+		if err != nil {
+			log.Fatal(err)
+		}
+		head, err := client.BlockNumber(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := ledger.Sync(ctx, client, head); err != nil {
+			log.Fatal(err)
+		}
+		from, next := ledger.Covered()
+		fmt.Printf("ledger covers blocks %d-%d, %d records\n", from, next-1, len(ledger.Records))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func ref(s string) history.Ref {
+	r, err := history.ParseRef(s)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return r
+}
+
+func tokens(s string) []common.Address {
+	var out []common.Address
+	for _, t := range strings.Split(s, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			out = append(out, address(t))
+		}
+	}
+	return out
+}
+
+func need(args []string, n int) {
+	if len(args) < n {
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func address(s string) common.Address {
+	if !common.IsHexAddress(s) {
+		log.Fatalf("invalid address %s", s)
+	}
+	return common.HexToAddress(s)
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+
+	"ethkit/contracts/multicall3"
+	"ethkit/contracts/token"
+	"ethkit/internal/cli"
+	"ethkit/multicall"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const usage = `usage: multicall [flags] <command>
+
+commands:
+  erc20  <token> [holder...]    name, symbol, decimals, totalSupply and balances in one aggregate3 call
+  deploy                        deploy Multicall3 (for local chains without it), needs -keystore and -from
+
+Without Multicall3 at -multicall the calls fall back to one eth_call each.
+`
+
+// 05/contract_read_erc20.go 的四次调用合并成一次 eth_call
+// go run ./cmd/multicall -rpc https://cloudflare-eth.com erc20 0xa74476443119A942dE498590Fe1f2454d7D4aC0d 0x0536806df512d6cdde913cf95c9886f65b1d3462
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	multicallAddr := flag.String("multicall", multicall.Address.Hex(), "Multicall3 address")
+	block := flag.Int64("block", -1, "block number, -1 for latest")
+	keystoreDir := flag.String("keystore", "", "deploy: keystore directory for signing")
+	from := flag.String("from", "", "deploy: sender address in the keystore")
+	passwordFile := flag.String("password-file", "", "deploy: file containing the keystore passphrase")
+	dryRun := flag.Bool("dry-run", false, "deploy: estimate gas and fees, check balance and simulate, but do not send")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	args := flag.Args()
+	switch args[0] {
+	case "deploy":
+		addr, tx, _, err := multicall3.DeployMulticall3(cli.Transactor(ctx, client, *keystoreDir, *from, *passwordFile, *dryRun), client)
+		if err != nil {
+			cli.Fatal(err)
+		}
+		if _, err := bind.WaitDeployed(ctx, client, tx); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("Multicall3 deployed at", addr.Hex())
+	case "erc20":
+		need(args, 2)
+		mc := multicall.New(client)
+		mc.Address = address(*multicallAddr)
+		available, err := mc.Available(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !available {
+			log.Printf("no Multicall3 at %s, falling back to individual calls", mc.Address.Hex())
+		}
+
+		parsed, err := token.TokenMetaData.GetAbi()
+		if err != nil {
+			log.Fatal(err)
+		}
+		tokenAddr := address(args[1])
+		calls := []*multicall.Call{
+			multicall.NewCall(tokenAddr, parsed, "name"),
+			multicall.NewCall(tokenAddr, parsed, "symbol"),
+			multicall.NewCall(tokenAddr, parsed, "decimals"),
+			multicall.NewCall(tokenAddr, parsed, "totalSupply"),
+		}
+		for _, holder := range args[2:] {
+			calls = append(calls, multicall.NewCall(tokenAddr, parsed, "balanceOf", address(holder)))
+		}
+		var at *big.Int
+		if *block >= 0 {
+			at = big.NewInt(*block)
+		}
+		if err := mc.Do(ctx, at, calls...); err != nil {
+			log.Fatal(err)
+		}
+
+		var name, symbol string
+		var decimals uint8
+		var supply *big.Int
+		for i, out := range []any{&name, &symbol, &decimals, &supply} {
+			if err := calls[i].Unpack(out); err != nil {
+				fmt.Printf("%s: %v\n", calls[i].Method, err)
+			}
+		}
+		fmt.Printf("name: %s\nsymbol: %s\ndecimals: %d\ntotalSupply: %s\n", name, symbol, decimals, supply)
+		for i, call := range calls[4:] {
+			var balance *big.Int
+			if err := call.Unpack(&balance); err != nil {
+				fmt.Printf("%s: %v\n", args[2+i], err)
+				continue
+			}
+			fmt.Printf("%s: %s (%s %s)\n", args[2+i], balance, units(balance, decimals), symbol)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+// units 按 decimals 把最小单位换算成可读的数量
+func units(v *big.Int, decimals uint8) string {
+	f := new(big.Float).SetInt(v)
+	f.Quo(f, new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	return f.Text('f', 6)
+}
+
+func need(args []string, n int) {
+	if len(args) < n {
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func address(s string) common.Address {
+	if !common.IsHexAddress(s) {
+		log.Fatalf("invalid address %s", s)
+	}
+	return common.HexToAddress(s)
+}
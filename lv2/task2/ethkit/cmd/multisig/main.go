@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strconv"
+
+	"ethkit/internal/cli"
+	"ethkit/multisig"
+	"store/deploy"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gin-gonic/gin"
+)
+
+const usage = `usage: multisig [flags] <command>
+
+commands:
+  info                          owners, required approvals and balance
+  list [all]                    pending proposals with approval counts (all includes executed)
+  show    <id>                  one proposal
+  submit  <to> <wei> [0xdata]   propose a transaction
+  approve <id>
+  revoke  <id>
+  execute <id>                  execute once approvals reach the threshold
+  serve                         HTTP API on -addr
+`
+
+// MultiSigWallet 命令行：提案、批准、撤回、执行，提案状态从事件重建
+// go run ./cmd/multisig -contract 0x... list
+// go run ./cmd/multisig -keystore ./keys -from 0x... -password-file ./pass approve 0
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	contract := flag.String("contract", "", "MultiSigWallet address, defaults to the MultiSigWallet entry in the deployment manifest")
+	dir := flag.String("deployments", "deployments", "deployment manifest directory")
+	fromBlock := flag.Uint64("from-block", 0, "block the wallet was deployed in, events are read from here")
+	keystoreDir := flag.String("keystore", "", "keystore directory for signing")
+	from := flag.String("from", "", "owner address in the keystore")
+	passwordFile := flag.String("password-file", "", "file containing the keystore passphrase")
+	dryRun := flag.Bool("dry-run", false, "estimate gas and fees, check balance and simulate, but do not send")
+	addr := flag.String("addr", ":8080", "listen address for serve")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	address := common.HexToAddress(*contract)
+	if *contract == "" {
+		manager, err := deploy.NewManager(ctx, client, *dir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		record, ok := manager.Manifest().Contracts["MultiSigWallet"]
+		if address, err = manager.Address(ctx, "MultiSigWallet"); err != nil {
+			log.Fatal(err)
+		}
+		if ok && *fromBlock == 0 {
+			*fromBlock = record.Block
+		}
+	}
+	wallet, err := multisig.New(address, client)
+	if err != nil {
+		log.Fatal(err)
+	}
+	wallet.FromBlock = *fromBlock
+
+	sign := func() *bind.TransactOpts {
+		return cli.Transactor(ctx, client, *keystoreDir, *from, *passwordFile, *dryRun)
+	}
+
+	args := flag.Args()
+	switch args[0] {
+	case "info":
+		info, err := wallet.Info(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("wallet:   %s\n", info.Address.Hex())
+		fmt.Printf("required: %d of %d\n", info.Required, len(info.Owners))
+		fmt.Printf("balance:  %s wei\n", info.Balance)
+		for _, o := range info.Owners {
+			fmt.Println("owner:   ", o.Hex())
+		}
+	case "list":
+		fetch := wallet.Pending
+		if len(args) > 1 && args[1] == "all" {
+			fetch = wallet.Proposals
+		}
+		proposals, err := fetch(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		required, err := wallet.Required(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, p := range proposals {
+			printProposal(p, required)
+		}
+	case "show":
+		need(args, 2)
+		p, err := wallet.Proposal(ctx, id(args[1]))
+		if err != nil {
+			log.Fatal(err)
+		}
+		required, err := wallet.Required(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		printProposal(p, required)
+		for _, a := range p.Approvals {
+			fmt.Println("  approved by", a.Hex())
+		}
+	case "submit":
+		need(args, 3)
+		if !common.IsHexAddress(args[1]) {
+			log.Fatalf("invalid address %s", args[1])
+		}
+		value, ok := new(big.Int).SetString(args[2], 10)
+		if !ok || value.Sign() < 0 {
+			log.Fatalf("invalid amount %s", args[2])
+		}
+		var data []byte
+		if len(args) > 3 {
+			if data, err = hexutil.Decode(args[3]); err != nil {
+				log.Fatalf("invalid data %s: %v", args[3], err)
+			}
+		}
+		tx, err := wallet.Submit(ctx, sign(), common.HexToAddress(args[1]), value, data)
+		if err != nil {
+			cli.Fatal(err)
+		}
+		receipt := wait(ctx, wallet, tx)
+		pid, err := wallet.SubmittedID(receipt)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("proposal", pid)
+	case "approve", "revoke", "execute":
+		need(args, 2)
+		auth := sign()
+		var tx *types.Transaction
+		switch args[0] {
+		case "approve":
+			tx, err = wallet.Approve(ctx, auth, id(args[1]))
+		case "revoke":
+			tx, err = wallet.Revoke(ctx, auth, id(args[1]))
+		case "execute":
+			tx, err = wallet.Execute(ctx, auth, id(args[1]))
+		}
+		if err != nil {
+			cli.Fatal(err)
+		}
+		wait(ctx, wallet, tx)
+	case "serve":
+		var auth *bind.TransactOpts
+		if *keystoreDir != "" {
+			auth = sign()
+		}
+		router := gin.Default()
+		multisig.Register(router, wallet, auth)
+		log.Fatal(router.Run(*addr))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func printProposal(p multisig.Proposal, required uint64) {
+	state := "pending"
+	switch {
+	case p.Executed:
+		state = "executed"
+	case uint64(len(p.Approvals)) >= required:
+		state = "ready"
+	}
+	fmt.Printf("#%d  to=%s value=%s data=%s approvals=%d/%d %s\n",
+		p.ID, p.To.Hex(), p.Value, hexutil.Encode(p.Data), len(p.Approvals), required, state)
+}
+
+func wait(ctx context.Context, wallet *multisig.Client, tx *types.Transaction) *types.Receipt {
+	fmt.Println("tx:", tx.Hash().Hex())
+	receipt, err := wallet.Wait(ctx, tx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("mined in block", receipt.BlockNumber)
+	return receipt
+}
+
+func need(args []string, n int) {
+	if len(args) < n {
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func id(s string) uint64 {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		log.Fatalf("invalid proposal id %s", s)
+	}
+	return n
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"ethkit/orders"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gin-gonic/gin"
+)
+
+const usage = `usage: orders [flags] <command> [kind:address[:from-block] ...]
+
+commands:
+  status   poll once and print every tracked order
+  serve    keep polling, push changes to -webhook and serve the HTTP API (with SSE) on -addr.
+           changes a webhook has not accepted yet are queued next to -state and retried after a restart
+
+kind is shipping or shopping. from-block replays events from the contract's deploy block;
+without it the current Status() is taken as the starting point.
+`
+
+// Shipping / Shopping（lv0/task3）订单状态跟踪，按 LogNewAlert 推进状态并推送变化
+// go run ./cmd/orders -webhook http://127.0.0.1:9000/hook serve shipping:0x...:12
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	statePath := flag.String("state", "orders.json", "state file")
+	confirmations := flag.Uint64("confirmations", 0, "blocks to wait before applying an event")
+	interval := flag.Duration("interval", 5*time.Second, "poll interval")
+	webhooks := flag.String("webhook", "", "comma separated URLs that receive each status change as a JSON POST")
+	retries := flag.Int("webhook-retries", 3, "retries per webhook delivery before pausing; the change stays queued")
+	addr := flag.String("addr", ":8080", "listen address for serve")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	hub := orders.NewHub()
+	watcher, err := orders.New(orders.Config{Interval: *interval, Confirmations: *confirmations, StatePath: *statePath}, client, hub)
+	if err != nil {
+		log.Fatal(err)
+	}
+	args := flag.Args()
+	for _, spec := range args[1:] {
+		kind, address, from := parseSpec(spec)
+		if _, err := watcher.Order(address); err == nil {
+			continue
+		}
+		if _, err := watcher.Add(ctx, address, kind, from); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	switch args[0] {
+	case "status":
+		if err := watcher.Poll(ctx); err != nil {
+			log.Fatal(err)
+		}
+		for _, o := range watcher.Orders() {
+			fmt.Printf("%s %-8s %-9s synced=%d\n", o.Address.Hex(), o.Kind, o.Status, o.Synced)
+			for _, t := range o.History {
+				mark := ""
+				if !t.Valid {
+					mark = "  (unexpected)"
+				}
+				fmt.Printf("  %s  %s -> %s  block %d%s\n", t.Time.Format(time.RFC3339), t.From, t.To, t.Block, mark)
+			}
+		}
+	case "serve":
+		// 每个 webhook 一个队列文件，对方不可用时变化留在队列里，重启后接着推送
+		for _, url := range strings.Split(*webhooks, ",") {
+			if url = strings.TrimSpace(url); url == "" {
+				continue
+			}
+			q, _, err := hub.Queue(queuePath(*statePath, url))
+			if err != nil {
+				log.Fatal(err)
+			}
+			go (&orders.Webhook{URL: url, Retries: *retries}).Run(ctx, q)
+		}
+		go watcher.Run(ctx)
+		router := gin.Default()
+		orders.Register(router, watcher, hub)
+		log.Fatal(router.Run(*addr))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+// queuePath 是 webhook 的队列文件：orders.json 旁边的 orders.webhook-<URL 哈希>.json，状态文件为空时不落盘
+func queuePath(statePath, url string) string {
+	if statePath == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(url))
+	return fmt.Sprintf("%s.webhook-%x.json", strings.TrimSuffix(statePath, ".json"), sum[:4])
+}
+
+// parseSpec 解析 kind:address[:from-block]
+func parseSpec(spec string) (orders.Kind, common.Address, uint64) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 || !common.IsHexAddress(parts[1]) {
+		log.Fatalf("invalid order %s, want kind:address[:from-block]", spec)
+	}
+	kind, err := orders.KindByName(parts[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+	var from uint64
+	if len(parts) == 3 {
+		if from, err = strconv.ParseUint(parts[2], 10, 64); err != nil {
+			log.Fatalf("invalid block in %s", spec)
+		}
+	}
+	return kind, common.HexToAddress(parts[1]), from
+}
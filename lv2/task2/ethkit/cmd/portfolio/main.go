@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+
+	"ethkit/multicall"
+	"ethkit/portfolio"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gin-gonic/gin"
+)
+
+const usage = `usage: portfolio -tokens <list.json> [flags] <command>
+
+commands:
+  show    <address>...     ETH and token balances at -block
+  history <address>...     balances at every block in -blocks, or -from to -to every -step blocks
+  serve                    HTTP API on -addr (GET /tokens, /portfolio, /portfolio/history)
+
+The token list is a JSON array of {"address", "symbol", "decimals"} or a Uniswap-style
+{"tokens": [...]} list; missing symbols and decimals are read from the contracts.
+`
+
+// 多个地址的 ETH 和代币余额
+// go run ./cmd/portfolio -tokens portfolio/tokens.example.json show 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266
+// go run ./cmd/portfolio -tokens portfolio/tokens.example.json -from 1 -to 20 -step 5 history 0x...
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	tokensPath := flag.String("tokens", "", "token list JSON")
+	multicallAddr := flag.String("multicall", multicall.Address.Hex(), "Multicall3 address, falls back to single calls when not deployed")
+	block := flag.Int64("block", -1, "show: block number, -1 for latest")
+	blocks := flag.String("blocks", "", "history: comma separated block numbers")
+	fromBlock := flag.Uint64("from", 0, "history: first block")
+	toBlock := flag.Int64("to", -1, "history: last block, -1 for latest")
+	step := flag.Uint64("step", 1000, "history: blocks between snapshots")
+	asJSON := flag.Bool("json", false, "print JSON instead of a table")
+	addr := flag.String("addr", ":8080", "listen address for serve")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 || *tokensPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	tokens, err := portfolio.LoadTokens(*tokensPath, chainID.Uint64())
+	if err != nil {
+		log.Fatal(err)
+	}
+	mc := multicall.New(client)
+	mc.Address = address(*multicallAddr)
+	service, err := portfolio.New(client, mc, tokens)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	args := flag.Args()
+	switch args[0] {
+	case "show":
+		need(args, 2)
+		var at *big.Int
+		if *block >= 0 {
+			at = big.NewInt(*block)
+		}
+		snap, err := service.At(ctx, owners(args[1:]), at)
+		if err != nil {
+			log.Fatal(err)
+		}
+		printResult(*asJSON, snap)
+	case "history":
+		need(args, 2)
+		var list []*big.Int
+		if *blocks != "" {
+			for _, s := range strings.Split(*blocks, ",") {
+				n, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+				if err != nil {
+					log.Fatalf("invalid block %s", s)
+				}
+				list = append(list, new(big.Int).SetUint64(n))
+			}
+		} else {
+			to := uint64(*toBlock)
+			if *toBlock < 0 {
+				if to, err = client.BlockNumber(ctx); err != nil {
+					log.Fatal(err)
+				}
+			}
+			if *step == 0 || to < *fromBlock {
+				log.Fatal("want -step > 0 and -from <= -to")
+			}
+			list = portfolio.Range(*fromBlock, to, *step)
+		}
+		snaps, err := service.History(ctx, owners(args[1:]), list)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if *asJSON {
+			printResult(true, snaps)
+			return
+		}
+		for _, snap := range snaps {
+			printResult(false, snap)
+		}
+	case "serve":
+		router := gin.Default()
+		portfolio.Register(router, service)
+		log.Fatal(router.Run(*addr))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func printResult(asJSON bool, v any) {
+	if asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(v); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	snap := v.(*portfolio.Snapshot)
+	fmt.Printf("block %d (%s)\n", snap.Block, snap.Hash.Hex())
+	for _, h := range snap.Holdings {
+		fmt.Println(h.Owner.Hex())
+		fmt.Printf("  %-10s %s\n", "ETH", h.ETH.Formatted)
+		for _, b := range h.Tokens {
+			if b.Error != "" {
+				fmt.Printf("  %-10s error: %s\n", b.Symbol, b.Error)
+				continue
+			}
+			fmt.Printf("  %-10s %s\n", b.Symbol, b.Formatted)
+		}
+	}
+}
+
+func owners(args []string) []common.Address {
+	out := make([]common.Address, len(args))
+	for i, s := range args {
+		out[i] = address(s)
+	}
+	return out
+}
+
+func need(args []string, n int) {
+	if len(args) < n {
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func address(s string) common.Address {
+	if !common.IsHexAddress(s) {
+		log.Fatalf("invalid address %s", s)
+	}
+	return common.HexToAddress(s)
+}
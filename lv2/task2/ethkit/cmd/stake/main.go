@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strconv"
+
+	"ethkit/internal/cli"
+	"ethkit/stake"
+	"store/deploy"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const usage = `usage: stake [flags] <command>
+
+commands:
+  pools                        list pools
+  position <pid> <address>     staked balance, pending RCC and unlock schedule
+  deposit  <pid> <wei>         stake (approves the staking token first if needed)
+  unstake  <pid> <wei>         request unstake
+  withdraw <pid>               withdraw unlocked requests
+  claim    <pid>               claim RCC rewards
+`
+
+// RCCStake 命令行：查询池子和用户仓位，用 keystore 里的账户发交易
+// go run ./cmd/stake -rpc http://127.0.0.1:8545 pools
+// go run ./cmd/stake -keystore ./keys -from 0x... -password-file ./pass deposit 0 1000000000000000000
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	contract := flag.String("contract", "", "RCCStake address, defaults to the RCCStake entry in the deployment manifest")
+	dir := flag.String("deployments", "deployments", "deployment manifest directory")
+	keystoreDir := flag.String("keystore", "", "keystore directory for signing")
+	from := flag.String("from", "", "sender address in the keystore")
+	passwordFile := flag.String("password-file", "", "file containing the keystore passphrase")
+	dryRun := flag.Bool("dry-run", false, "estimate gas and fees, check balance and simulate, but do not send")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	address := common.HexToAddress(*contract)
+	if *contract == "" {
+		manager, err := deploy.NewManager(ctx, client, *dir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if address, err = manager.Address(ctx, "RCCStake"); err != nil {
+			log.Fatal(err)
+		}
+	}
+	staking, err := stake.New(address, client)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	args := flag.Args()
+	switch args[0] {
+	case "pools":
+		pools, err := staking.Pools(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, p := range pools {
+			token := p.StToken.Hex()
+			if p.IsETH() {
+				token = "ETH"
+			}
+			fmt.Printf("pool %d  token=%s weight=%s staked=%s minDeposit=%s lockBlocks=%d\n",
+				p.ID, token, p.Weight, p.Staked, p.MinDeposit, p.UnstakeLockedBlocks)
+		}
+	case "position":
+		need(args, 3)
+		if !common.IsHexAddress(args[2]) {
+			log.Fatalf("invalid address %s", args[2])
+		}
+		pos, err := staking.Position(ctx, pid(args[1]), common.HexToAddress(args[2]))
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("staked:       %s\n", pos.Staked)
+		fmt.Printf("pending RCC:  %s\n", pos.PendingRCC)
+		fmt.Printf("unstaking:    %s (withdrawable %s)\n", pos.Requested, pos.Withdrawable)
+		for _, u := range pos.Unlocks {
+			state := "locked"
+			if u.Unlocked {
+				state = "unlocked"
+			}
+			fmt.Printf("  %s at block %d (%s)\n", u.Amount, u.Block, state)
+		}
+	case "deposit", "unstake", "withdraw", "claim":
+		send(ctx, staking, cli.Transactor(ctx, client, *keystoreDir, *from, *passwordFile, *dryRun), args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func send(ctx context.Context, staking *stake.Client, auth *bind.TransactOpts, args []string) {
+	need(args, 2)
+	id := pid(args[1])
+	var (
+		tx  *types.Transaction
+		err error
+	)
+	switch args[0] {
+	case "deposit":
+		need(args, 3)
+		amount := wei(args[2])
+		approve, err := staking.EnsureAllowance(ctx, auth, id, amount)
+		if err != nil {
+			cli.Fatal(err)
+		}
+		if approve != nil {
+			fmt.Println("approve:", approve.Hash().Hex())
+			wait(ctx, staking, approve)
+		}
+		tx, err = staking.Deposit(ctx, auth, id, amount)
+		if err != nil {
+			cli.Fatal(err)
+		}
+	case "unstake":
+		need(args, 3)
+		tx, err = staking.Unstake(ctx, auth, id, wei(args[2]))
+	case "withdraw":
+		tx, err = staking.Withdraw(ctx, auth, id)
+	case "claim":
+		tx, err = staking.Claim(ctx, auth, id)
+	}
+	if err != nil {
+		cli.Fatal(err)
+	}
+	fmt.Printf("%s: %s\n", args[0], tx.Hash().Hex())
+	wait(ctx, staking, tx)
+}
+
+func wait(ctx context.Context, staking *stake.Client, tx *types.Transaction) {
+	receipt, err := staking.Wait(ctx, tx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		log.Fatalf("tx %s reverted in block %s", tx.Hash().Hex(), receipt.BlockNumber)
+	}
+	fmt.Println("mined in block", receipt.BlockNumber)
+}
+
+func need(args []string, n int) {
+	if len(args) < n {
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func pid(s string) uint64 {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		log.Fatalf("invalid pool id %s", s)
+	}
+	return id
+}
+
+func wei(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok || n.Sign() < 0 {
+		log.Fatalf("invalid amount %s", s)
+	}
+	return n
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"time"
+
+	"ethkit/internal/cli"
+	"ethkit/todo"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gin-gonic/gin"
+)
+
+const usage = `usage: todo [flags] <command>
+
+commands:
+  list                   sync and print all todos
+  create <name>
+  rename <id> <name>
+  toggle <id>
+  done   <id>            mark completed
+  undone <id>            mark not completed
+  serve                  keep the cache in sync and serve the HTTP API on -addr
+`
+
+// TodoList（lv1/task5/TodoList.sol）命令行，列表通过批量 eth_call 按下标读取
+// go run ./cmd/todo -contract 0x... list
+// go run ./cmd/todo -contract 0x... -keystore ./keys -from 0x... create "buy milk"
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	contract := flag.String("contract", "", "TodoList address")
+	cachePath := flag.String("cache", "", "local cache file, empty keeps the cache in memory only")
+	batch := flag.Int("batch", 100, "eth_call requests per JSON-RPC batch")
+	keystoreDir := flag.String("keystore", "", "keystore directory for signing")
+	from := flag.String("from", "", "sender address in the keystore")
+	passwordFile := flag.String("password-file", "", "file containing the keystore passphrase")
+	dryRun := flag.Bool("dry-run", false, "estimate gas and fees, check balance and simulate, but do not send")
+	interval := flag.Duration("interval", 5*time.Second, "sync interval for serve")
+	addr := flag.String("addr", ":8080", "listen address for serve")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 || !common.IsHexAddress(*contract) || *batch <= 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	list, err := todo.New(common.HexToAddress(*contract), client, client.Client(), *cachePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	list.BatchSize = *batch
+	sign := func() *bind.TransactOpts {
+		return cli.Transactor(ctx, client, *keystoreDir, *from, *passwordFile, *dryRun)
+	}
+
+	args := flag.Args()
+	var t todo.Todo
+	switch args[0] {
+	case "list":
+		block, err := list.Sync(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		todos, _ := list.List()
+		for _, t := range todos {
+			printTodo(t)
+		}
+		fmt.Printf("%d todos at block %d\n", len(todos), block)
+		return
+	case "create":
+		need(args, 2)
+		t, err = list.Create(ctx, sign(), args[1])
+	case "rename":
+		need(args, 3)
+		t, err = list.Rename(ctx, sign(), id(args[1]), args[2])
+	case "toggle":
+		need(args, 2)
+		t, err = list.Toggle(ctx, sign(), id(args[1]))
+	case "done", "undone":
+		need(args, 2)
+		t, err = list.SetCompleted(ctx, sign(), id(args[1]), args[0] == "done")
+	case "serve":
+		var auth *bind.TransactOpts
+		if *keystoreDir != "" {
+			auth = sign()
+		}
+		go list.Run(ctx, *interval)
+		router := gin.Default()
+		todo.Register(router, list, auth)
+		log.Fatal(router.Run(*addr))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		cli.Fatal(err)
+	}
+	printTodo(t)
+}
+
+func printTodo(t todo.Todo) {
+	mark := " "
+	if t.Completed {
+		mark = "x"
+	}
+	fmt.Printf("[%s] #%d %s\n", mark, t.ID, t.Name)
+}
+
+func need(args []string, n int) {
+	if len(args) < n {
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func id(s string) uint64 {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		log.Fatalf("invalid todo id %s", s)
+	}
+	return n
+}
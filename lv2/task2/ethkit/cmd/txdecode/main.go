@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"ethkit/abicall"
+	"ethkit/sigdb"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gin-gonic/gin"
+)
+
+const usage = `usage: txdecode [flags] <command>
+
+commands:
+  tx       <hash>                 decode a transaction's calldata and every log in its receipt
+  calldata <0xdata>               decode raw calldata
+  log      <0xdata> <topic>...    decode a log from its data and topics
+  sig      <selector|topic>       look up signatures
+  export                          print the signature database as JSON (readable by -import)
+  serve                           HTTP API on -addr
+
+The database always contains the contracts with generated bindings in this repo;
+-abi-dir adds every .abi and artifact .json under the given directories, -import adds signature files.
+`
+
+// calldata / 日志解码，签名来自仓库里的 ABI 和可导入的签名库
+// go run ./cmd/txdecode -abi-dir ..,../../../lv0/task3/hardhat-project tx 0x...
+// go run ./cmd/txdecode calldata 0xa9059cbb000000000000000000000000...
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	abiDirs := flag.String("abi-dir", "", "comma separated directories to load ABIs from")
+	imports := flag.String("import", "", "comma separated signature files (.json from export, or one signature per line)")
+	addr := flag.String("addr", ":8080", "listen address for serve")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	db := sigdb.Builtin()
+	for _, dir := range list(*abiDirs) {
+		n, err := db.LoadDir(dir)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("loaded %d ABI files from %s", n, dir)
+	}
+	for _, path := range list(*imports) {
+		n, err := db.Import(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("imported %d signatures from %s", n, path)
+	}
+
+	ctx := context.Background()
+	args := flag.Args()
+	switch args[0] {
+	case "tx":
+		need(args, 2)
+		client := dial(*rpcURL)
+		tx, pending, err := client.TransactionByHash(ctx, common.HexToHash(args[1]))
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("tx %s to %v value %s\n", tx.Hash().Hex(), tx.To(), tx.Value())
+		if len(tx.Data()) > 0 {
+			printCall(db, tx.Data())
+		}
+		if pending {
+			fmt.Println("pending")
+			return
+		}
+		receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("status %d, %d logs\n", receipt.Status, len(receipt.Logs))
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			printRevert(db, abicall.ReplayRevert(ctx, client, tx, receipt))
+		}
+		for _, l := range receipt.Logs {
+			printLog(db, *l)
+		}
+	case "calldata":
+		need(args, 2)
+		printCall(db, decodeHex(args[1]))
+	case "log":
+		need(args, 3)
+		l := types.Log{Data: decodeHex(args[1])}
+		for _, topic := range args[2:] {
+			l.Topics = append(l.Topics, common.HexToHash(topic))
+		}
+		printLog(db, l)
+	case "sig":
+		need(args, 2)
+		id := decodeHex(args[1])
+		switch len(id) {
+		case 4:
+			for _, m := range db.Methods([4]byte(id)) {
+				fmt.Printf("function %s  (%s)\n", m.Sig, m.Source)
+			}
+			for _, e := range db.Errors([4]byte(id)) {
+				fmt.Printf("error %s  (%s)\n", e.Sig, e.Source)
+			}
+		case 32:
+			for _, e := range db.Events(common.BytesToHash(id)) {
+				fmt.Printf("event %s  (%s)\n", e.Sig, e.Source)
+			}
+		default:
+			log.Fatal("want a 4-byte selector or a 32-byte topic")
+		}
+	case "export":
+		if err := db.Export(os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+	case "serve":
+		router := gin.Default()
+		sigdb.Register(router, db, dial(*rpcURL))
+		log.Fatal(router.Run(*addr))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func printCall(db *sigdb.DB, data []byte) {
+	call, err := db.DecodeCalldata(data)
+	if err != nil {
+		fmt.Println("calldata:", err)
+		return
+	}
+	fmt.Printf("call %s  (%s)\n", call.Signature, call.Source)
+	printArgs(call.Args)
+	for _, other := range call.Others {
+		fmt.Println("  also matches", other)
+	}
+}
+
+// printRevert 打印重放得到的 revert 原因，自定义错误在签名库里查
+func printRevert(db *sigdb.DB, err error) {
+	var revert *abicall.RevertError
+	if !errors.As(err, &revert) {
+		fmt.Println("replay:", err)
+		return
+	}
+	if revert.Kind == abicall.RevertUnknown && len(revert.Data) >= 4 {
+		if decoded, err := db.DecodeError(revert.Data); err == nil {
+			fmt.Printf("reverted with %s  (%s)\n", decoded.Signature, decoded.Source)
+			printArgs(decoded.Args)
+			return
+		}
+	}
+	fmt.Println(revert)
+}
+
+func printLog(db *sigdb.DB, l types.Log) {
+	decoded, err := db.DecodeLog(l)
+	if err != nil {
+		fmt.Printf("log %d %s: %v\n", l.Index, l.Address.Hex(), err)
+		return
+	}
+	guessed := ""
+	if decoded.Guessed {
+		guessed = ", indexed guessed"
+	}
+	fmt.Printf("log %d %s: %s  (%s%s)\n", l.Index, l.Address.Hex(), decoded.Signature, decoded.Source, guessed)
+	printArgs(decoded.Args)
+}
+
+func printArgs(args []abicall.Value) {
+	for _, a := range args {
+		fmt.Printf("  %s (%s): %s\n", a.Name, a.Type, a)
+	}
+}
+
+func dial(url string) *ethclient.Client {
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return client
+}
+
+func decodeHex(s string) []byte {
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		log.Fatalf("invalid hex %s: %v", s, err)
+	}
+	return b
+}
+
+func need(args []string, n int) {
+	if len(args) < n {
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func list(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
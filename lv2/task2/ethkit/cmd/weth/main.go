@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strconv"
+
+	"ethkit/internal/cli"
+	"ethkit/wrapped"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const usage = `usage: weth [flags] <command>
+
+commands:
+  balance   <address>
+  wrap      <wei>                     deposit ETH
+  unwrap    <wei>                     withdraw ETH
+  approve   <spender> <wei|max>       raise the allowance if it is below the amount
+  reconcile <address> <from> <to>     check balance changes against Deposit/Withdraw/Transfer events
+`
+
+// WETH 类代币命令行，-variant 选择合约的方法名：weth9 是标准 WETH，task5 是 lv1/task5/WETH.sol
+// go run ./cmd/weth -token 0x... -keystore ./keys -from 0x... wrap 1000000000000000000
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	tokenAddr := flag.String("token", "", "token address")
+	variant := flag.String("variant", "weth9", "contract variant: weth9 or task5")
+	keystoreDir := flag.String("keystore", "", "keystore directory for signing")
+	from := flag.String("from", "", "sender address in the keystore")
+	passwordFile := flag.String("password-file", "", "file containing the keystore passphrase")
+	dryRun := flag.Bool("dry-run", false, "estimate gas and fees, check balance and simulate, but do not send")
+	reset := flag.Bool("reset", false, "approve: set a non-zero allowance to 0 before changing it")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 || !common.IsHexAddress(*tokenAddr) {
+		flag.Usage()
+		os.Exit(2)
+	}
+	v, ok := map[string]wrapped.Variant{"weth9": wrapped.WETH9, "task5": wrapped.Task5}[*variant]
+	if !ok {
+		log.Fatalf("unknown variant %s", *variant)
+	}
+
+	ctx := context.Background()
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	tok, err := wrapped.New(common.HexToAddress(*tokenAddr), client, v)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sign := func() *bind.TransactOpts {
+		return cli.Transactor(ctx, client, *keystoreDir, *from, *passwordFile, *dryRun)
+	}
+
+	args := flag.Args()
+	switch args[0] {
+	case "balance":
+		need(args, 2)
+		balance, err := tok.BalanceOf(ctx, address(args[1]))
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(balance)
+	case "wrap", "unwrap":
+		need(args, 2)
+		var tx *types.Transaction
+		if args[0] == "wrap" {
+			tx, err = tok.Wrap(ctx, sign(), wei(args[1]))
+		} else {
+			tx, err = tok.Unwrap(ctx, sign(), wei(args[1]))
+		}
+		if err != nil {
+			cli.Fatal(err)
+		}
+		wait(ctx, tok, tx)
+	case "approve":
+		need(args, 3)
+		opt := wrapped.ApproveOptions{ResetFirst: *reset}
+		amount := big.NewInt(1)
+		if args[2] == "max" {
+			opt.Infinite = true
+		} else {
+			amount = wei(args[2])
+		}
+		txs, err := tok.EnsureAllowance(ctx, sign(), address(args[1]), amount, opt)
+		if err != nil {
+			cli.Fatal(err)
+		}
+		if len(txs) == 0 {
+			fmt.Println("allowance already sufficient")
+		}
+		for _, tx := range txs {
+			wait(ctx, tok, tx)
+		}
+	case "reconcile":
+		need(args, 4)
+		r, err := tok.Reconcile(ctx, address(args[1]), block(args[2]), block(args[3]))
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, c := range r.Changes {
+			fmt.Printf("block %d  %-6s %s %s\n", c.Block, c.Kind, c.Amount, c.TxHash.Hex())
+		}
+		fmt.Printf("start %s + wrapped %s - unwrapped %s + in %s - out %s = %s\n",
+			r.Start, r.Wrapped, r.Unwrapped, r.In, r.Out, r.Expected())
+		fmt.Printf("balance at %d: %s\n", r.To, r.End)
+		if !r.Balanced() {
+			log.Fatalf("mismatch: events explain %s, balance is %s", r.Expected(), r.End)
+		}
+		fmt.Println("balanced")
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func wait(ctx context.Context, tok *wrapped.Token, tx *types.Transaction) {
+	fmt.Println("tx:", tx.Hash().Hex())
+	receipt, err := tok.Wait(ctx, tx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("mined in block", receipt.BlockNumber)
+}
+
+func need(args []string, n int) {
+	if len(args) < n {
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func address(s string) common.Address {
+	if !common.IsHexAddress(s) {
+		log.Fatalf("invalid address %s", s)
+	}
+	return common.HexToAddress(s)
+}
+
+func wei(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok || n.Sign() < 0 {
+		log.Fatalf("invalid amount %s", s)
+	}
+	return n
+}
+
+func block(s string) uint64 {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		log.Fatalf("invalid block %s", s)
+	}
+	return n
+}
@@ -0,0 +1,462 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package crowdfunding
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CrowdFundingMetaData contains all meta data concerning the CrowdFunding contract.
+var CrowdFundingMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"beneficiary_\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"golal_\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AVALIABLED\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"beneficiary\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"close\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"contribute\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"funderLenght\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"funders\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"funderskey\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"fundingAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"fundingGoal\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Bin: "0x60c06040526004805460ff1916600117905534801561001d57600080fd5b5060405161062e38038061062e83398101604081905261003c91610052565b6001600160a01b0390911660805260a05261008c565b6000806040838503121561006557600080fd5b82516001600160a01b038116811461007c57600080fd5b6020939093015192949293505050565b60805160a0516105626100cc60003960008181610199015281816101e301528181610311015261033c01526000818160f3015261023101526105626000f3fe6080604052600436106100865760003560e01c806346761bc01161005957806346761bc01461015257806372fbd483146101675780637a3a0e8414610187578063c47f987c146101bb578063d7bb99ba146101d557600080fd5b8063031b36771461008b57806304a79c97146100cb57806338af3eed146100e157806343d726d61461012d575b600080fd5b34801561009757600080fd5b506100b86100a63660046104a1565b60016020526000908152604090205481565b6040519081526020015b60405180910390f35b3480156100d757600080fd5b506100b860005481565b3480156100ed57600080fd5b506101157f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016100c2565b34801561013957600080fd5b506101426101df565b60405190151581526020016100c2565b34801561015e57600080fd5b506003546100b8565b34801561017357600080fd5b506101156101823660046104d1565b610282565b34801561019357600080fd5b506100b87f000000000000000000000000000000000000000000000000000000000000000081565b3480156101c757600080fd5b506004546101429060ff1681565b6101dd6102ac565b005b60007f000000000000000000000000000000000000000000000000000000000000000060005410156102115750600090565b600080548180556004805460ff1916905560405190916001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000169183156108fc0291849190818181858888f19350505050158015610279573d6000803e3d6000fd5b50600191505090565b6003818154811061029257600080fd5b6000918252602090912001546001600160a01b0316905081565b60045460ff166102fb5760405162461bcd60e51b815260206004820152601660248201527510dc9bddd9119d5b991a5b99c81a5cc818db1bdcd95960521b604482015260640160405180910390fd5b60003460005461030b9190610500565b905060007f00000000000000000000000000000000000000000000000000000000000000008211156103b7576103617f000000000000000000000000000000000000000000000000000000000000000083610519565b905061036d8134610519565b336000908152600160205260408120805490919061038c908490610500565b9091555061039c90508134610519565b6000808282546103ac9190610500565b909155506103f49050565b33600090815260016020526040812080543492906103d6908490610500565b92505081905550346000808282546103ee9190610500565b90915550505b3360009081526002602052604090205460ff1661046857336000818152600260205260408120805460ff191660019081179091556003805491820181559091527fc2575a0e9e593c00f959f8c92f12db2869c3395a3b0502d05e2516446f71f85b0180546001600160a01b03191690911790555b801561049d57604051339082156108fc029083906000818181858888f1935050505015801561049b573d6000803e3d6000fd5b505b5050565b6000602082840312156104b357600080fd5b81356001600160a01b03811681146104ca57600080fd5b9392505050565b6000602082840312156104e357600080fd5b5035919050565b634e487b7160e01b600052601160045260246000fd5b80820180821115610513576105136104ea565b92915050565b81810381811115610513576105136104ea56fea26469706673582212202a7b012faff82c75bddb1fb5436f53f662ebb008f136e309305043e93b93480e64736f6c634300081e0033",
+}
+
+// CrowdFundingABI is the input ABI used to generate the binding from.
+// Deprecated: Use CrowdFundingMetaData.ABI instead.
+var CrowdFundingABI = CrowdFundingMetaData.ABI
+
+// CrowdFundingBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use CrowdFundingMetaData.Bin instead.
+var CrowdFundingBin = CrowdFundingMetaData.Bin
+
+// DeployCrowdFunding deploys a new Ethereum contract, binding an instance of CrowdFunding to it.
+func DeployCrowdFunding(auth *bind.TransactOpts, backend bind.ContractBackend, beneficiary_ common.Address, golal_ *big.Int) (common.Address, *types.Transaction, *CrowdFunding, error) {
+	parsed, err := CrowdFundingMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(CrowdFundingBin), backend, beneficiary_, golal_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &CrowdFunding{CrowdFundingCaller: CrowdFundingCaller{contract: contract}, CrowdFundingTransactor: CrowdFundingTransactor{contract: contract}, CrowdFundingFilterer: CrowdFundingFilterer{contract: contract}}, nil
+}
+
+// CrowdFunding is an auto generated Go binding around an Ethereum contract.
+type CrowdFunding struct {
+	CrowdFundingCaller     // Read-only binding to the contract
+	CrowdFundingTransactor // Write-only binding to the contract
+	CrowdFundingFilterer   // Log filterer for contract events
+}
+
+// CrowdFundingCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CrowdFundingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CrowdFundingTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CrowdFundingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CrowdFundingFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CrowdFundingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CrowdFundingSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CrowdFundingSession struct {
+	Contract     *CrowdFunding     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// CrowdFundingCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CrowdFundingCallerSession struct {
+	Contract *CrowdFundingCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// CrowdFundingTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CrowdFundingTransactorSession struct {
+	Contract     *CrowdFundingTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// CrowdFundingRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CrowdFundingRaw struct {
+	Contract *CrowdFunding // Generic contract binding to access the raw methods on
+}
+
+// CrowdFundingCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CrowdFundingCallerRaw struct {
+	Contract *CrowdFundingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CrowdFundingTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CrowdFundingTransactorRaw struct {
+	Contract *CrowdFundingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCrowdFunding creates a new instance of CrowdFunding, bound to a specific deployed contract.
+func NewCrowdFunding(address common.Address, backend bind.ContractBackend) (*CrowdFunding, error) {
+	contract, err := bindCrowdFunding(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CrowdFunding{CrowdFundingCaller: CrowdFundingCaller{contract: contract}, CrowdFundingTransactor: CrowdFundingTransactor{contract: contract}, CrowdFundingFilterer: CrowdFundingFilterer{contract: contract}}, nil
+}
+
+// NewCrowdFundingCaller creates a new read-only instance of CrowdFunding, bound to a specific deployed contract.
+func NewCrowdFundingCaller(address common.Address, caller bind.ContractCaller) (*CrowdFundingCaller, error) {
+	contract, err := bindCrowdFunding(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CrowdFundingCaller{contract: contract}, nil
+}
+
+// NewCrowdFundingTransactor creates a new write-only instance of CrowdFunding, bound to a specific deployed contract.
+func NewCrowdFundingTransactor(address common.Address, transactor bind.ContractTransactor) (*CrowdFundingTransactor, error) {
+	contract, err := bindCrowdFunding(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CrowdFundingTransactor{contract: contract}, nil
+}
+
+// NewCrowdFundingFilterer creates a new log filterer instance of CrowdFunding, bound to a specific deployed contract.
+func NewCrowdFundingFilterer(address common.Address, filterer bind.ContractFilterer) (*CrowdFundingFilterer, error) {
+	contract, err := bindCrowdFunding(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CrowdFundingFilterer{contract: contract}, nil
+}
+
+// bindCrowdFunding binds a generic wrapper to an already deployed contract.
+func bindCrowdFunding(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CrowdFundingMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CrowdFunding *CrowdFundingRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CrowdFunding.Contract.CrowdFundingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CrowdFunding *CrowdFundingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CrowdFunding.Contract.CrowdFundingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CrowdFunding *CrowdFundingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CrowdFunding.Contract.CrowdFundingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CrowdFunding *CrowdFundingCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CrowdFunding.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CrowdFunding *CrowdFundingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CrowdFunding.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CrowdFunding *CrowdFundingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CrowdFunding.Contract.contract.Transact(opts, method, params...)
+}
+
+// AVALIABLED is a free data retrieval call binding the contract method 0xc47f987c.
+//
+// Solidity: function AVALIABLED() view returns(bool)
+func (_CrowdFunding *CrowdFundingCaller) AVALIABLED(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _CrowdFunding.contract.Call(opts, &out, "AVALIABLED")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// AVALIABLED is a free data retrieval call binding the contract method 0xc47f987c.
+//
+// Solidity: function AVALIABLED() view returns(bool)
+func (_CrowdFunding *CrowdFundingSession) AVALIABLED() (bool, error) {
+	return _CrowdFunding.Contract.AVALIABLED(&_CrowdFunding.CallOpts)
+}
+
+// AVALIABLED is a free data retrieval call binding the contract method 0xc47f987c.
+//
+// Solidity: function AVALIABLED() view returns(bool)
+func (_CrowdFunding *CrowdFundingCallerSession) AVALIABLED() (bool, error) {
+	return _CrowdFunding.Contract.AVALIABLED(&_CrowdFunding.CallOpts)
+}
+
+// Beneficiary is a free data retrieval call binding the contract method 0x38af3eed.
+//
+// Solidity: function beneficiary() view returns(address)
+func (_CrowdFunding *CrowdFundingCaller) Beneficiary(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CrowdFunding.contract.Call(opts, &out, "beneficiary")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Beneficiary is a free data retrieval call binding the contract method 0x38af3eed.
+//
+// Solidity: function beneficiary() view returns(address)
+func (_CrowdFunding *CrowdFundingSession) Beneficiary() (common.Address, error) {
+	return _CrowdFunding.Contract.Beneficiary(&_CrowdFunding.CallOpts)
+}
+
+// Beneficiary is a free data retrieval call binding the contract method 0x38af3eed.
+//
+// Solidity: function beneficiary() view returns(address)
+func (_CrowdFunding *CrowdFundingCallerSession) Beneficiary() (common.Address, error) {
+	return _CrowdFunding.Contract.Beneficiary(&_CrowdFunding.CallOpts)
+}
+
+// FunderLenght is a free data retrieval call binding the contract method 0x46761bc0.
+//
+// Solidity: function funderLenght() view returns(uint256)
+func (_CrowdFunding *CrowdFundingCaller) FunderLenght(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CrowdFunding.contract.Call(opts, &out, "funderLenght")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FunderLenght is a free data retrieval call binding the contract method 0x46761bc0.
+//
+// Solidity: function funderLenght() view returns(uint256)
+func (_CrowdFunding *CrowdFundingSession) FunderLenght() (*big.Int, error) {
+	return _CrowdFunding.Contract.FunderLenght(&_CrowdFunding.CallOpts)
+}
+
+// FunderLenght is a free data retrieval call binding the contract method 0x46761bc0.
+//
+// Solidity: function funderLenght() view returns(uint256)
+func (_CrowdFunding *CrowdFundingCallerSession) FunderLenght() (*big.Int, error) {
+	return _CrowdFunding.Contract.FunderLenght(&_CrowdFunding.CallOpts)
+}
+
+// Funders is a free data retrieval call binding the contract method 0x031b3677.
+//
+// Solidity: function funders(address ) view returns(uint256)
+func (_CrowdFunding *CrowdFundingCaller) Funders(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _CrowdFunding.contract.Call(opts, &out, "funders", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Funders is a free data retrieval call binding the contract method 0x031b3677.
+//
+// Solidity: function funders(address ) view returns(uint256)
+func (_CrowdFunding *CrowdFundingSession) Funders(arg0 common.Address) (*big.Int, error) {
+	return _CrowdFunding.Contract.Funders(&_CrowdFunding.CallOpts, arg0)
+}
+
+// Funders is a free data retrieval call binding the contract method 0x031b3677.
+//
+// Solidity: function funders(address ) view returns(uint256)
+func (_CrowdFunding *CrowdFundingCallerSession) Funders(arg0 common.Address) (*big.Int, error) {
+	return _CrowdFunding.Contract.Funders(&_CrowdFunding.CallOpts, arg0)
+}
+
+// Funderskey is a free data retrieval call binding the contract method 0x72fbd483.
+//
+// Solidity: function funderskey(uint256 ) view returns(address)
+func (_CrowdFunding *CrowdFundingCaller) Funderskey(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _CrowdFunding.contract.Call(opts, &out, "funderskey", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Funderskey is a free data retrieval call binding the contract method 0x72fbd483.
+//
+// Solidity: function funderskey(uint256 ) view returns(address)
+func (_CrowdFunding *CrowdFundingSession) Funderskey(arg0 *big.Int) (common.Address, error) {
+	return _CrowdFunding.Contract.Funderskey(&_CrowdFunding.CallOpts, arg0)
+}
+
+// Funderskey is a free data retrieval call binding the contract method 0x72fbd483.
+//
+// Solidity: function funderskey(uint256 ) view returns(address)
+func (_CrowdFunding *CrowdFundingCallerSession) Funderskey(arg0 *big.Int) (common.Address, error) {
+	return _CrowdFunding.Contract.Funderskey(&_CrowdFunding.CallOpts, arg0)
+}
+
+// FundingAmount is a free data retrieval call binding the contract method 0x04a79c97.
+//
+// Solidity: function fundingAmount() view returns(uint256)
+func (_CrowdFunding *CrowdFundingCaller) FundingAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CrowdFunding.contract.Call(opts, &out, "fundingAmount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FundingAmount is a free data retrieval call binding the contract method 0x04a79c97.
+//
+// Solidity: function fundingAmount() view returns(uint256)
+func (_CrowdFunding *CrowdFundingSession) FundingAmount() (*big.Int, error) {
+	return _CrowdFunding.Contract.FundingAmount(&_CrowdFunding.CallOpts)
+}
+
+// FundingAmount is a free data retrieval call binding the contract method 0x04a79c97.
+//
+// Solidity: function fundingAmount() view returns(uint256)
+func (_CrowdFunding *CrowdFundingCallerSession) FundingAmount() (*big.Int, error) {
+	return _CrowdFunding.Contract.FundingAmount(&_CrowdFunding.CallOpts)
+}
+
+// FundingGoal is a free data retrieval call binding the contract method 0x7a3a0e84.
+//
+// Solidity: function fundingGoal() view returns(uint256)
+func (_CrowdFunding *CrowdFundingCaller) FundingGoal(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CrowdFunding.contract.Call(opts, &out, "fundingGoal")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// FundingGoal is a free data retrieval call binding the contract method 0x7a3a0e84.
+//
+// Solidity: function fundingGoal() view returns(uint256)
+func (_CrowdFunding *CrowdFundingSession) FundingGoal() (*big.Int, error) {
+	return _CrowdFunding.Contract.FundingGoal(&_CrowdFunding.CallOpts)
+}
+
+// FundingGoal is a free data retrieval call binding the contract method 0x7a3a0e84.
+//
+// Solidity: function fundingGoal() view returns(uint256)
+func (_CrowdFunding *CrowdFundingCallerSession) FundingGoal() (*big.Int, error) {
+	return _CrowdFunding.Contract.FundingGoal(&_CrowdFunding.CallOpts)
+}
+
+// Close is a paid mutator transaction binding the contract method 0x43d726d6.
+//
+// Solidity: function close() returns(bool)
+func (_CrowdFunding *CrowdFundingTransactor) Close(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CrowdFunding.contract.Transact(opts, "close")
+}
+
+// Close is a paid mutator transaction binding the contract method 0x43d726d6.
+//
+// Solidity: function close() returns(bool)
+func (_CrowdFunding *CrowdFundingSession) Close() (*types.Transaction, error) {
+	return _CrowdFunding.Contract.Close(&_CrowdFunding.TransactOpts)
+}
+
+// Close is a paid mutator transaction binding the contract method 0x43d726d6.
+//
+// Solidity: function close() returns(bool)
+func (_CrowdFunding *CrowdFundingTransactorSession) Close() (*types.Transaction, error) {
+	return _CrowdFunding.Contract.Close(&_CrowdFunding.TransactOpts)
+}
+
+// Contribute is a paid mutator transaction binding the contract method 0xd7bb99ba.
+//
+// Solidity: function contribute() payable returns()
+func (_CrowdFunding *CrowdFundingTransactor) Contribute(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CrowdFunding.contract.Transact(opts, "contribute")
+}
+
+// Contribute is a paid mutator transaction binding the contract method 0xd7bb99ba.
+//
+// Solidity: function contribute() payable returns()
+func (_CrowdFunding *CrowdFundingSession) Contribute() (*types.Transaction, error) {
+	return _CrowdFunding.Contract.Contribute(&_CrowdFunding.TransactOpts)
+}
+
+// Contribute is a paid mutator transaction binding the contract method 0xd7bb99ba.
+//
+// Solidity: function contribute() payable returns()
+func (_CrowdFunding *CrowdFundingTransactorSession) Contribute() (*types.Transaction, error) {
+	return _CrowdFunding.Contract.Contribute(&_CrowdFunding.TransactOpts)
+}
@@ -0,0 +1,26 @@
+package multicall3
+
+import (
+	_ "embed"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// 这里的字节码不是重新编译的，是主网上的原版 Multicall3（github.com/mds1/multicall，solc 0.8.12）：
+// Multicall3.bin 是那笔无密钥部署交易的 initcode，Multicall3.bin-runtime 是 Address 上的代码，
+// keccak256 等于 CodeHash，可以用 eth_getCode 在任意一条部署过的链上核对
+
+// Address 是 Multicall3 在主网、各测试网和大多数 L2 上的地址（同一笔无密钥部署交易）
+var Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// CodeHash 是 Address 上运行时代码的 keccak256
+var CodeHash = common.HexToHash("0xd5c15df687b16f2ff992fc8d767b4216323184a2bbc6ee2f9c398c318e770891")
+
+//go:embed Multicall3.bin-runtime
+var runtime string
+
+// Runtime 返回 Address 上的运行时代码，本地链把它写进创世块，就能和主网一样直接用 Address
+func Runtime() []byte {
+	return common.FromHex(strings.TrimSpace(runtime))
+}
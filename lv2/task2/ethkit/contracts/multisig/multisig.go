@@ -0,0 +1,1280 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package multisig
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MultiSigWalletMetaData contains all meta data concerning the MultiSigWallet contract.
+var MultiSigWalletMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_owners\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"_required\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"Approve\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"txId\",\"type\":\"uint256\"}],\"name\":\"Execute\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"txId\",\"type\":\"uint256\"}],\"name\":\"Revoke\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"txId\",\"type\":\"uint256\"}],\"name\":\"Submit\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_txId\",\"type\":\"uint256\"}],\"name\":\"approv\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"approved\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_txId\",\"type\":\"uint256\"}],\"name\":\"execute\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_txId\",\"type\":\"uint256\"}],\"name\":\"getApprovalCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"count\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"isOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"owners\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"required\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_txId\",\"type\":\"uint256\"}],\"name\":\"revoke\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"submit\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"transactions\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bool\",\"name\":\"exected\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x608060405234801561001057600080fd5b506040516111b13803806111b183398101604081905261002f91610267565b60008251116100765760405162461bcd60e51b815260206004820152600e60248201526d1bdddb995c881c995c5d5a5c995960921b60448201526064015b60405180910390fd5b600081118015610087575081518111155b6100dd5760405162461bcd60e51b815260206004820152602160248201527f696e76616c6964207265717569726564206e756d626572206f66206f776e65726044820152607360f81b606482015260840161006d565b60005b825181101561022b5760008382815181106100fd576100fd610340565b6020026020010151905060006001600160a01b0316816001600160a01b0316036101595760405162461bcd60e51b815260206004820152600d60248201526c34b73b30b634b21037bbb732b960991b604482015260640161006d565b6001600160a01b03811660009081526001602052604090205460ff16156101c25760405162461bcd60e51b815260206004820152601360248201527f6f776e6572206973206e6f7420756e6971756500000000000000000000000000604482015260640161006d565b6001600160a01b031660008181526001602081905260408220805460ff191682179055815480820183559180527f290decd9548b62a8d60345a988386fc84ba6bc95484008f6362f93160ef3e56390910180546001600160a01b031916909217909155016100e0565b5060025550610356565b634e487b7160e01b600052604160045260246000fd5b80516001600160a01b038116811461026257600080fd5b919050565b6000806040838503121561027a57600080fd5b82516001600160401b0381111561029057600080fd5b8301601f810185136102a157600080fd5b80516001600160401b038111156102ba576102ba610235565b604051600582901b90603f8201601f191681016001600160401b03811182821017156102e8576102e8610235565b60405291825260208184018101929081018884111561030657600080fd5b6020850194505b8385101561032c5761031e8561024b565b81526020948501940161030d565b506020969096015195979596505050505050565b634e487b7160e01b600052603260045260246000fd5b610e4c806103656000396000f3fe6080604052600436106100a05760003560e01c80636bdddcde116100645780636bdddcde146101bd5780638253951a146101dd5780639ace38c214610218578063ba7e7cab14610248578063dc8452cd14610268578063fe0d94c11461027e57600080fd5b8063025e7c27146100e157806312065fe01461011e57806314de327f1461013b57806320c5429b1461015b5780632f54bf6e1461017d57600080fd5b366100dc5760405134815233907fe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c9060200160405180910390a2005b600080fd5b3480156100ed57600080fd5b506101016100fc366004610a06565b61029e565b6040516001600160a01b0390911681526020015b60405180910390f35b34801561012a57600080fd5b50475b604051908152602001610115565b34801561014757600080fd5b5061012d610156366004610a3b565b6102c8565b34801561016757600080fd5b5061017b610176366004610a06565b610423565b005b34801561018957600080fd5b506101ad610198366004610ac5565b60016020526000908152604090205460ff1681565b6040519015158152602001610115565b3480156101c957600080fd5b5061017b6101d8366004610a06565b610565565b3480156101e957600080fd5b506101ad6101f8366004610ae7565b600460209081526000928352604080842090915290825290205460ff1681565b34801561022457600080fd5b50610238610233366004610a06565b6106bd565b6040516101159493929190610b13565b34801561025457600080fd5b5061012d610263366004610a06565b61078f565b34801561027457600080fd5b5061012d60025481565b34801561028a57600080fd5b5061017b610299366004610a06565b61080d565b600081815481106102ae57600080fd5b6000918252602090912001546001600160a01b0316905081565b3360009081526001602052604081205460ff166103005760405162461bcd60e51b81526004016102f790610b7d565b60405180910390fd5b60036040518060800160405280876001600160a01b0316815260200186815260200185858080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920182905250938552505050602091820181905283546001808201865594825290829020835160049092020180546001600160a01b0319166001600160a01b039092169190911781559082015192810192909255604081015190919060028201906103b99082610c39565b50606091909101516003918201805460ff1916911515919091179055546103e290600190610d0e565b6040517f08324b3d745b914e3abd4ffbfead91e3b78391a98c173202129215ab933adfbe90600090a260035461041a90600190610d0e565b95945050505050565b3360009081526001602052604090205460ff166104525760405162461bcd60e51b81526004016102f790610b7d565b600354819081106104755760405162461bcd60e51b81526004016102f790610d27565b816003818154811061048957610489610d51565b600091825260209091206003600490920201015460ff16156104bd5760405162461bcd60e51b81526004016102f790610d67565b600083815260046020908152604080832033845290915290205460ff166105185760405162461bcd60e51b815260206004820152600f60248201526e1d1e081b9bdd08185c1c1c9bdd9959608a1b60448201526064016102f7565b6000838152600460209081526040808320338085529252808320805460ff191690555185927fec9ab91322523c899ede7830ec9bfc992b5981cdcc27b91162fb23de5791117b91a3505050565b3360009081526001602052604090205460ff166105945760405162461bcd60e51b81526004016102f790610b7d565b600354819081106105b75760405162461bcd60e51b81526004016102f790610d27565b6000828152600460209081526040808320338452909152902054829060ff16156106195760405162461bcd60e51b81526020600482015260136024820152721d1e08185b1c9958591e48185c1c1c9bdd9959606a1b60448201526064016102f7565b826003818154811061062d5761062d610d51565b600091825260209091206003600490920201015460ff16156106615760405162461bcd60e51b81526004016102f790610d67565b60008481526004602090815260408083203380855290835292819020805460ff19166001179055518681527f90ec57f18fa7b15c6b8d5e4d1deb90796c74b2ff23d4d0cecad0cb42a96b3128910160405180910390a250505050565b600381815481106106cd57600080fd5b60009182526020909120600490910201805460018201546002830180546001600160a01b03909316945090929161070390610bb6565b80601f016020809104026020016040519081016040528092919081815260200182805461072f90610bb6565b801561077c5780601f106107515761010080835404028352916020019161077c565b820191906000526020600020905b81548152906001019060200180831161075f57829003601f168201915b5050506003909301549192505060ff1684565b6000805b600054811015610807576004600084815260200190815260200160002060008083815481106107c4576107c4610d51565b60009182526020808320909101546001600160a01b0316835282019290925260400190205460ff16156107ff576107fc600183610d8e565b91505b600101610793565b50919050565b3360009081526001602052604090205460ff1661083c5760405162461bcd60e51b81526004016102f790610b7d565b6003548190811061085f5760405162461bcd60e51b81526004016102f790610d27565b816003818154811061087357610873610d51565b600091825260209091206003600490920201015460ff16156108a75760405162461bcd60e51b81526004016102f790610d67565b6002546108b38461078f565b10156108f85760405162461bcd60e51b8152602060048201526014602482015273185c1c1c9bdd985b1cc80f081c995c5d5a5c995960621b60448201526064016102f7565b60006003848154811061090d5761090d610d51565b6000918252602082206003600490920201908101805460ff191660019081179091558154908201546040519294506001600160a01b0390911691610955906002860190610da1565b60006040518083038185875af1925050503d8060008114610992576040519150601f19603f3d011682016040523d82523d6000602084013e610997565b606091505b50509050806109d45760405162461bcd60e51b81526020600482015260096024820152681d1e0819985a5b195960ba1b60448201526064016102f7565b60405185907fddb556f1d2c1ec821e910b019d3685b229db152a0ecd517ca7e24b8bd713928990600090a25050505050565b600060208284031215610a1857600080fd5b5035919050565b80356001600160a01b0381168114610a3657600080fd5b919050565b60008060008060608587031215610a5157600080fd5b610a5a85610a1f565b935060208501359250604085013567ffffffffffffffff811115610a7d57600080fd5b8501601f81018713610a8e57600080fd5b803567ffffffffffffffff811115610aa557600080fd5b876020828401011115610ab757600080fd5b949793965060200194505050565b600060208284031215610ad757600080fd5b610ae082610a1f565b9392505050565b60008060408385031215610afa57600080fd5b82359150610b0a60208401610a1f565b90509250929050565b60018060a01b0385168152836020820152608060408201526000835180608084015260005b81811015610b5557602081870181015160a0868401015201610b38565b50600060a0828501015260a0601f19601f83011684010191505061041a606083018415159052565b6020808252600990820152682737ba1037bbb732b960b91b604082015260600190565b634e487b7160e01b600052604160045260246000fd5b600181811c90821680610bca57607f821691505b60208210810361080757634e487b7160e01b600052602260045260246000fd5b601f821115610c3457806000526020600020601f840160051c81016020851015610c115750805b601f840160051c820191505b81811015610c315760008155600101610c1d565b50505b505050565b815167ffffffffffffffff811115610c5357610c53610ba0565b610c6781610c618454610bb6565b84610bea565b6020601f821160018114610c9b5760008315610c835750848201515b600019600385901b1c1916600184901b178455610c31565b600084815260208120601f198516915b82811015610ccb5787850151825560209485019460019092019101610cab565b5084821015610ce95786840151600019600387901b60f8161c191681555b50505050600190811b01905550565b634e487b7160e01b600052601160045260246000fd5b81810381811115610d2157610d21610cf8565b92915050565b60208082526010908201526f1d1e08191bd95cdb89dd08195e1a5cdd60821b604082015260600190565b634e487b7160e01b600052603260045260246000fd5b6020808252600d908201526c1d1e081a5cc8195e1958dd1959609a1b604082015260600190565b80820180821115610d2157610d21610cf8565b6000808354610daf81610bb6565b600182168015610dc65760018114610ddb57610e0b565b60ff1983168652811515820286019350610e0b565b86600052602060002060005b83811015610e0357815488820152600190910190602001610de7565b505081860193505b50919594505050505056fea2646970667358221220572876f088776120d008318c7333693ae626e5d89ce6f041da0503447a52e4dc64736f6c634300081e0033",
+}
+
+// MultiSigWalletABI is the input ABI used to generate the binding from.
+// Deprecated: Use MultiSigWalletMetaData.ABI instead.
+var MultiSigWalletABI = MultiSigWalletMetaData.ABI
+
+// MultiSigWalletBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use MultiSigWalletMetaData.Bin instead.
+var MultiSigWalletBin = MultiSigWalletMetaData.Bin
+
+// DeployMultiSigWallet deploys a new Ethereum contract, binding an instance of MultiSigWallet to it.
+func DeployMultiSigWallet(auth *bind.TransactOpts, backend bind.ContractBackend, _owners []common.Address, _required *big.Int) (common.Address, *types.Transaction, *MultiSigWallet, error) {
+	parsed, err := MultiSigWalletMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(MultiSigWalletBin), backend, _owners, _required)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &MultiSigWallet{MultiSigWalletCaller: MultiSigWalletCaller{contract: contract}, MultiSigWalletTransactor: MultiSigWalletTransactor{contract: contract}, MultiSigWalletFilterer: MultiSigWalletFilterer{contract: contract}}, nil
+}
+
+// MultiSigWallet is an auto generated Go binding around an Ethereum contract.
+type MultiSigWallet struct {
+	MultiSigWalletCaller     // Read-only binding to the contract
+	MultiSigWalletTransactor // Write-only binding to the contract
+	MultiSigWalletFilterer   // Log filterer for contract events
+}
+
+// MultiSigWalletCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MultiSigWalletCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MultiSigWalletTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MultiSigWalletTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MultiSigWalletFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MultiSigWalletFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MultiSigWalletSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MultiSigWalletSession struct {
+	Contract     *MultiSigWallet   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MultiSigWalletCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MultiSigWalletCallerSession struct {
+	Contract *MultiSigWalletCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// MultiSigWalletTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MultiSigWalletTransactorSession struct {
+	Contract     *MultiSigWalletTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// MultiSigWalletRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MultiSigWalletRaw struct {
+	Contract *MultiSigWallet // Generic contract binding to access the raw methods on
+}
+
+// MultiSigWalletCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MultiSigWalletCallerRaw struct {
+	Contract *MultiSigWalletCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MultiSigWalletTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MultiSigWalletTransactorRaw struct {
+	Contract *MultiSigWalletTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMultiSigWallet creates a new instance of MultiSigWallet, bound to a specific deployed contract.
+func NewMultiSigWallet(address common.Address, backend bind.ContractBackend) (*MultiSigWallet, error) {
+	contract, err := bindMultiSigWallet(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigWallet{MultiSigWalletCaller: MultiSigWalletCaller{contract: contract}, MultiSigWalletTransactor: MultiSigWalletTransactor{contract: contract}, MultiSigWalletFilterer: MultiSigWalletFilterer{contract: contract}}, nil
+}
+
+// NewMultiSigWalletCaller creates a new read-only instance of MultiSigWallet, bound to a specific deployed contract.
+func NewMultiSigWalletCaller(address common.Address, caller bind.ContractCaller) (*MultiSigWalletCaller, error) {
+	contract, err := bindMultiSigWallet(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigWalletCaller{contract: contract}, nil
+}
+
+// NewMultiSigWalletTransactor creates a new write-only instance of MultiSigWallet, bound to a specific deployed contract.
+func NewMultiSigWalletTransactor(address common.Address, transactor bind.ContractTransactor) (*MultiSigWalletTransactor, error) {
+	contract, err := bindMultiSigWallet(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigWalletTransactor{contract: contract}, nil
+}
+
+// NewMultiSigWalletFilterer creates a new log filterer instance of MultiSigWallet, bound to a specific deployed contract.
+func NewMultiSigWalletFilterer(address common.Address, filterer bind.ContractFilterer) (*MultiSigWalletFilterer, error) {
+	contract, err := bindMultiSigWallet(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigWalletFilterer{contract: contract}, nil
+}
+
+// bindMultiSigWallet binds a generic wrapper to an already deployed contract.
+func bindMultiSigWallet(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MultiSigWalletMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MultiSigWallet *MultiSigWalletRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MultiSigWallet.Contract.MultiSigWalletCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MultiSigWallet *MultiSigWalletRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.MultiSigWalletTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MultiSigWallet *MultiSigWalletRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.MultiSigWalletTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MultiSigWallet *MultiSigWalletCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MultiSigWallet.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MultiSigWallet *MultiSigWalletTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MultiSigWallet *MultiSigWalletTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.contract.Transact(opts, method, params...)
+}
+
+// Approved is a free data retrieval call binding the contract method 0x8253951a.
+//
+// Solidity: function approved(uint256 , address ) view returns(bool)
+func (_MultiSigWallet *MultiSigWalletCaller) Approved(opts *bind.CallOpts, arg0 *big.Int, arg1 common.Address) (bool, error) {
+	var out []interface{}
+	err := _MultiSigWallet.contract.Call(opts, &out, "approved", arg0, arg1)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Approved is a free data retrieval call binding the contract method 0x8253951a.
+//
+// Solidity: function approved(uint256 , address ) view returns(bool)
+func (_MultiSigWallet *MultiSigWalletSession) Approved(arg0 *big.Int, arg1 common.Address) (bool, error) {
+	return _MultiSigWallet.Contract.Approved(&_MultiSigWallet.CallOpts, arg0, arg1)
+}
+
+// Approved is a free data retrieval call binding the contract method 0x8253951a.
+//
+// Solidity: function approved(uint256 , address ) view returns(bool)
+func (_MultiSigWallet *MultiSigWalletCallerSession) Approved(arg0 *big.Int, arg1 common.Address) (bool, error) {
+	return _MultiSigWallet.Contract.Approved(&_MultiSigWallet.CallOpts, arg0, arg1)
+}
+
+// GetApprovalCount is a free data retrieval call binding the contract method 0xba7e7cab.
+//
+// Solidity: function getApprovalCount(uint256 _txId) view returns(uint256 count)
+func (_MultiSigWallet *MultiSigWalletCaller) GetApprovalCount(opts *bind.CallOpts, _txId *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _MultiSigWallet.contract.Call(opts, &out, "getApprovalCount", _txId)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetApprovalCount is a free data retrieval call binding the contract method 0xba7e7cab.
+//
+// Solidity: function getApprovalCount(uint256 _txId) view returns(uint256 count)
+func (_MultiSigWallet *MultiSigWalletSession) GetApprovalCount(_txId *big.Int) (*big.Int, error) {
+	return _MultiSigWallet.Contract.GetApprovalCount(&_MultiSigWallet.CallOpts, _txId)
+}
+
+// GetApprovalCount is a free data retrieval call binding the contract method 0xba7e7cab.
+//
+// Solidity: function getApprovalCount(uint256 _txId) view returns(uint256 count)
+func (_MultiSigWallet *MultiSigWalletCallerSession) GetApprovalCount(_txId *big.Int) (*big.Int, error) {
+	return _MultiSigWallet.Contract.GetApprovalCount(&_MultiSigWallet.CallOpts, _txId)
+}
+
+// GetBalance is a free data retrieval call binding the contract method 0x12065fe0.
+//
+// Solidity: function getBalance() view returns(uint256)
+func (_MultiSigWallet *MultiSigWalletCaller) GetBalance(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MultiSigWallet.contract.Call(opts, &out, "getBalance")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBalance is a free data retrieval call binding the contract method 0x12065fe0.
+//
+// Solidity: function getBalance() view returns(uint256)
+func (_MultiSigWallet *MultiSigWalletSession) GetBalance() (*big.Int, error) {
+	return _MultiSigWallet.Contract.GetBalance(&_MultiSigWallet.CallOpts)
+}
+
+// GetBalance is a free data retrieval call binding the contract method 0x12065fe0.
+//
+// Solidity: function getBalance() view returns(uint256)
+func (_MultiSigWallet *MultiSigWalletCallerSession) GetBalance() (*big.Int, error) {
+	return _MultiSigWallet.Contract.GetBalance(&_MultiSigWallet.CallOpts)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x2f54bf6e.
+//
+// Solidity: function isOwner(address ) view returns(bool)
+func (_MultiSigWallet *MultiSigWalletCaller) IsOwner(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out []interface{}
+	err := _MultiSigWallet.contract.Call(opts, &out, "isOwner", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x2f54bf6e.
+//
+// Solidity: function isOwner(address ) view returns(bool)
+func (_MultiSigWallet *MultiSigWalletSession) IsOwner(arg0 common.Address) (bool, error) {
+	return _MultiSigWallet.Contract.IsOwner(&_MultiSigWallet.CallOpts, arg0)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x2f54bf6e.
+//
+// Solidity: function isOwner(address ) view returns(bool)
+func (_MultiSigWallet *MultiSigWalletCallerSession) IsOwner(arg0 common.Address) (bool, error) {
+	return _MultiSigWallet.Contract.IsOwner(&_MultiSigWallet.CallOpts, arg0)
+}
+
+// Owners is a free data retrieval call binding the contract method 0x025e7c27.
+//
+// Solidity: function owners(uint256 ) view returns(address)
+func (_MultiSigWallet *MultiSigWalletCaller) Owners(opts *bind.CallOpts, arg0 *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _MultiSigWallet.contract.Call(opts, &out, "owners", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owners is a free data retrieval call binding the contract method 0x025e7c27.
+//
+// Solidity: function owners(uint256 ) view returns(address)
+func (_MultiSigWallet *MultiSigWalletSession) Owners(arg0 *big.Int) (common.Address, error) {
+	return _MultiSigWallet.Contract.Owners(&_MultiSigWallet.CallOpts, arg0)
+}
+
+// Owners is a free data retrieval call binding the contract method 0x025e7c27.
+//
+// Solidity: function owners(uint256 ) view returns(address)
+func (_MultiSigWallet *MultiSigWalletCallerSession) Owners(arg0 *big.Int) (common.Address, error) {
+	return _MultiSigWallet.Contract.Owners(&_MultiSigWallet.CallOpts, arg0)
+}
+
+// Required is a free data retrieval call binding the contract method 0xdc8452cd.
+//
+// Solidity: function required() view returns(uint256)
+func (_MultiSigWallet *MultiSigWalletCaller) Required(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MultiSigWallet.contract.Call(opts, &out, "required")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Required is a free data retrieval call binding the contract method 0xdc8452cd.
+//
+// Solidity: function required() view returns(uint256)
+func (_MultiSigWallet *MultiSigWalletSession) Required() (*big.Int, error) {
+	return _MultiSigWallet.Contract.Required(&_MultiSigWallet.CallOpts)
+}
+
+// Required is a free data retrieval call binding the contract method 0xdc8452cd.
+//
+// Solidity: function required() view returns(uint256)
+func (_MultiSigWallet *MultiSigWalletCallerSession) Required() (*big.Int, error) {
+	return _MultiSigWallet.Contract.Required(&_MultiSigWallet.CallOpts)
+}
+
+// Transactions is a free data retrieval call binding the contract method 0x9ace38c2.
+//
+// Solidity: function transactions(uint256 ) view returns(address to, uint256 value, bytes data, bool exected)
+func (_MultiSigWallet *MultiSigWalletCaller) Transactions(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	To      common.Address
+	Value   *big.Int
+	Data    []byte
+	Exected bool
+}, error) {
+	var out []interface{}
+	err := _MultiSigWallet.contract.Call(opts, &out, "transactions", arg0)
+
+	outstruct := new(struct {
+		To      common.Address
+		Value   *big.Int
+		Data    []byte
+		Exected bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.To = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Value = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Data = *abi.ConvertType(out[2], new([]byte)).(*[]byte)
+	outstruct.Exected = *abi.ConvertType(out[3], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// Transactions is a free data retrieval call binding the contract method 0x9ace38c2.
+//
+// Solidity: function transactions(uint256 ) view returns(address to, uint256 value, bytes data, bool exected)
+func (_MultiSigWallet *MultiSigWalletSession) Transactions(arg0 *big.Int) (struct {
+	To      common.Address
+	Value   *big.Int
+	Data    []byte
+	Exected bool
+}, error) {
+	return _MultiSigWallet.Contract.Transactions(&_MultiSigWallet.CallOpts, arg0)
+}
+
+// Transactions is a free data retrieval call binding the contract method 0x9ace38c2.
+//
+// Solidity: function transactions(uint256 ) view returns(address to, uint256 value, bytes data, bool exected)
+func (_MultiSigWallet *MultiSigWalletCallerSession) Transactions(arg0 *big.Int) (struct {
+	To      common.Address
+	Value   *big.Int
+	Data    []byte
+	Exected bool
+}, error) {
+	return _MultiSigWallet.Contract.Transactions(&_MultiSigWallet.CallOpts, arg0)
+}
+
+// Approv is a paid mutator transaction binding the contract method 0x6bdddcde.
+//
+// Solidity: function approv(uint256 _txId) returns()
+func (_MultiSigWallet *MultiSigWalletTransactor) Approv(opts *bind.TransactOpts, _txId *big.Int) (*types.Transaction, error) {
+	return _MultiSigWallet.contract.Transact(opts, "approv", _txId)
+}
+
+// Approv is a paid mutator transaction binding the contract method 0x6bdddcde.
+//
+// Solidity: function approv(uint256 _txId) returns()
+func (_MultiSigWallet *MultiSigWalletSession) Approv(_txId *big.Int) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.Approv(&_MultiSigWallet.TransactOpts, _txId)
+}
+
+// Approv is a paid mutator transaction binding the contract method 0x6bdddcde.
+//
+// Solidity: function approv(uint256 _txId) returns()
+func (_MultiSigWallet *MultiSigWalletTransactorSession) Approv(_txId *big.Int) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.Approv(&_MultiSigWallet.TransactOpts, _txId)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xfe0d94c1.
+//
+// Solidity: function execute(uint256 _txId) returns()
+func (_MultiSigWallet *MultiSigWalletTransactor) Execute(opts *bind.TransactOpts, _txId *big.Int) (*types.Transaction, error) {
+	return _MultiSigWallet.contract.Transact(opts, "execute", _txId)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xfe0d94c1.
+//
+// Solidity: function execute(uint256 _txId) returns()
+func (_MultiSigWallet *MultiSigWalletSession) Execute(_txId *big.Int) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.Execute(&_MultiSigWallet.TransactOpts, _txId)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xfe0d94c1.
+//
+// Solidity: function execute(uint256 _txId) returns()
+func (_MultiSigWallet *MultiSigWalletTransactorSession) Execute(_txId *big.Int) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.Execute(&_MultiSigWallet.TransactOpts, _txId)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0x20c5429b.
+//
+// Solidity: function revoke(uint256 _txId) returns()
+func (_MultiSigWallet *MultiSigWalletTransactor) Revoke(opts *bind.TransactOpts, _txId *big.Int) (*types.Transaction, error) {
+	return _MultiSigWallet.contract.Transact(opts, "revoke", _txId)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0x20c5429b.
+//
+// Solidity: function revoke(uint256 _txId) returns()
+func (_MultiSigWallet *MultiSigWalletSession) Revoke(_txId *big.Int) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.Revoke(&_MultiSigWallet.TransactOpts, _txId)
+}
+
+// Revoke is a paid mutator transaction binding the contract method 0x20c5429b.
+//
+// Solidity: function revoke(uint256 _txId) returns()
+func (_MultiSigWallet *MultiSigWalletTransactorSession) Revoke(_txId *big.Int) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.Revoke(&_MultiSigWallet.TransactOpts, _txId)
+}
+
+// Submit is a paid mutator transaction binding the contract method 0x14de327f.
+//
+// Solidity: function submit(address _to, uint256 _value, bytes _data) returns(uint256)
+func (_MultiSigWallet *MultiSigWalletTransactor) Submit(opts *bind.TransactOpts, _to common.Address, _value *big.Int, _data []byte) (*types.Transaction, error) {
+	return _MultiSigWallet.contract.Transact(opts, "submit", _to, _value, _data)
+}
+
+// Submit is a paid mutator transaction binding the contract method 0x14de327f.
+//
+// Solidity: function submit(address _to, uint256 _value, bytes _data) returns(uint256)
+func (_MultiSigWallet *MultiSigWalletSession) Submit(_to common.Address, _value *big.Int, _data []byte) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.Submit(&_MultiSigWallet.TransactOpts, _to, _value, _data)
+}
+
+// Submit is a paid mutator transaction binding the contract method 0x14de327f.
+//
+// Solidity: function submit(address _to, uint256 _value, bytes _data) returns(uint256)
+func (_MultiSigWallet *MultiSigWalletTransactorSession) Submit(_to common.Address, _value *big.Int, _data []byte) (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.Submit(&_MultiSigWallet.TransactOpts, _to, _value, _data)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_MultiSigWallet *MultiSigWalletTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MultiSigWallet.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_MultiSigWallet *MultiSigWalletSession) Receive() (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.Receive(&_MultiSigWallet.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_MultiSigWallet *MultiSigWalletTransactorSession) Receive() (*types.Transaction, error) {
+	return _MultiSigWallet.Contract.Receive(&_MultiSigWallet.TransactOpts)
+}
+
+// MultiSigWalletApproveIterator is returned from FilterApprove and is used to iterate over the raw logs and unpacked data for Approve events raised by the MultiSigWallet contract.
+type MultiSigWalletApproveIterator struct {
+	Event *MultiSigWalletApprove // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MultiSigWalletApproveIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MultiSigWalletApprove)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MultiSigWalletApprove)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MultiSigWalletApproveIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MultiSigWalletApproveIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MultiSigWalletApprove represents a Approve event raised by the MultiSigWallet contract.
+type MultiSigWalletApprove struct {
+	Owner common.Address
+	Arg1  *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprove is a free log retrieval operation binding the contract event 0x90ec57f18fa7b15c6b8d5e4d1deb90796c74b2ff23d4d0cecad0cb42a96b3128.
+//
+// Solidity: event Approve(address indexed owner, uint256 arg1)
+func (_MultiSigWallet *MultiSigWalletFilterer) FilterApprove(opts *bind.FilterOpts, owner []common.Address) (*MultiSigWalletApproveIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _MultiSigWallet.contract.FilterLogs(opts, "Approve", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigWalletApproveIterator{contract: _MultiSigWallet.contract, event: "Approve", logs: logs, sub: sub}, nil
+}
+
+// WatchApprove is a free log subscription operation binding the contract event 0x90ec57f18fa7b15c6b8d5e4d1deb90796c74b2ff23d4d0cecad0cb42a96b3128.
+//
+// Solidity: event Approve(address indexed owner, uint256 arg1)
+func (_MultiSigWallet *MultiSigWalletFilterer) WatchApprove(opts *bind.WatchOpts, sink chan<- *MultiSigWalletApprove, owner []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _MultiSigWallet.contract.WatchLogs(opts, "Approve", ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MultiSigWalletApprove)
+				if err := _MultiSigWallet.contract.UnpackLog(event, "Approve", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprove is a log parse operation binding the contract event 0x90ec57f18fa7b15c6b8d5e4d1deb90796c74b2ff23d4d0cecad0cb42a96b3128.
+//
+// Solidity: event Approve(address indexed owner, uint256 arg1)
+func (_MultiSigWallet *MultiSigWalletFilterer) ParseApprove(log types.Log) (*MultiSigWalletApprove, error) {
+	event := new(MultiSigWalletApprove)
+	if err := _MultiSigWallet.contract.UnpackLog(event, "Approve", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MultiSigWalletDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the MultiSigWallet contract.
+type MultiSigWalletDepositIterator struct {
+	Event *MultiSigWalletDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MultiSigWalletDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MultiSigWalletDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MultiSigWalletDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MultiSigWalletDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MultiSigWalletDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MultiSigWalletDeposit represents a Deposit event raised by the MultiSigWallet contract.
+type MultiSigWalletDeposit struct {
+	Sender common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c.
+//
+// Solidity: event Deposit(address indexed sender, uint256 amount)
+func (_MultiSigWallet *MultiSigWalletFilterer) FilterDeposit(opts *bind.FilterOpts, sender []common.Address) (*MultiSigWalletDepositIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _MultiSigWallet.contract.FilterLogs(opts, "Deposit", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigWalletDepositIterator{contract: _MultiSigWallet.contract, event: "Deposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c.
+//
+// Solidity: event Deposit(address indexed sender, uint256 amount)
+func (_MultiSigWallet *MultiSigWalletFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *MultiSigWalletDeposit, sender []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _MultiSigWallet.contract.WatchLogs(opts, "Deposit", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MultiSigWalletDeposit)
+				if err := _MultiSigWallet.contract.UnpackLog(event, "Deposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposit is a log parse operation binding the contract event 0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c.
+//
+// Solidity: event Deposit(address indexed sender, uint256 amount)
+func (_MultiSigWallet *MultiSigWalletFilterer) ParseDeposit(log types.Log) (*MultiSigWalletDeposit, error) {
+	event := new(MultiSigWalletDeposit)
+	if err := _MultiSigWallet.contract.UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MultiSigWalletExecuteIterator is returned from FilterExecute and is used to iterate over the raw logs and unpacked data for Execute events raised by the MultiSigWallet contract.
+type MultiSigWalletExecuteIterator struct {
+	Event *MultiSigWalletExecute // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MultiSigWalletExecuteIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MultiSigWalletExecute)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MultiSigWalletExecute)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MultiSigWalletExecuteIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MultiSigWalletExecuteIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MultiSigWalletExecute represents a Execute event raised by the MultiSigWallet contract.
+type MultiSigWalletExecute struct {
+	TxId *big.Int
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecute is a free log retrieval operation binding the contract event 0xddb556f1d2c1ec821e910b019d3685b229db152a0ecd517ca7e24b8bd7139289.
+//
+// Solidity: event Execute(uint256 indexed txId)
+func (_MultiSigWallet *MultiSigWalletFilterer) FilterExecute(opts *bind.FilterOpts, txId []*big.Int) (*MultiSigWalletExecuteIterator, error) {
+
+	var txIdRule []interface{}
+	for _, txIdItem := range txId {
+		txIdRule = append(txIdRule, txIdItem)
+	}
+
+	logs, sub, err := _MultiSigWallet.contract.FilterLogs(opts, "Execute", txIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigWalletExecuteIterator{contract: _MultiSigWallet.contract, event: "Execute", logs: logs, sub: sub}, nil
+}
+
+// WatchExecute is a free log subscription operation binding the contract event 0xddb556f1d2c1ec821e910b019d3685b229db152a0ecd517ca7e24b8bd7139289.
+//
+// Solidity: event Execute(uint256 indexed txId)
+func (_MultiSigWallet *MultiSigWalletFilterer) WatchExecute(opts *bind.WatchOpts, sink chan<- *MultiSigWalletExecute, txId []*big.Int) (event.Subscription, error) {
+
+	var txIdRule []interface{}
+	for _, txIdItem := range txId {
+		txIdRule = append(txIdRule, txIdItem)
+	}
+
+	logs, sub, err := _MultiSigWallet.contract.WatchLogs(opts, "Execute", txIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MultiSigWalletExecute)
+				if err := _MultiSigWallet.contract.UnpackLog(event, "Execute", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecute is a log parse operation binding the contract event 0xddb556f1d2c1ec821e910b019d3685b229db152a0ecd517ca7e24b8bd7139289.
+//
+// Solidity: event Execute(uint256 indexed txId)
+func (_MultiSigWallet *MultiSigWalletFilterer) ParseExecute(log types.Log) (*MultiSigWalletExecute, error) {
+	event := new(MultiSigWalletExecute)
+	if err := _MultiSigWallet.contract.UnpackLog(event, "Execute", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MultiSigWalletRevokeIterator is returned from FilterRevoke and is used to iterate over the raw logs and unpacked data for Revoke events raised by the MultiSigWallet contract.
+type MultiSigWalletRevokeIterator struct {
+	Event *MultiSigWalletRevoke // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MultiSigWalletRevokeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MultiSigWalletRevoke)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MultiSigWalletRevoke)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MultiSigWalletRevokeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MultiSigWalletRevokeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MultiSigWalletRevoke represents a Revoke event raised by the MultiSigWallet contract.
+type MultiSigWalletRevoke struct {
+	Owner common.Address
+	TxId  *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterRevoke is a free log retrieval operation binding the contract event 0xec9ab91322523c899ede7830ec9bfc992b5981cdcc27b91162fb23de5791117b.
+//
+// Solidity: event Revoke(address indexed owner, uint256 indexed txId)
+func (_MultiSigWallet *MultiSigWalletFilterer) FilterRevoke(opts *bind.FilterOpts, owner []common.Address, txId []*big.Int) (*MultiSigWalletRevokeIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var txIdRule []interface{}
+	for _, txIdItem := range txId {
+		txIdRule = append(txIdRule, txIdItem)
+	}
+
+	logs, sub, err := _MultiSigWallet.contract.FilterLogs(opts, "Revoke", ownerRule, txIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigWalletRevokeIterator{contract: _MultiSigWallet.contract, event: "Revoke", logs: logs, sub: sub}, nil
+}
+
+// WatchRevoke is a free log subscription operation binding the contract event 0xec9ab91322523c899ede7830ec9bfc992b5981cdcc27b91162fb23de5791117b.
+//
+// Solidity: event Revoke(address indexed owner, uint256 indexed txId)
+func (_MultiSigWallet *MultiSigWalletFilterer) WatchRevoke(opts *bind.WatchOpts, sink chan<- *MultiSigWalletRevoke, owner []common.Address, txId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var txIdRule []interface{}
+	for _, txIdItem := range txId {
+		txIdRule = append(txIdRule, txIdItem)
+	}
+
+	logs, sub, err := _MultiSigWallet.contract.WatchLogs(opts, "Revoke", ownerRule, txIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MultiSigWalletRevoke)
+				if err := _MultiSigWallet.contract.UnpackLog(event, "Revoke", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRevoke is a log parse operation binding the contract event 0xec9ab91322523c899ede7830ec9bfc992b5981cdcc27b91162fb23de5791117b.
+//
+// Solidity: event Revoke(address indexed owner, uint256 indexed txId)
+func (_MultiSigWallet *MultiSigWalletFilterer) ParseRevoke(log types.Log) (*MultiSigWalletRevoke, error) {
+	event := new(MultiSigWalletRevoke)
+	if err := _MultiSigWallet.contract.UnpackLog(event, "Revoke", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MultiSigWalletSubmitIterator is returned from FilterSubmit and is used to iterate over the raw logs and unpacked data for Submit events raised by the MultiSigWallet contract.
+type MultiSigWalletSubmitIterator struct {
+	Event *MultiSigWalletSubmit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MultiSigWalletSubmitIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MultiSigWalletSubmit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MultiSigWalletSubmit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MultiSigWalletSubmitIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MultiSigWalletSubmitIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MultiSigWalletSubmit represents a Submit event raised by the MultiSigWallet contract.
+type MultiSigWalletSubmit struct {
+	TxId *big.Int
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterSubmit is a free log retrieval operation binding the contract event 0x08324b3d745b914e3abd4ffbfead91e3b78391a98c173202129215ab933adfbe.
+//
+// Solidity: event Submit(uint256 indexed txId)
+func (_MultiSigWallet *MultiSigWalletFilterer) FilterSubmit(opts *bind.FilterOpts, txId []*big.Int) (*MultiSigWalletSubmitIterator, error) {
+
+	var txIdRule []interface{}
+	for _, txIdItem := range txId {
+		txIdRule = append(txIdRule, txIdItem)
+	}
+
+	logs, sub, err := _MultiSigWallet.contract.FilterLogs(opts, "Submit", txIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiSigWalletSubmitIterator{contract: _MultiSigWallet.contract, event: "Submit", logs: logs, sub: sub}, nil
+}
+
+// WatchSubmit is a free log subscription operation binding the contract event 0x08324b3d745b914e3abd4ffbfead91e3b78391a98c173202129215ab933adfbe.
+//
+// Solidity: event Submit(uint256 indexed txId)
+func (_MultiSigWallet *MultiSigWalletFilterer) WatchSubmit(opts *bind.WatchOpts, sink chan<- *MultiSigWalletSubmit, txId []*big.Int) (event.Subscription, error) {
+
+	var txIdRule []interface{}
+	for _, txIdItem := range txId {
+		txIdRule = append(txIdRule, txIdItem)
+	}
+
+	logs, sub, err := _MultiSigWallet.contract.WatchLogs(opts, "Submit", txIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MultiSigWalletSubmit)
+				if err := _MultiSigWallet.contract.UnpackLog(event, "Submit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSubmit is a log parse operation binding the contract event 0x08324b3d745b914e3abd4ffbfead91e3b78391a98c173202129215ab933adfbe.
+//
+// Solidity: event Submit(uint256 indexed txId)
+func (_MultiSigWallet *MultiSigWalletFilterer) ParseSubmit(log types.Log) (*MultiSigWalletSubmit, error) {
+	event := new(MultiSigWalletSubmit)
+	if err := _MultiSigWallet.contract.UnpackLog(event, "Submit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
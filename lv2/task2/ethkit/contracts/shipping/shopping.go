@@ -0,0 +1,410 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package shipping
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ShoppingMetaData contains all meta data concerning the Shopping contract.
+var ShoppingMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"descrption\",\"type\":\"string\"}],\"name\":\"LogNewAlert\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"Devliverd\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"Shopped\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"Status\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Bin: "0x6080604052348015600f57600080fd5b506000805460ff191690556102e9806100296000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c80633766cf181461004657806345f09ce914610050578063eedaca901461006e575b600080fd5b61004e610076565b005b6100586100fd565b604051610065919061024f565b60405180910390f35b61004e610115565b6000805460ff19166001179055604080516020808252602a908201527f596f757220666f6f64206f7264657220686173206265656e207374617274207491810191909152696f2064656c697665727960b01b60608201527fca75c80642b93c980135b8355995abae3ab06033dc81f956b3824323b4903ef5906080015b60405180910390a1565b60005460609060ff1661010f81610183565b91505090565b6000805460ff19166002179055604080516020808252601b908201527f596f757220666f6f64206f726465722068617320617272697665640000000000918101919091527fca75c80642b93c980135b8355995abae3ab06033dc81f956b3824323b4903ef5906060016100f3565b60608160028111156101975761019761029d565b6000036101c157505060408051808201909152600781526650656e64696e6760c81b602082015290565b8160028111156101d3576101d361029d565b6001036101fd57505060408051808201909152600781526614da1bdc1c195960ca1b602082015290565b81600281111561020f5761020f61029d565b60020361023b57505060408051808201909152600981526811195b1a5d995c995960ba1b602082015290565b505060408051602081019091526000815290565b602081526000825180602084015260005b8181101561027d5760208186018101516040868401015201610260565b506000604082850101526040601f19601f83011684010191505092915050565b634e487b7160e01b600052602160045260246000fdfea264697066735822122070b8f19878e93ad32f2db36c16db4fd51a62c9d3bc9268bfe9e630c1af5b67b864736f6c634300081e0033",
+}
+
+// ShoppingABI is the input ABI used to generate the binding from.
+// Deprecated: Use ShoppingMetaData.ABI instead.
+var ShoppingABI = ShoppingMetaData.ABI
+
+// ShoppingBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ShoppingMetaData.Bin instead.
+var ShoppingBin = ShoppingMetaData.Bin
+
+// DeployShopping deploys a new Ethereum contract, binding an instance of Shopping to it.
+func DeployShopping(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Shopping, error) {
+	parsed, err := ShoppingMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ShoppingBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Shopping{ShoppingCaller: ShoppingCaller{contract: contract}, ShoppingTransactor: ShoppingTransactor{contract: contract}, ShoppingFilterer: ShoppingFilterer{contract: contract}}, nil
+}
+
+// Shopping is an auto generated Go binding around an Ethereum contract.
+type Shopping struct {
+	ShoppingCaller     // Read-only binding to the contract
+	ShoppingTransactor // Write-only binding to the contract
+	ShoppingFilterer   // Log filterer for contract events
+}
+
+// ShoppingCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ShoppingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ShoppingTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ShoppingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ShoppingFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ShoppingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ShoppingSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ShoppingSession struct {
+	Contract     *Shopping         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ShoppingCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ShoppingCallerSession struct {
+	Contract *ShoppingCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// ShoppingTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ShoppingTransactorSession struct {
+	Contract     *ShoppingTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// ShoppingRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ShoppingRaw struct {
+	Contract *Shopping // Generic contract binding to access the raw methods on
+}
+
+// ShoppingCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ShoppingCallerRaw struct {
+	Contract *ShoppingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ShoppingTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ShoppingTransactorRaw struct {
+	Contract *ShoppingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewShopping creates a new instance of Shopping, bound to a specific deployed contract.
+func NewShopping(address common.Address, backend bind.ContractBackend) (*Shopping, error) {
+	contract, err := bindShopping(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Shopping{ShoppingCaller: ShoppingCaller{contract: contract}, ShoppingTransactor: ShoppingTransactor{contract: contract}, ShoppingFilterer: ShoppingFilterer{contract: contract}}, nil
+}
+
+// NewShoppingCaller creates a new read-only instance of Shopping, bound to a specific deployed contract.
+func NewShoppingCaller(address common.Address, caller bind.ContractCaller) (*ShoppingCaller, error) {
+	contract, err := bindShopping(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ShoppingCaller{contract: contract}, nil
+}
+
+// NewShoppingTransactor creates a new write-only instance of Shopping, bound to a specific deployed contract.
+func NewShoppingTransactor(address common.Address, transactor bind.ContractTransactor) (*ShoppingTransactor, error) {
+	contract, err := bindShopping(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ShoppingTransactor{contract: contract}, nil
+}
+
+// NewShoppingFilterer creates a new log filterer instance of Shopping, bound to a specific deployed contract.
+func NewShoppingFilterer(address common.Address, filterer bind.ContractFilterer) (*ShoppingFilterer, error) {
+	contract, err := bindShopping(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ShoppingFilterer{contract: contract}, nil
+}
+
+// bindShopping binds a generic wrapper to an already deployed contract.
+func bindShopping(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ShoppingMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Shopping *ShoppingRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Shopping.Contract.ShoppingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Shopping *ShoppingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Shopping.Contract.ShoppingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Shopping *ShoppingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Shopping.Contract.ShoppingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Shopping *ShoppingCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Shopping.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Shopping *ShoppingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Shopping.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Shopping *ShoppingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Shopping.Contract.contract.Transact(opts, method, params...)
+}
+
+// Status is a free data retrieval call binding the contract method 0x45f09ce9.
+//
+// Solidity: function Status() view returns(string)
+func (_Shopping *ShoppingCaller) Status(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Shopping.contract.Call(opts, &out, "Status")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Status is a free data retrieval call binding the contract method 0x45f09ce9.
+//
+// Solidity: function Status() view returns(string)
+func (_Shopping *ShoppingSession) Status() (string, error) {
+	return _Shopping.Contract.Status(&_Shopping.CallOpts)
+}
+
+// Status is a free data retrieval call binding the contract method 0x45f09ce9.
+//
+// Solidity: function Status() view returns(string)
+func (_Shopping *ShoppingCallerSession) Status() (string, error) {
+	return _Shopping.Contract.Status(&_Shopping.CallOpts)
+}
+
+// Devliverd is a paid mutator transaction binding the contract method 0xeedaca90.
+//
+// Solidity: function Devliverd() returns()
+func (_Shopping *ShoppingTransactor) Devliverd(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Shopping.contract.Transact(opts, "Devliverd")
+}
+
+// Devliverd is a paid mutator transaction binding the contract method 0xeedaca90.
+//
+// Solidity: function Devliverd() returns()
+func (_Shopping *ShoppingSession) Devliverd() (*types.Transaction, error) {
+	return _Shopping.Contract.Devliverd(&_Shopping.TransactOpts)
+}
+
+// Devliverd is a paid mutator transaction binding the contract method 0xeedaca90.
+//
+// Solidity: function Devliverd() returns()
+func (_Shopping *ShoppingTransactorSession) Devliverd() (*types.Transaction, error) {
+	return _Shopping.Contract.Devliverd(&_Shopping.TransactOpts)
+}
+
+// Shopped is a paid mutator transaction binding the contract method 0x3766cf18.
+//
+// Solidity: function Shopped() returns()
+func (_Shopping *ShoppingTransactor) Shopped(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Shopping.contract.Transact(opts, "Shopped")
+}
+
+// Shopped is a paid mutator transaction binding the contract method 0x3766cf18.
+//
+// Solidity: function Shopped() returns()
+func (_Shopping *ShoppingSession) Shopped() (*types.Transaction, error) {
+	return _Shopping.Contract.Shopped(&_Shopping.TransactOpts)
+}
+
+// Shopped is a paid mutator transaction binding the contract method 0x3766cf18.
+//
+// Solidity: function Shopped() returns()
+func (_Shopping *ShoppingTransactorSession) Shopped() (*types.Transaction, error) {
+	return _Shopping.Contract.Shopped(&_Shopping.TransactOpts)
+}
+
+// ShoppingLogNewAlertIterator is returned from FilterLogNewAlert and is used to iterate over the raw logs and unpacked data for LogNewAlert events raised by the Shopping contract.
+type ShoppingLogNewAlertIterator struct {
+	Event *ShoppingLogNewAlert // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ShoppingLogNewAlertIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ShoppingLogNewAlert)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ShoppingLogNewAlert)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ShoppingLogNewAlertIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ShoppingLogNewAlertIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ShoppingLogNewAlert represents a LogNewAlert event raised by the Shopping contract.
+type ShoppingLogNewAlert struct {
+	Descrption string
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogNewAlert is a free log retrieval operation binding the contract event 0xca75c80642b93c980135b8355995abae3ab06033dc81f956b3824323b4903ef5.
+//
+// Solidity: event LogNewAlert(string descrption)
+func (_Shopping *ShoppingFilterer) FilterLogNewAlert(opts *bind.FilterOpts) (*ShoppingLogNewAlertIterator, error) {
+
+	logs, sub, err := _Shopping.contract.FilterLogs(opts, "LogNewAlert")
+	if err != nil {
+		return nil, err
+	}
+	return &ShoppingLogNewAlertIterator{contract: _Shopping.contract, event: "LogNewAlert", logs: logs, sub: sub}, nil
+}
+
+// WatchLogNewAlert is a free log subscription operation binding the contract event 0xca75c80642b93c980135b8355995abae3ab06033dc81f956b3824323b4903ef5.
+//
+// Solidity: event LogNewAlert(string descrption)
+func (_Shopping *ShoppingFilterer) WatchLogNewAlert(opts *bind.WatchOpts, sink chan<- *ShoppingLogNewAlert) (event.Subscription, error) {
+
+	logs, sub, err := _Shopping.contract.WatchLogs(opts, "LogNewAlert")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ShoppingLogNewAlert)
+				if err := _Shopping.contract.UnpackLog(event, "LogNewAlert", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogNewAlert is a log parse operation binding the contract event 0xca75c80642b93c980135b8355995abae3ab06033dc81f956b3824323b4903ef5.
+//
+// Solidity: event LogNewAlert(string descrption)
+func (_Shopping *ShoppingFilterer) ParseLogNewAlert(log types.Log) (*ShoppingLogNewAlert, error) {
+	event := new(ShoppingLogNewAlert)
+	if err := _Shopping.contract.UnpackLog(event, "LogNewAlert", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,98 @@
+// 用 solc-js 的 soljson 编译合约，按 solc --abi --bin --bin-runtime 的格式输出 <Contract>.abi、.bin 和 .bin-runtime，
+// 本机没有 solc、也装不了 hardhat 依赖时用。soljson 从 https://binaries.soliditylang.org/bin/ 下载，
+// 仓库里的产物用的是 soljson-v0.8.30+commit.73712a01.js，优化 200 次，evmVersion paris（不用 PUSH0，老节点也能部署）。
+//
+//	SOLJSON=/path/to/soljson.js node solc.js -o weth ../../../../lv1/task5/WETH.sol:WETH weth/WETH9.sol:WETH9
+//	SOLJSON=/path/to/soljson.js node solc.js -o shipping ../../../../lv0/task3/hardhat-project/contracts/Shipping.sol:Shipping ../../../../lv0/task3/hardhat-project/contracts/Shopping.sol:Shopping
+//	SOLJSON=/path/to/soljson.js node solc.js -o todolist ../../../../lv1/task5/TodoList.sol:Demo=TodoList
+//	SOLJSON=/path/to/soljson.js node solc.js -o rccstake -I openzeppelin ../../../../lv3/rcc_stake/rcc-stake-contract/contracts/RCCStake.sol:RCCStake \
+//		openzeppelin/@openzeppelin/contracts/proxy/ERC1967/ERC1967Proxy.sol:ERC1967Proxy
+//
+// 参数是 文件:合约名[=输出名]，-o 指定输出目录，-I 是 @openzeppelin/... 这类非相对路径 import 的查找目录
+const fs = require('fs');
+const path = require('path');
+
+const usage = 'usage: SOLJSON=soljson.js node solc.js -o <dir> [-I <dir>]... <file.sol>:<Contract>[=<Name>]...';
+
+function parseArgs(argv) {
+  const opts = {out: '.', include: [], targets: []};
+  for (let i = 0; i < argv.length; i++) {
+    switch (argv[i]) {
+      case '-o':
+        opts.out = argv[++i];
+        break;
+      case '-I':
+        opts.include.push(argv[++i]);
+        break;
+      default: {
+        const m = /^(.+\.sol):(\w+)(?:=(\w+))?$/.exec(argv[i]);
+        if (!m) throw new Error(usage);
+        opts.targets.push({file: m[1], contract: m[2], name: m[3] || m[2]});
+      }
+    }
+  }
+  if (!process.env.SOLJSON || opts.targets.length === 0) throw new Error(usage);
+  return opts;
+}
+
+// collect 递归读取 import，source unit 名字用相对当前目录的路径，非相对路径的 import 保持原名
+function collect(opts) {
+  const sources = {};
+  const visit = (unit, file) => {
+    if (sources[unit]) return;
+    const content = fs.readFileSync(file, 'utf8');
+    sources[unit] = {content};
+    for (const m of content.matchAll(/^\s*import\s+(?:[^"';]*\sfrom\s+)?["']([^"']+)["']/gm)) {
+      const spec = m[1];
+      if (spec.startsWith('.')) {
+        const target = path.posix.normalize(path.posix.join(path.posix.dirname(unit), spec));
+        visit(target, path.join(path.dirname(file), spec));
+        continue;
+      }
+      const dir = opts.include.find((d) => fs.existsSync(path.join(d, spec)));
+      if (!dir) throw new Error(`${unit}: cannot resolve import ${spec}`);
+      visit(spec, path.join(dir, spec));
+    }
+  };
+  for (const t of opts.targets) visit(path.posix.normalize(t.file), t.file);
+  return sources;
+}
+
+function main() {
+  const opts = parseArgs(process.argv.slice(2));
+  const soljson = require(path.resolve(process.env.SOLJSON));
+  const compile = soljson.cwrap('solidity_compile', 'string', ['string', 'number', 'number']);
+  const input = {
+    language: 'Solidity',
+    sources: collect(opts),
+    settings: {
+      optimizer: {enabled: true, runs: 200},
+      evmVersion: 'paris',
+      outputSelection: {'*': {'*': ['abi', 'evm.bytecode.object', 'evm.deployedBytecode.object']}},
+    },
+  };
+  const output = JSON.parse(compile(JSON.stringify(input), 0, 0));
+  let failed = false;
+  for (const e of output.errors || []) {
+    if (e.severity === 'error') failed = true;
+    console.error(e.formattedMessage);
+  }
+  if (failed) process.exit(1);
+
+  fs.mkdirSync(opts.out, {recursive: true});
+  for (const t of opts.targets) {
+    const c = (output.contracts[path.posix.normalize(t.file)] || {})[t.contract];
+    if (!c) throw new Error(`${t.file}: no contract ${t.contract}`);
+    fs.writeFileSync(path.join(opts.out, t.name + '.abi'), JSON.stringify(c.abi));
+    fs.writeFileSync(path.join(opts.out, t.name + '.bin'), c.evm.bytecode.object);
+    fs.writeFileSync(path.join(opts.out, t.name + '.bin-runtime'), c.evm.deployedBytecode.object);
+    console.log(`${t.name}: ${c.evm.bytecode.object.length / 2} bytes`);
+  }
+}
+
+try {
+  main();
+} catch (e) {
+  console.error(e.message);
+  process.exit(2);
+}
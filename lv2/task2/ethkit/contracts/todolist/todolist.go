@@ -0,0 +1,443 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package todolist
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// TodoListMetaData contains all meta data concerning the TodoList contract.
+var TodoListMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"}],\"name\":\"create\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index_\",\"type\":\"uint256\"}],\"name\":\"get1\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"status_\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index_\",\"type\":\"uint256\"}],\"name\":\"get2\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"status_\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"list\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"isCompleted\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index_\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"}],\"name\":\"modiName1\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index_\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"}],\"name\":\"modiName2\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index_\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"status_\",\"type\":\"bool\"}],\"name\":\"modiStatus1\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index_\",\"type\":\"uint256\"}],\"name\":\"modiStatus2\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x6080604052348015600f57600080fd5b506108988061001f6000396000f3fe608060405234801561001057600080fd5b50600436106100885760003560e01c80639c117f0f1161005b5780639c117f0f146100f2578063b6a46b3b14610105578063c91b78cb14610118578063d511b4be1461012b57600080fd5b80631fbb5bc51461008d57806331184dc3146100a25780634112a18e146100cc57806380c9419e146100df575b600080fd5b6100a061009b3660046105dc565b61013e565b005b6100b56100b0366004610623565b610174565b6040516100c392919061063c565b60405180910390f35b6100a06100da366004610693565b610248565b6100b56100ed366004610623565b610282565b6100b5610100366004610623565b610341565b6100a06101133660046106c8565b610425565b6100a06101263660046105dc565b61049b565b6100a0610139366004610623565b6104d2565b806000838154811061015257610152610705565b9060005260206000209060020201600001908161016f91906107a3565b505050565b60606000806000848154811061018c5761018c610705565b90600052602060002090600202019050806000018160010160009054906101000a900460ff168180546101be9061071b565b80601f01602080910402602001604051908101604052809291908181526020018280546101ea9061071b565b80156102375780601f1061020c57610100808354040283529160200191610237565b820191906000526020600020905b81548152906001019060200180831161021a57829003601f168201915b505050505091509250925050915091565b806000838154811061025c5761025c610705565b60009182526020909120600290910201600101805460ff19169115159190911790555050565b6000818154811061029257600080fd5b90600052602060002090600202016000915090508060000180546102b59061071b565b80601f01602080910402602001604051908101604052809291908181526020018280546102e19061071b565b801561032e5780601f106103035761010080835404028352916020019161032e565b820191906000526020600020905b81548152906001019060200180831161031157829003601f168201915b5050506001909301549192505060ff1682565b60606000806000848154811061035957610359610705565b90600052602060002090600202016040518060400160405290816000820180546103829061071b565b80601f01602080910402602001604051908101604052809291908181526020018280546103ae9061071b565b80156103fb5780601f106103d0576101008083540402835291602001916103fb565b820191906000526020600020905b8154815290600101906020018083116103de57829003601f168201915b50505091835250506001919091015460ff1615156020918201528151910151909590945092505050565b604080518082019091528181526000602082018190528054600181018255908052815160029091027f290decd9548b62a8d60345a988386fc84ba6bc95484008f6362f93160ef3e5630190819061047c90826107a3565b50602091909101516001909101805460ff191691151591909117905550565b60008083815481106104af576104af610705565b600091825260209091206002909102019050806104cc83826107a3565b50505050565b600081815481106104e5576104e5610705565b60009182526020822060016002909202010154815460ff9091161591908390811061051257610512610705565b60009182526020909120600290910201600101805460ff191691151591909117905550565b634e487b7160e01b600052604160045260246000fd5b600082601f83011261055e57600080fd5b813567ffffffffffffffff81111561057857610578610537565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156105a7576105a7610537565b6040528181528382016020018510156105bf57600080fd5b816020850160208301376000918101602001919091529392505050565b600080604083850312156105ef57600080fd5b82359150602083013567ffffffffffffffff81111561060d57600080fd5b6106198582860161054d565b9150509250929050565b60006020828403121561063557600080fd5b5035919050565b604081526000835180604084015260005b8181101561066a576020818701810151606086840101520161064d565b506000606082850101526060601f19601f83011684010191505082151560208301529392505050565b600080604083850312156106a657600080fd5b82359150602083013580151581146106bd57600080fd5b809150509250929050565b6000602082840312156106da57600080fd5b813567ffffffffffffffff8111156106f157600080fd5b6106fd8482850161054d565b949350505050565b634e487b7160e01b600052603260045260246000fd5b600181811c9082168061072f57607f821691505b60208210810361074f57634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561016f57806000526020600020601f840160051c8101602085101561077c5750805b601f840160051c820191505b8181101561079c5760008155600101610788565b5050505050565b815167ffffffffffffffff8111156107bd576107bd610537565b6107d1816107cb845461071b565b84610755565b6020601f82116001811461080557600083156107ed5750848201515b600019600385901b1c1916600184901b17845561079c565b600084815260208120601f198516915b828110156108355787850151825560209485019460019092019101610815565b50848210156108535786840151600019600387901b60f8161c191681555b50505050600190811b0190555056fea264697066735822122069646e6e54d01f81ea7a0d4711c98e3bf7c61bbd827a78a795d7aa371ee51c9964736f6c634300081e0033",
+}
+
+// TodoListABI is the input ABI used to generate the binding from.
+// Deprecated: Use TodoListMetaData.ABI instead.
+var TodoListABI = TodoListMetaData.ABI
+
+// TodoListBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use TodoListMetaData.Bin instead.
+var TodoListBin = TodoListMetaData.Bin
+
+// DeployTodoList deploys a new Ethereum contract, binding an instance of TodoList to it.
+func DeployTodoList(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *TodoList, error) {
+	parsed, err := TodoListMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(TodoListBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &TodoList{TodoListCaller: TodoListCaller{contract: contract}, TodoListTransactor: TodoListTransactor{contract: contract}, TodoListFilterer: TodoListFilterer{contract: contract}}, nil
+}
+
+// TodoList is an auto generated Go binding around an Ethereum contract.
+type TodoList struct {
+	TodoListCaller     // Read-only binding to the contract
+	TodoListTransactor // Write-only binding to the contract
+	TodoListFilterer   // Log filterer for contract events
+}
+
+// TodoListCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TodoListCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TodoListTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TodoListTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TodoListFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TodoListFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TodoListSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TodoListSession struct {
+	Contract     *TodoList         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// TodoListCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TodoListCallerSession struct {
+	Contract *TodoListCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// TodoListTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TodoListTransactorSession struct {
+	Contract     *TodoListTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// TodoListRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TodoListRaw struct {
+	Contract *TodoList // Generic contract binding to access the raw methods on
+}
+
+// TodoListCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TodoListCallerRaw struct {
+	Contract *TodoListCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TodoListTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TodoListTransactorRaw struct {
+	Contract *TodoListTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTodoList creates a new instance of TodoList, bound to a specific deployed contract.
+func NewTodoList(address common.Address, backend bind.ContractBackend) (*TodoList, error) {
+	contract, err := bindTodoList(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &TodoList{TodoListCaller: TodoListCaller{contract: contract}, TodoListTransactor: TodoListTransactor{contract: contract}, TodoListFilterer: TodoListFilterer{contract: contract}}, nil
+}
+
+// NewTodoListCaller creates a new read-only instance of TodoList, bound to a specific deployed contract.
+func NewTodoListCaller(address common.Address, caller bind.ContractCaller) (*TodoListCaller, error) {
+	contract, err := bindTodoList(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TodoListCaller{contract: contract}, nil
+}
+
+// NewTodoListTransactor creates a new write-only instance of TodoList, bound to a specific deployed contract.
+func NewTodoListTransactor(address common.Address, transactor bind.ContractTransactor) (*TodoListTransactor, error) {
+	contract, err := bindTodoList(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TodoListTransactor{contract: contract}, nil
+}
+
+// NewTodoListFilterer creates a new log filterer instance of TodoList, bound to a specific deployed contract.
+func NewTodoListFilterer(address common.Address, filterer bind.ContractFilterer) (*TodoListFilterer, error) {
+	contract, err := bindTodoList(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TodoListFilterer{contract: contract}, nil
+}
+
+// bindTodoList binds a generic wrapper to an already deployed contract.
+func bindTodoList(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := TodoListMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TodoList *TodoListRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TodoList.Contract.TodoListCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TodoList *TodoListRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TodoList.Contract.TodoListTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TodoList *TodoListRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TodoList.Contract.TodoListTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TodoList *TodoListCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TodoList.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TodoList *TodoListTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TodoList.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TodoList *TodoListTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TodoList.Contract.contract.Transact(opts, method, params...)
+}
+
+// Get1 is a free data retrieval call binding the contract method 0x9c117f0f.
+//
+// Solidity: function get1(uint256 index_) view returns(string name_, bool status_)
+func (_TodoList *TodoListCaller) Get1(opts *bind.CallOpts, index_ *big.Int) (struct {
+	Name   string
+	Status bool
+}, error) {
+	var out []interface{}
+	err := _TodoList.contract.Call(opts, &out, "get1", index_)
+
+	outstruct := new(struct {
+		Name   string
+		Status bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Name = *abi.ConvertType(out[0], new(string)).(*string)
+	outstruct.Status = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// Get1 is a free data retrieval call binding the contract method 0x9c117f0f.
+//
+// Solidity: function get1(uint256 index_) view returns(string name_, bool status_)
+func (_TodoList *TodoListSession) Get1(index_ *big.Int) (struct {
+	Name   string
+	Status bool
+}, error) {
+	return _TodoList.Contract.Get1(&_TodoList.CallOpts, index_)
+}
+
+// Get1 is a free data retrieval call binding the contract method 0x9c117f0f.
+//
+// Solidity: function get1(uint256 index_) view returns(string name_, bool status_)
+func (_TodoList *TodoListCallerSession) Get1(index_ *big.Int) (struct {
+	Name   string
+	Status bool
+}, error) {
+	return _TodoList.Contract.Get1(&_TodoList.CallOpts, index_)
+}
+
+// Get2 is a free data retrieval call binding the contract method 0x31184dc3.
+//
+// Solidity: function get2(uint256 index_) view returns(string name_, bool status_)
+func (_TodoList *TodoListCaller) Get2(opts *bind.CallOpts, index_ *big.Int) (struct {
+	Name   string
+	Status bool
+}, error) {
+	var out []interface{}
+	err := _TodoList.contract.Call(opts, &out, "get2", index_)
+
+	outstruct := new(struct {
+		Name   string
+		Status bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Name = *abi.ConvertType(out[0], new(string)).(*string)
+	outstruct.Status = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// Get2 is a free data retrieval call binding the contract method 0x31184dc3.
+//
+// Solidity: function get2(uint256 index_) view returns(string name_, bool status_)
+func (_TodoList *TodoListSession) Get2(index_ *big.Int) (struct {
+	Name   string
+	Status bool
+}, error) {
+	return _TodoList.Contract.Get2(&_TodoList.CallOpts, index_)
+}
+
+// Get2 is a free data retrieval call binding the contract method 0x31184dc3.
+//
+// Solidity: function get2(uint256 index_) view returns(string name_, bool status_)
+func (_TodoList *TodoListCallerSession) Get2(index_ *big.Int) (struct {
+	Name   string
+	Status bool
+}, error) {
+	return _TodoList.Contract.Get2(&_TodoList.CallOpts, index_)
+}
+
+// List is a free data retrieval call binding the contract method 0x80c9419e.
+//
+// Solidity: function list(uint256 ) view returns(string name, bool isCompleted)
+func (_TodoList *TodoListCaller) List(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	Name        string
+	IsCompleted bool
+}, error) {
+	var out []interface{}
+	err := _TodoList.contract.Call(opts, &out, "list", arg0)
+
+	outstruct := new(struct {
+		Name        string
+		IsCompleted bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Name = *abi.ConvertType(out[0], new(string)).(*string)
+	outstruct.IsCompleted = *abi.ConvertType(out[1], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// List is a free data retrieval call binding the contract method 0x80c9419e.
+//
+// Solidity: function list(uint256 ) view returns(string name, bool isCompleted)
+func (_TodoList *TodoListSession) List(arg0 *big.Int) (struct {
+	Name        string
+	IsCompleted bool
+}, error) {
+	return _TodoList.Contract.List(&_TodoList.CallOpts, arg0)
+}
+
+// List is a free data retrieval call binding the contract method 0x80c9419e.
+//
+// Solidity: function list(uint256 ) view returns(string name, bool isCompleted)
+func (_TodoList *TodoListCallerSession) List(arg0 *big.Int) (struct {
+	Name        string
+	IsCompleted bool
+}, error) {
+	return _TodoList.Contract.List(&_TodoList.CallOpts, arg0)
+}
+
+// Create is a paid mutator transaction binding the contract method 0xb6a46b3b.
+//
+// Solidity: function create(string name_) returns()
+func (_TodoList *TodoListTransactor) Create(opts *bind.TransactOpts, name_ string) (*types.Transaction, error) {
+	return _TodoList.contract.Transact(opts, "create", name_)
+}
+
+// Create is a paid mutator transaction binding the contract method 0xb6a46b3b.
+//
+// Solidity: function create(string name_) returns()
+func (_TodoList *TodoListSession) Create(name_ string) (*types.Transaction, error) {
+	return _TodoList.Contract.Create(&_TodoList.TransactOpts, name_)
+}
+
+// Create is a paid mutator transaction binding the contract method 0xb6a46b3b.
+//
+// Solidity: function create(string name_) returns()
+func (_TodoList *TodoListTransactorSession) Create(name_ string) (*types.Transaction, error) {
+	return _TodoList.Contract.Create(&_TodoList.TransactOpts, name_)
+}
+
+// ModiName1 is a paid mutator transaction binding the contract method 0x1fbb5bc5.
+//
+// Solidity: function modiName1(uint256 index_, string name_) returns()
+func (_TodoList *TodoListTransactor) ModiName1(opts *bind.TransactOpts, index_ *big.Int, name_ string) (*types.Transaction, error) {
+	return _TodoList.contract.Transact(opts, "modiName1", index_, name_)
+}
+
+// ModiName1 is a paid mutator transaction binding the contract method 0x1fbb5bc5.
+//
+// Solidity: function modiName1(uint256 index_, string name_) returns()
+func (_TodoList *TodoListSession) ModiName1(index_ *big.Int, name_ string) (*types.Transaction, error) {
+	return _TodoList.Contract.ModiName1(&_TodoList.TransactOpts, index_, name_)
+}
+
+// ModiName1 is a paid mutator transaction binding the contract method 0x1fbb5bc5.
+//
+// Solidity: function modiName1(uint256 index_, string name_) returns()
+func (_TodoList *TodoListTransactorSession) ModiName1(index_ *big.Int, name_ string) (*types.Transaction, error) {
+	return _TodoList.Contract.ModiName1(&_TodoList.TransactOpts, index_, name_)
+}
+
+// ModiName2 is a paid mutator transaction binding the contract method 0xc91b78cb.
+//
+// Solidity: function modiName2(uint256 index_, string name_) returns()
+func (_TodoList *TodoListTransactor) ModiName2(opts *bind.TransactOpts, index_ *big.Int, name_ string) (*types.Transaction, error) {
+	return _TodoList.contract.Transact(opts, "modiName2", index_, name_)
+}
+
+// ModiName2 is a paid mutator transaction binding the contract method 0xc91b78cb.
+//
+// Solidity: function modiName2(uint256 index_, string name_) returns()
+func (_TodoList *TodoListSession) ModiName2(index_ *big.Int, name_ string) (*types.Transaction, error) {
+	return _TodoList.Contract.ModiName2(&_TodoList.TransactOpts, index_, name_)
+}
+
+// ModiName2 is a paid mutator transaction binding the contract method 0xc91b78cb.
+//
+// Solidity: function modiName2(uint256 index_, string name_) returns()
+func (_TodoList *TodoListTransactorSession) ModiName2(index_ *big.Int, name_ string) (*types.Transaction, error) {
+	return _TodoList.Contract.ModiName2(&_TodoList.TransactOpts, index_, name_)
+}
+
+// ModiStatus1 is a paid mutator transaction binding the contract method 0x4112a18e.
+//
+// Solidity: function modiStatus1(uint256 index_, bool status_) returns()
+func (_TodoList *TodoListTransactor) ModiStatus1(opts *bind.TransactOpts, index_ *big.Int, status_ bool) (*types.Transaction, error) {
+	return _TodoList.contract.Transact(opts, "modiStatus1", index_, status_)
+}
+
+// ModiStatus1 is a paid mutator transaction binding the contract method 0x4112a18e.
+//
+// Solidity: function modiStatus1(uint256 index_, bool status_) returns()
+func (_TodoList *TodoListSession) ModiStatus1(index_ *big.Int, status_ bool) (*types.Transaction, error) {
+	return _TodoList.Contract.ModiStatus1(&_TodoList.TransactOpts, index_, status_)
+}
+
+// ModiStatus1 is a paid mutator transaction binding the contract method 0x4112a18e.
+//
+// Solidity: function modiStatus1(uint256 index_, bool status_) returns()
+func (_TodoList *TodoListTransactorSession) ModiStatus1(index_ *big.Int, status_ bool) (*types.Transaction, error) {
+	return _TodoList.Contract.ModiStatus1(&_TodoList.TransactOpts, index_, status_)
+}
+
+// ModiStatus2 is a paid mutator transaction binding the contract method 0xd511b4be.
+//
+// Solidity: function modiStatus2(uint256 index_) returns()
+func (_TodoList *TodoListTransactor) ModiStatus2(opts *bind.TransactOpts, index_ *big.Int) (*types.Transaction, error) {
+	return _TodoList.contract.Transact(opts, "modiStatus2", index_)
+}
+
+// ModiStatus2 is a paid mutator transaction binding the contract method 0xd511b4be.
+//
+// Solidity: function modiStatus2(uint256 index_) returns()
+func (_TodoList *TodoListSession) ModiStatus2(index_ *big.Int) (*types.Transaction, error) {
+	return _TodoList.Contract.ModiStatus2(&_TodoList.TransactOpts, index_)
+}
+
+// ModiStatus2 is a paid mutator transaction binding the contract method 0xd511b4be.
+//
+// Solidity: function modiStatus2(uint256 index_) returns()
+func (_TodoList *TodoListTransactorSession) ModiStatus2(index_ *big.Int) (*types.Transaction, error) {
+	return _TodoList.Contract.ModiStatus2(&_TodoList.TransactOpts, index_)
+}
@@ -0,0 +1,1134 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package weth
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// WETHMetaData contains all meta data concerning the WETH contract.
+var WETHMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"src\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegateAds\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"toAds\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Deposit\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"src\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"toAds\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"toAds\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Withdrawa\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegateAds\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"depoist\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"toAds\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"src\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"toAds\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	Bin: "0x60c0604052600c60809081526b2bb930b838321022ba33b2b960a11b60a05260009061002b9082610113565b506040805180820190915260048152630ae8aa8960e31b60208201526001906100549082610113565b506002805460ff1916601217905534801561006e57600080fd5b506101d1565b634e487b7160e01b600052604160045260246000fd5b600181811c9082168061009e57607f821691505b6020821081036100be57634e487b7160e01b600052602260045260246000fd5b50919050565b601f82111561010e57806000526020600020601f840160051c810160208510156100eb5750805b601f840160051c820191505b8181101561010b57600081556001016100f7565b50505b505050565b81516001600160401b0381111561012c5761012c610074565b6101408161013a845461008a565b846100c4565b6020601f821160018114610174576000831561015c5750848201515b600019600385901b1c1916600184901b17845561010b565b600084815260208120601f198516915b828110156101a45787850151825560209485019460019092019101610184565b50848210156101c25786840151600019600387901b60f8161c191681555b50505050600190811b01905550565b610787806101e06000396000f3fe6080604052600436106100a05760003560e01c80632e1a7d4d116100645780632e1a7d4d1461014f578063313ce5671461016f57806370a082311461019b57806395d89b41146101c8578063a9059cbb146101dd578063dd62ed3e146101fd576100af565b8063041bacd2146100af57806306fdde03146100b7578063095ea7b3146100e257806318160ddd1461011257806323b872dd1461012f576100af565b366100af576100ad610235565b005b6100ad610235565b3480156100c357600080fd5b506100cc610290565b6040516100d991906105a3565b60405180910390f35b3480156100ee57600080fd5b506101026100fd36600461060d565b61031e565b60405190151581526020016100d9565b34801561011e57600080fd5b50475b6040519081526020016100d9565b34801561013b57600080fd5b5061010261014a366004610637565b61038b565b34801561015b57600080fd5b506100ad61016a366004610674565b6104dc565b34801561017b57600080fd5b506002546101899060ff1681565b60405160ff90911681526020016100d9565b3480156101a757600080fd5b506101216101b636600461068d565b60036020526000908152604090205481565b3480156101d457600080fd5b506100cc610582565b3480156101e957600080fd5b506101026101f836600461060d565b61058f565b34801561020957600080fd5b506101216102183660046106a8565b600460209081526000928352604080842090915290825290205481565b33600090815260036020526040812080543492906102549084906106f1565b909155505060405134815233907fe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c9060200160405180910390a2565b6000805461029d90610704565b80601f01602080910402602001604051908101604052809291908181526020018280546102c990610704565b80156103165780601f106102eb57610100808354040283529160200191610316565b820191906000526020600020905b8154815290600101906020018083116102f957829003601f168201915b505050505081565b3360008181526004602090815260408083206001600160a01b038716808552925280832085905551919290917f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925906103799086815260200190565b60405180910390a35060015b92915050565b6001600160a01b0383166000908152600360205260408120548211156103b057600080fd5b6001600160a01b0384163314610429576001600160a01b03841660009081526004602090815260408083203384529091529020548211156103f057600080fd5b6001600160a01b03841660009081526004602090815260408083203384529091528120805484929061042390849061073e565b90915550505b6001600160a01b0384166000908152600360205260408120805484929061045190849061073e565b90915550506001600160a01b0383166000908152600360205260408120805484929061047e9084906106f1565b92505081905550826001600160a01b0316846001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040516104ca91815260200190565b60405180910390a35060019392505050565b336000908152600360205260409020548111156104f857600080fd5b336000908152600360205260408120805483929061051790849061073e565b9091555050604051339082156108fc029083906000818181858888f19350505050158015610549573d6000803e3d6000fd5b5060405181815233907fd0162d2773fa4f457ac53f1afa2c13f5d237a5e8ec11621d750ed0fe7d7f52279060200160405180910390a250565b6001805461029d90610704565b600061059c33848461038b565b9392505050565b602081526000825180602084015260005b818110156105d157602081860181015160408684010152016105b4565b506000604082850101526040601f19601f83011684010191505092915050565b80356001600160a01b038116811461060857600080fd5b919050565b6000806040838503121561062057600080fd5b610629836105f1565b946020939093013593505050565b60008060006060848603121561064c57600080fd5b610655846105f1565b9250610663602085016105f1565b929592945050506040919091013590565b60006020828403121561068657600080fd5b5035919050565b60006020828403121561069f57600080fd5b61059c826105f1565b600080604083850312156106bb57600080fd5b6106c4836105f1565b91506106d2602084016105f1565b90509250929050565b634e487b7160e01b600052601160045260246000fd5b80820180821115610385576103856106db565b600181811c9082168061071857607f821691505b60208210810361073857634e487b7160e01b600052602260045260246000fd5b50919050565b81810381811115610385576103856106db56fea2646970667358221220b9ca4c8bda5e14c95888b5025fb951172cc234a9b393779b257d8becea6071a564736f6c634300081e0033",
+}
+
+// WETHABI is the input ABI used to generate the binding from.
+// Deprecated: Use WETHMetaData.ABI instead.
+var WETHABI = WETHMetaData.ABI
+
+// WETHBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use WETHMetaData.Bin instead.
+var WETHBin = WETHMetaData.Bin
+
+// DeployWETH deploys a new Ethereum contract, binding an instance of WETH to it.
+func DeployWETH(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *WETH, error) {
+	parsed, err := WETHMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(WETHBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &WETH{WETHCaller: WETHCaller{contract: contract}, WETHTransactor: WETHTransactor{contract: contract}, WETHFilterer: WETHFilterer{contract: contract}}, nil
+}
+
+// WETH is an auto generated Go binding around an Ethereum contract.
+type WETH struct {
+	WETHCaller     // Read-only binding to the contract
+	WETHTransactor // Write-only binding to the contract
+	WETHFilterer   // Log filterer for contract events
+}
+
+// WETHCaller is an auto generated read-only Go binding around an Ethereum contract.
+type WETHCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WETHTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type WETHTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WETHFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type WETHFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WETHSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type WETHSession struct {
+	Contract     *WETH             // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// WETHCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type WETHCallerSession struct {
+	Contract *WETHCaller   // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// WETHTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type WETHTransactorSession struct {
+	Contract     *WETHTransactor   // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// WETHRaw is an auto generated low-level Go binding around an Ethereum contract.
+type WETHRaw struct {
+	Contract *WETH // Generic contract binding to access the raw methods on
+}
+
+// WETHCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type WETHCallerRaw struct {
+	Contract *WETHCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// WETHTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type WETHTransactorRaw struct {
+	Contract *WETHTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewWETH creates a new instance of WETH, bound to a specific deployed contract.
+func NewWETH(address common.Address, backend bind.ContractBackend) (*WETH, error) {
+	contract, err := bindWETH(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &WETH{WETHCaller: WETHCaller{contract: contract}, WETHTransactor: WETHTransactor{contract: contract}, WETHFilterer: WETHFilterer{contract: contract}}, nil
+}
+
+// NewWETHCaller creates a new read-only instance of WETH, bound to a specific deployed contract.
+func NewWETHCaller(address common.Address, caller bind.ContractCaller) (*WETHCaller, error) {
+	contract, err := bindWETH(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHCaller{contract: contract}, nil
+}
+
+// NewWETHTransactor creates a new write-only instance of WETH, bound to a specific deployed contract.
+func NewWETHTransactor(address common.Address, transactor bind.ContractTransactor) (*WETHTransactor, error) {
+	contract, err := bindWETH(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHTransactor{contract: contract}, nil
+}
+
+// NewWETHFilterer creates a new log filterer instance of WETH, bound to a specific deployed contract.
+func NewWETHFilterer(address common.Address, filterer bind.ContractFilterer) (*WETHFilterer, error) {
+	contract, err := bindWETH(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHFilterer{contract: contract}, nil
+}
+
+// bindWETH binds a generic wrapper to an already deployed contract.
+func bindWETH(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := WETHMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_WETH *WETHRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _WETH.Contract.WETHCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_WETH *WETHRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WETH.Contract.WETHTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_WETH *WETHRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _WETH.Contract.WETHTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_WETH *WETHCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _WETH.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_WETH *WETHTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WETH.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_WETH *WETHTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _WETH.Contract.contract.Transact(opts, method, params...)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address , address ) view returns(uint256)
+func (_WETH *WETHCaller) Allowance(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "allowance", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address , address ) view returns(uint256)
+func (_WETH *WETHSession) Allowance(arg0 common.Address, arg1 common.Address) (*big.Int, error) {
+	return _WETH.Contract.Allowance(&_WETH.CallOpts, arg0, arg1)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address , address ) view returns(uint256)
+func (_WETH *WETHCallerSession) Allowance(arg0 common.Address, arg1 common.Address) (*big.Int, error) {
+	return _WETH.Contract.Allowance(&_WETH.CallOpts, arg0, arg1)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address ) view returns(uint256)
+func (_WETH *WETHCaller) BalanceOf(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "balanceOf", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address ) view returns(uint256)
+func (_WETH *WETHSession) BalanceOf(arg0 common.Address) (*big.Int, error) {
+	return _WETH.Contract.BalanceOf(&_WETH.CallOpts, arg0)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address ) view returns(uint256)
+func (_WETH *WETHCallerSession) BalanceOf(arg0 common.Address) (*big.Int, error) {
+	return _WETH.Contract.BalanceOf(&_WETH.CallOpts, arg0)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_WETH *WETHCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_WETH *WETHSession) Decimals() (uint8, error) {
+	return _WETH.Contract.Decimals(&_WETH.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_WETH *WETHCallerSession) Decimals() (uint8, error) {
+	return _WETH.Contract.Decimals(&_WETH.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_WETH *WETHCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_WETH *WETHSession) Name() (string, error) {
+	return _WETH.Contract.Name(&_WETH.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_WETH *WETHCallerSession) Name() (string, error) {
+	return _WETH.Contract.Name(&_WETH.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_WETH *WETHCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_WETH *WETHSession) Symbol() (string, error) {
+	return _WETH.Contract.Symbol(&_WETH.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_WETH *WETHCallerSession) Symbol() (string, error) {
+	return _WETH.Contract.Symbol(&_WETH.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_WETH *WETHCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _WETH.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_WETH *WETHSession) TotalSupply() (*big.Int, error) {
+	return _WETH.Contract.TotalSupply(&_WETH.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_WETH *WETHCallerSession) TotalSupply() (*big.Int, error) {
+	return _WETH.Contract.TotalSupply(&_WETH.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address delegateAds, uint256 amount) returns(bool)
+func (_WETH *WETHTransactor) Approve(opts *bind.TransactOpts, delegateAds common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "approve", delegateAds, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address delegateAds, uint256 amount) returns(bool)
+func (_WETH *WETHSession) Approve(delegateAds common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.Approve(&_WETH.TransactOpts, delegateAds, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address delegateAds, uint256 amount) returns(bool)
+func (_WETH *WETHTransactorSession) Approve(delegateAds common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.Approve(&_WETH.TransactOpts, delegateAds, amount)
+}
+
+// Depoist is a paid mutator transaction binding the contract method 0x041bacd2.
+//
+// Solidity: function depoist() payable returns()
+func (_WETH *WETHTransactor) Depoist(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "depoist")
+}
+
+// Depoist is a paid mutator transaction binding the contract method 0x041bacd2.
+//
+// Solidity: function depoist() payable returns()
+func (_WETH *WETHSession) Depoist() (*types.Transaction, error) {
+	return _WETH.Contract.Depoist(&_WETH.TransactOpts)
+}
+
+// Depoist is a paid mutator transaction binding the contract method 0x041bacd2.
+//
+// Solidity: function depoist() payable returns()
+func (_WETH *WETHTransactorSession) Depoist() (*types.Transaction, error) {
+	return _WETH.Contract.Depoist(&_WETH.TransactOpts)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address toAds, uint256 amount) returns(bool)
+func (_WETH *WETHTransactor) Transfer(opts *bind.TransactOpts, toAds common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "transfer", toAds, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address toAds, uint256 amount) returns(bool)
+func (_WETH *WETHSession) Transfer(toAds common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.Transfer(&_WETH.TransactOpts, toAds, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address toAds, uint256 amount) returns(bool)
+func (_WETH *WETHTransactorSession) Transfer(toAds common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.Transfer(&_WETH.TransactOpts, toAds, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address src, address toAds, uint256 amount) returns(bool)
+func (_WETH *WETHTransactor) TransferFrom(opts *bind.TransactOpts, src common.Address, toAds common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "transferFrom", src, toAds, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address src, address toAds, uint256 amount) returns(bool)
+func (_WETH *WETHSession) TransferFrom(src common.Address, toAds common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.TransferFrom(&_WETH.TransactOpts, src, toAds, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address src, address toAds, uint256 amount) returns(bool)
+func (_WETH *WETHTransactorSession) TransferFrom(src common.Address, toAds common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.TransferFrom(&_WETH.TransactOpts, src, toAds, amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 amount) returns()
+func (_WETH *WETHTransactor) Withdraw(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _WETH.contract.Transact(opts, "withdraw", amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 amount) returns()
+func (_WETH *WETHSession) Withdraw(amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.Withdraw(&_WETH.TransactOpts, amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x2e1a7d4d.
+//
+// Solidity: function withdraw(uint256 amount) returns()
+func (_WETH *WETHTransactorSession) Withdraw(amount *big.Int) (*types.Transaction, error) {
+	return _WETH.Contract.Withdraw(&_WETH.TransactOpts, amount)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_WETH *WETHTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _WETH.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_WETH *WETHSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _WETH.Contract.Fallback(&_WETH.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_WETH *WETHTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _WETH.Contract.Fallback(&_WETH.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_WETH *WETHTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WETH.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_WETH *WETHSession) Receive() (*types.Transaction, error) {
+	return _WETH.Contract.Receive(&_WETH.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_WETH *WETHTransactorSession) Receive() (*types.Transaction, error) {
+	return _WETH.Contract.Receive(&_WETH.TransactOpts)
+}
+
+// WETHApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the WETH contract.
+type WETHApprovalIterator struct {
+	Event *WETHApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHApproval represents a Approval event raised by the WETH contract.
+type WETHApproval struct {
+	Src         common.Address
+	DelegateAds common.Address
+	Amount      *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed src, address indexed delegateAds, uint256 amount)
+func (_WETH *WETHFilterer) FilterApproval(opts *bind.FilterOpts, src []common.Address, delegateAds []common.Address) (*WETHApprovalIterator, error) {
+
+	var srcRule []interface{}
+	for _, srcItem := range src {
+		srcRule = append(srcRule, srcItem)
+	}
+	var delegateAdsRule []interface{}
+	for _, delegateAdsItem := range delegateAds {
+		delegateAdsRule = append(delegateAdsRule, delegateAdsItem)
+	}
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "Approval", srcRule, delegateAdsRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHApprovalIterator{contract: _WETH.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed src, address indexed delegateAds, uint256 amount)
+func (_WETH *WETHFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *WETHApproval, src []common.Address, delegateAds []common.Address) (event.Subscription, error) {
+
+	var srcRule []interface{}
+	for _, srcItem := range src {
+		srcRule = append(srcRule, srcItem)
+	}
+	var delegateAdsRule []interface{}
+	for _, delegateAdsItem := range delegateAds {
+		delegateAdsRule = append(delegateAdsRule, delegateAdsItem)
+	}
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "Approval", srcRule, delegateAdsRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHApproval)
+				if err := _WETH.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed src, address indexed delegateAds, uint256 amount)
+func (_WETH *WETHFilterer) ParseApproval(log types.Log) (*WETHApproval, error) {
+	event := new(WETHApproval)
+	if err := _WETH.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the WETH contract.
+type WETHDepositIterator struct {
+	Event *WETHDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHDeposit represents a Deposit event raised by the WETH contract.
+type WETHDeposit struct {
+	ToAds  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c.
+//
+// Solidity: event Deposit(address indexed toAds, uint256 amount)
+func (_WETH *WETHFilterer) FilterDeposit(opts *bind.FilterOpts, toAds []common.Address) (*WETHDepositIterator, error) {
+
+	var toAdsRule []interface{}
+	for _, toAdsItem := range toAds {
+		toAdsRule = append(toAdsRule, toAdsItem)
+	}
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "Deposit", toAdsRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHDepositIterator{contract: _WETH.contract, event: "Deposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c.
+//
+// Solidity: event Deposit(address indexed toAds, uint256 amount)
+func (_WETH *WETHFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *WETHDeposit, toAds []common.Address) (event.Subscription, error) {
+
+	var toAdsRule []interface{}
+	for _, toAdsItem := range toAds {
+		toAdsRule = append(toAdsRule, toAdsItem)
+	}
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "Deposit", toAdsRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHDeposit)
+				if err := _WETH.contract.UnpackLog(event, "Deposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposit is a log parse operation binding the contract event 0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c.
+//
+// Solidity: event Deposit(address indexed toAds, uint256 amount)
+func (_WETH *WETHFilterer) ParseDeposit(log types.Log) (*WETHDeposit, error) {
+	event := new(WETHDeposit)
+	if err := _WETH.contract.UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the WETH contract.
+type WETHTransferIterator struct {
+	Event *WETHTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHTransfer represents a Transfer event raised by the WETH contract.
+type WETHTransfer struct {
+	Src    common.Address
+	ToAds  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed src, address indexed toAds, uint256 amount)
+func (_WETH *WETHFilterer) FilterTransfer(opts *bind.FilterOpts, src []common.Address, toAds []common.Address) (*WETHTransferIterator, error) {
+
+	var srcRule []interface{}
+	for _, srcItem := range src {
+		srcRule = append(srcRule, srcItem)
+	}
+	var toAdsRule []interface{}
+	for _, toAdsItem := range toAds {
+		toAdsRule = append(toAdsRule, toAdsItem)
+	}
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "Transfer", srcRule, toAdsRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHTransferIterator{contract: _WETH.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed src, address indexed toAds, uint256 amount)
+func (_WETH *WETHFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *WETHTransfer, src []common.Address, toAds []common.Address) (event.Subscription, error) {
+
+	var srcRule []interface{}
+	for _, srcItem := range src {
+		srcRule = append(srcRule, srcItem)
+	}
+	var toAdsRule []interface{}
+	for _, toAdsItem := range toAds {
+		toAdsRule = append(toAdsRule, toAdsItem)
+	}
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "Transfer", srcRule, toAdsRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHTransfer)
+				if err := _WETH.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed src, address indexed toAds, uint256 amount)
+func (_WETH *WETHFilterer) ParseTransfer(log types.Log) (*WETHTransfer, error) {
+	event := new(WETHTransfer)
+	if err := _WETH.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// WETHWithdrawaIterator is returned from FilterWithdrawa and is used to iterate over the raw logs and unpacked data for Withdrawa events raised by the WETH contract.
+type WETHWithdrawaIterator struct {
+	Event *WETHWithdrawa // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *WETHWithdrawaIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(WETHWithdrawa)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(WETHWithdrawa)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *WETHWithdrawaIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *WETHWithdrawaIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// WETHWithdrawa represents a Withdrawa event raised by the WETH contract.
+type WETHWithdrawa struct {
+	ToAds  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawa is a free log retrieval operation binding the contract event 0xd0162d2773fa4f457ac53f1afa2c13f5d237a5e8ec11621d750ed0fe7d7f5227.
+//
+// Solidity: event Withdrawa(address indexed toAds, uint256 amount)
+func (_WETH *WETHFilterer) FilterWithdrawa(opts *bind.FilterOpts, toAds []common.Address) (*WETHWithdrawaIterator, error) {
+
+	var toAdsRule []interface{}
+	for _, toAdsItem := range toAds {
+		toAdsRule = append(toAdsRule, toAdsItem)
+	}
+
+	logs, sub, err := _WETH.contract.FilterLogs(opts, "Withdrawa", toAdsRule)
+	if err != nil {
+		return nil, err
+	}
+	return &WETHWithdrawaIterator{contract: _WETH.contract, event: "Withdrawa", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawa is a free log subscription operation binding the contract event 0xd0162d2773fa4f457ac53f1afa2c13f5d237a5e8ec11621d750ed0fe7d7f5227.
+//
+// Solidity: event Withdrawa(address indexed toAds, uint256 amount)
+func (_WETH *WETHFilterer) WatchWithdrawa(opts *bind.WatchOpts, sink chan<- *WETHWithdrawa, toAds []common.Address) (event.Subscription, error) {
+
+	var toAdsRule []interface{}
+	for _, toAdsItem := range toAds {
+		toAdsRule = append(toAdsRule, toAdsItem)
+	}
+
+	logs, sub, err := _WETH.contract.WatchLogs(opts, "Withdrawa", toAdsRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(WETHWithdrawa)
+				if err := _WETH.contract.UnpackLog(event, "Withdrawa", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawa is a log parse operation binding the contract event 0xd0162d2773fa4f457ac53f1afa2c13f5d237a5e8ec11621d750ed0fe7d7f5227.
+//
+// Solidity: event Withdrawa(address indexed toAds, uint256 amount)
+func (_WETH *WETHFilterer) ParseWithdrawa(log types.Log) (*WETHWithdrawa, error) {
+	event := new(WETHWithdrawa)
+	if err := _WETH.contract.UnpackLog(event, "Withdrawa", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
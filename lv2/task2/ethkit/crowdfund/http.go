@@ -0,0 +1,170 @@
+package crowdfund
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+)
+
+// Register 注册众筹的 HTTP 接口
+//
+//	GET  /campaigns                         所有合约的进度
+//	GET  /campaigns/:address                进度和每个捐款人的金额
+//	GET  /campaigns/:address/contributions  捐款记录
+//	POST /campaigns                         {"beneficiary": "0x...", "goal": "wei"} 部署新合约
+//	POST /campaigns/track                   {"address": "0x...", "fromBlock": 123} 跟踪已有合约
+func Register(r gin.IRouter, s *Service) {
+	h := &handler{svc: s}
+	g := r.Group("/campaigns")
+	g.GET("", h.list)
+	g.GET("/:address", h.get)
+	g.GET("/:address/contributions", h.contributions)
+	g.POST("", h.deploy)
+	g.POST("/track", h.track)
+}
+
+type handler struct {
+	svc *Service
+}
+
+type funderView struct {
+	Address common.Address `json:"address"`
+	Amount  *big.Int       `json:"amount"`
+}
+
+type campaignView struct {
+	Address     common.Address `json:"address"`
+	Beneficiary common.Address `json:"beneficiary"`
+	Goal        *big.Int       `json:"goal"`
+	Raised      *big.Int       `json:"raised"`
+	Progress    float64        `json:"progress"` // Raised / Goal，达到目标为 1
+	Funders     int            `json:"funders"`
+	Closed      bool           `json:"closed"`
+	CloseTx     *common.Hash   `json:"closeTx,omitempty"`
+	Synced      uint64         `json:"synced"`
+}
+
+func view(c Campaign) campaignView {
+	return campaignView{
+		Address:     c.Address,
+		Beneficiary: c.Beneficiary,
+		Goal:        c.Goal,
+		Raised:      c.Raised,
+		Progress:    c.Progress(),
+		Funders:     len(c.Funders),
+		Closed:      c.Closed,
+		CloseTx:     c.CloseTx,
+		Synced:      c.Synced,
+	}
+}
+
+func (h *handler) list(c *gin.Context) {
+	campaigns := h.svc.Campaigns()
+	views := make([]campaignView, len(campaigns))
+	for i, campaign := range campaigns {
+		views[i] = view(campaign)
+	}
+	c.JSON(http.StatusOK, gin.H{"campaigns": views})
+}
+
+func (h *handler) get(c *gin.Context) {
+	campaign, ok := h.campaign(c)
+	if !ok {
+		return
+	}
+	funders := make([]funderView, 0, len(campaign.Funders))
+	for address, amount := range campaign.Funders {
+		funders = append(funders, funderView{Address: address, Amount: amount})
+	}
+	sort.Slice(funders, func(i, j int) bool { return funders[i].Amount.Cmp(funders[j].Amount) > 0 })
+	c.JSON(http.StatusOK, gin.H{"campaign": view(campaign), "funders": funders})
+}
+
+func (h *handler) contributions(c *gin.Context) {
+	campaign, ok := h.campaign(c)
+	if !ok {
+		return
+	}
+	contributions := campaign.Contributions
+	if funder := c.Query("funder"); funder != "" {
+		if !common.IsHexAddress(funder) {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid funder"})
+			return
+		}
+		address := common.HexToAddress(funder)
+		contributions = nil
+		for _, x := range campaign.Contributions {
+			if x.Funder == address {
+				contributions = append(contributions, x)
+			}
+		}
+	}
+	if contributions == nil {
+		contributions = []Contribution{}
+	}
+	c.JSON(http.StatusOK, gin.H{"contributions": contributions})
+}
+
+func (h *handler) deploy(c *gin.Context) {
+	var req struct {
+		Beneficiary string `json:"beneficiary" binding:"required"`
+		Goal        string `json:"goal" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	if !common.IsHexAddress(req.Beneficiary) {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid beneficiary"})
+		return
+	}
+	goal, ok := new(big.Int).SetString(req.Goal, 10)
+	if !ok || goal.Sign() <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid goal"})
+		return
+	}
+	campaign, err := h.svc.Deploy(c.Request.Context(), common.HexToAddress(req.Beneficiary), goal)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"campaign": view(campaign)})
+}
+
+func (h *handler) track(c *gin.Context) {
+	var req struct {
+		Address   string `json:"address" binding:"required"`
+		FromBlock uint64 `json:"fromBlock"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	if !common.IsHexAddress(req.Address) {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid address"})
+		return
+	}
+	campaign, err := h.svc.Track(c.Request.Context(), common.HexToAddress(req.Address), req.FromBlock)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"campaign": view(campaign)})
+}
+
+func (h *handler) campaign(c *gin.Context) (Campaign, bool) {
+	if !common.IsHexAddress(c.Param("address")) {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid address"})
+		return Campaign{}, false
+	}
+	campaign, err := h.svc.Campaign(common.HexToAddress(c.Param("address")))
+	if errors.Is(err, ErrUnknownCampaign) {
+		c.JSON(http.StatusNotFound, gin.H{"msg": err.Error()})
+		return Campaign{}, false
+	}
+	return campaign, true
+}
@@ -0,0 +1,388 @@
+package crowdfund
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"ethkit/abicall"
+	"ethkit/contracts/crowdfunding"
+	"ethkit/preflight"
+	"ethkit/signer"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var ErrUnknownCampaign = errors.New("crowdfund: unknown campaign")
+
+// Backend 在 abicall.Backend 之外还要逐块扫描交易、按链 ID 恢复发送方
+type Backend interface {
+	abicall.Backend
+	ethereum.ChainReader
+	ethereum.BlockNumberReader
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+type Config struct {
+	Bin       []byte             // CrowdFunding 的创建字节码，为空时用 contracts/crowdfunding 里编译好的
+	Auth      *bind.TransactOpts // 部署和 close 用的签名器，为空时只跟踪不发交易
+	AutoClose bool               // 达到目标后自动调用 close
+	Interval  time.Duration
+	StatePath string
+}
+
+// Service 部署和跟踪 CrowdFunding 合约。合约没有事件，捐款记录靠逐块扫描发给合约的 contribute 交易得到，
+// 通过其他合约间接调用的 contribute 看不到，每轮会用链上的 fundingAmount 校验
+type Service struct {
+	cfg     Config
+	backend Backend
+	abi     *abi.ABI
+	signer  types.Signer
+
+	send  sync.Mutex // Deploy 和自动 close 共用一个签名账户，串行发送避免 nonce 冲突
+	scan  sync.Mutex // 同一时间只有一个 Sync 在扫描
+	mu    sync.RWMutex
+	state *state
+}
+
+func New(ctx context.Context, cfg Config, backend Backend) (*Service, error) {
+	if cfg.Interval == 0 {
+		cfg.Interval = 5 * time.Second
+	}
+	if len(cfg.Bin) == 0 {
+		cfg.Bin = common.FromHex(crowdfunding.CrowdFundingMetaData.Bin)
+	}
+	parsed, err := crowdfunding.CrowdFundingMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	st, err := loadState(cfg.StatePath)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		cfg:     cfg,
+		backend: backend,
+		abi:     parsed,
+		signer:  types.LatestSignerForChainID(chainID),
+		state:   st,
+	}, nil
+}
+
+// Deploy 部署一个新的众筹合约并开始跟踪
+func (s *Service) Deploy(ctx context.Context, beneficiary common.Address, goal *big.Int) (Campaign, error) {
+	if s.cfg.Auth == nil {
+		return Campaign{}, errors.New("crowdfund: no signer configured")
+	}
+	s.send.Lock()
+	address, tx, _, err := bind.DeployContract(signer.WithContext(ctx, s.cfg.Auth), *s.abi, s.cfg.Bin, s.backend, beneficiary, goal)
+	s.send.Unlock()
+	if err != nil {
+		return Campaign{}, err
+	}
+	receipt, err := bind.WaitMined(ctx, s.backend, tx)
+	if err != nil {
+		return Campaign{}, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return Campaign{}, fmt.Errorf("crowdfund: deploy tx %s reverted", tx.Hash().Hex())
+	}
+	c := &Campaign{
+		Address:     address,
+		Beneficiary: beneficiary,
+		Goal:        goal,
+		Raised:      new(big.Int),
+		Funders:     map[common.Address]*big.Int{},
+		Synced:      receipt.BlockNumber.Uint64(),
+	}
+	log.Printf("crowdfund: deployed %s goal=%s beneficiary=%s", address.Hex(), goal, beneficiary.Hex())
+	return s.add(c)
+}
+
+// Track 开始跟踪已经部署的合约。from 为合约部署区块时从那里开始回放交易；
+// from 为 0 时直接读取合约里当前的捐款人和金额作为起点
+func (s *Service) Track(ctx context.Context, address common.Address, from uint64) (Campaign, error) {
+	contract, err := crowdfunding.NewCrowdFundingCaller(address, s.backend)
+	if err != nil {
+		return Campaign{}, err
+	}
+	head, err := s.backend.BlockNumber(ctx)
+	if err != nil {
+		return Campaign{}, err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(head)}
+	c := &Campaign{Address: address, Raised: new(big.Int), Funders: map[common.Address]*big.Int{}}
+	if c.Beneficiary, err = contract.Beneficiary(opts); err != nil {
+		return Campaign{}, err
+	}
+	if c.Goal, err = contract.FundingGoal(opts); err != nil {
+		return Campaign{}, err
+	}
+	if from > 0 {
+		c.Synced = from - 1
+		return s.add(c)
+	}
+
+	available, err := contract.AVALIABLED(opts)
+	if err != nil {
+		return Campaign{}, err
+	}
+	c.Closed = !available
+	n, err := contract.FunderLenght(opts)
+	if err != nil {
+		return Campaign{}, err
+	}
+	for i := int64(0); i < n.Int64(); i++ {
+		funder, err := contract.Funderskey(opts, big.NewInt(i))
+		if err != nil {
+			return Campaign{}, err
+		}
+		amount, err := contract.Funders(opts, funder)
+		if err != nil {
+			return Campaign{}, err
+		}
+		c.Funders[funder] = amount
+		c.Raised.Add(c.Raised, amount)
+	}
+	c.Synced = head
+	return s.add(c)
+}
+
+func (s *Service) add(c *Campaign) (Campaign, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.state.Campaigns[c.Address]; ok {
+		return Campaign{}, fmt.Errorf("crowdfund: %s is already tracked", c.Address.Hex())
+	}
+	s.state.Campaigns[c.Address] = c
+	return copyCampaign(c), s.state.save()
+}
+
+// Run 轮询直到 ctx 取消
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Sync(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("crowdfund: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync 扫描新区块里的 contribute 交易，然后检查是否需要 close。
+// 扫描和读合约都不持有 s.mu，只在计入结果时加锁，追赶大量区块时 HTTP 读接口不会被挡住
+func (s *Service) Sync(ctx context.Context) error {
+	s.scan.Lock()
+	defer s.scan.Unlock()
+	head, err := s.backend.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	// 只处理开始扫描时已经在跟踪的合约，扫描期间 Deploy / Track 新加的留给下一轮
+	s.mu.RLock()
+	synced := make(map[common.Address]uint64, len(s.state.Campaigns))
+	for addr, c := range s.state.Campaigns {
+		synced[addr] = c.Synced
+	}
+	s.mu.RUnlock()
+	if len(synced) == 0 {
+		return nil
+	}
+	found, err := s.scanContributions(ctx, synced, head)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	var open []Campaign
+	for _, f := range found {
+		c := s.state.Campaigns[f.campaign]
+		got := c.apply(f.funder, f.value, f.block, f.hash)
+		log.Printf("crowdfund: %s: %s contributed %s (accepted %s), raised %s/%s",
+			c.Address.Hex(), f.funder.Hex(), got.Value, got.Accepted, c.Raised, c.Goal)
+	}
+	for addr := range synced {
+		c := s.state.Campaigns[addr]
+		c.Synced = max(c.Synced, head)
+		if !c.Closed {
+			open = append(open, copyCampaign(c))
+		}
+	}
+	err = s.state.save()
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	for _, c := range open {
+		closed, closeTx, err := s.checkClose(ctx, c)
+		var dry *preflight.DryRunError
+		if errors.As(err, &dry) {
+			log.Printf("crowdfund: %s: dry run, close not sent\n%s", c.Address.Hex(), dry.Report)
+			continue
+		}
+		if err != nil {
+			log.Printf("crowdfund: %s: %v", c.Address.Hex(), err)
+			continue
+		}
+		if closed == c.Closed && closeTx == c.CloseTx {
+			continue
+		}
+		s.mu.Lock()
+		tracked := s.state.Campaigns[c.Address]
+		tracked.Closed, tracked.CloseTx = closed, closeTx
+		err = s.state.save()
+		s.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// found 是扫描到的一笔成功的 contribute
+type found struct {
+	campaign common.Address
+	funder   common.Address
+	value    *big.Int
+	block    uint64
+	hash     common.Hash
+}
+
+// scanContributions 扫描 (min(synced), head] 区间，返回每个合约在自己 Synced 之后的成功捐款，按区块顺序
+func (s *Service) scanContributions(ctx context.Context, synced map[common.Address]uint64, head uint64) ([]found, error) {
+	from := head + 1
+	for _, n := range synced {
+		from = min(from, n+1)
+	}
+	contribute := s.abi.Methods["contribute"].ID
+	var out []found
+	for number := from; number <= head; number++ {
+		block, err := s.backend.BlockByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range block.Transactions() {
+			if tx.To() == nil || len(tx.Data()) < 4 || !bytes.Equal(tx.Data()[:4], contribute) {
+				continue
+			}
+			last, ok := synced[*tx.To()]
+			if !ok || number <= last {
+				continue
+			}
+			receipt, err := s.backend.TransactionReceipt(ctx, tx.Hash())
+			if err != nil {
+				return nil, err
+			}
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				continue // 关闭后的捐款会 revert
+			}
+			funder, err := types.Sender(s.signer, tx)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, found{campaign: *tx.To(), funder: funder, value: tx.Value(), block: number, hash: tx.Hash()})
+		}
+	}
+	return out, nil
+}
+
+// checkClose 读取合约状态，返回新的 Closed 和 CloseTx：已经关闭的标记为 Closed；
+// 达到目标且开启了 AutoClose 时发出 close，上一笔 close 还没上链时不重复发送
+func (s *Service) checkClose(ctx context.Context, c Campaign) (bool, *common.Hash, error) {
+	contract, err := crowdfunding.NewCrowdFunding(c.Address, s.backend)
+	if err != nil {
+		return false, c.CloseTx, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	available, err := contract.AVALIABLED(opts)
+	if err != nil {
+		return false, c.CloseTx, err
+	}
+	if !available {
+		log.Printf("crowdfund: %s closed, raised %s", c.Address.Hex(), c.Raised)
+		return true, c.CloseTx, nil
+	}
+	amount, err := contract.FundingAmount(opts)
+	if err != nil {
+		return false, c.CloseTx, err
+	}
+	if amount.Cmp(c.Raised) != 0 {
+		log.Printf("crowdfund: %s: on-chain fundingAmount %s differs from tracked %s (contribution via another contract?)",
+			c.Address.Hex(), amount, c.Raised)
+	}
+	if amount.Cmp(c.Goal) < 0 || !s.cfg.AutoClose || s.cfg.Auth == nil {
+		return false, c.CloseTx, nil
+	}
+	if c.CloseTx != nil {
+		_, err := s.backend.TransactionReceipt(ctx, *c.CloseTx)
+		if errors.Is(err, ethereum.NotFound) {
+			return false, c.CloseTx, nil // 还在等上链
+		}
+		if err != nil {
+			return false, c.CloseTx, err
+		}
+		// 已经上链但合约仍是开放状态，说明 close 失败了，重新发送
+	}
+	s.send.Lock()
+	tx, err := contract.Close(signer.WithContext(ctx, s.cfg.Auth))
+	s.send.Unlock()
+	if err != nil {
+		return false, c.CloseTx, fmt.Errorf("close: %w", err)
+	}
+	hash := tx.Hash()
+	log.Printf("crowdfund: %s reached goal %s, sent close %s", c.Address.Hex(), c.Goal, hash.Hex())
+	return false, &hash, nil
+}
+
+// Campaigns 返回所有跟踪中的合约，按地址排序
+func (s *Service) Campaigns() []Campaign {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]Campaign, 0, len(s.state.Campaigns))
+	for _, c := range s.state.Campaigns {
+		out = append(out, copyCampaign(c))
+	}
+	sort.Slice(out, func(i, j int) bool { return bytes.Compare(out[i].Address[:], out[j].Address[:]) < 0 })
+	return out
+}
+
+func (s *Service) Campaign(address common.Address) (Campaign, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.state.Campaigns[address]
+	if !ok {
+		return Campaign{}, ErrUnknownCampaign
+	}
+	return copyCampaign(c), nil
+}
+
+// copyCampaign 复制一份给调用方，避免和 Sync 并发读写
+func copyCampaign(c *Campaign) Campaign {
+	out := *c
+	out.Funders = make(map[common.Address]*big.Int, len(c.Funders))
+	for k, v := range c.Funders {
+		out.Funders[k] = v
+	}
+	out.Contributions = append([]Contribution(nil), c.Contributions...)
+	return out
+}
@@ -0,0 +1,222 @@
+package crowdfund
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"ethkit/contracts/crowdfunding"
+	"ethkit/simchain"
+	"ethkit/simchain/simchaintest"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func ether(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(params.Ether))
+}
+
+// committing 在后台持续出块直到 fn 返回，Deploy 这类会等回执的调用在模拟链上才能返回
+func committing[T any](chain *simchain.Chain, fn func() (T, error)) (T, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ctx.Err() == nil {
+			chain.Commit()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	v, err := fn()
+	cancel()
+	<-done
+	return v, err
+}
+
+// 账户 0 部署并自动 close，1、2 捐款，3 是受益人
+func TestCampaign(t *testing.T) {
+	chain, err := simchain.New(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	ctx := context.Background()
+	statePath := filepath.Join(t.TempDir(), "crowdfund.json")
+	cfg := Config{Auth: chain.Transactor(0), AutoClose: true, StatePath: statePath}
+	svc, err := New(ctx, cfg, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beneficiary := chain.Accounts[3].Address
+	c, err := committing(chain, func() (Campaign, error) { return svc.Deploy(ctx, beneficiary, ether(10)) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract, err := crowdfunding.NewCrowdFunding(c.Address, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployBlock := c.Synced
+	contribute := func(account int, value *big.Int) *types.Receipt {
+		t.Helper()
+		auth := chain.Transactor(account)
+		auth.Value = value
+		tx, err := contract.Contribute(auth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipt, err := chain.Mine(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return receipt
+	}
+	sync := func() Campaign {
+		t.Helper()
+		if err := svc.Sync(ctx); err != nil {
+			t.Fatal(err)
+		}
+		c, err := svc.Campaign(c.Address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	funder1, funder2 := chain.Accounts[1].Address, chain.Accounts[2].Address
+
+	contribute(1, ether(4))
+	contribute(2, ether(3))
+	c = sync()
+	if c.Raised.Cmp(ether(7)) != 0 || c.Funders[funder1].Cmp(ether(4)) != 0 || c.Funders[funder2].Cmp(ether(3)) != 0 || c.CloseTx != nil {
+		t.Fatalf("after two contributions = %+v", c)
+	}
+
+	// 超过目标的部分合约当场退回，只计入剩下的 3
+	before, err := chain.BalanceAt(funder1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt := contribute(1, ether(5))
+	fee := new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+	simchaintest.RequireBalance(t, chain, funder1, new(big.Int).Sub(new(big.Int).Sub(before, ether(3)), fee))
+	c = sync()
+	last := c.Contributions[len(c.Contributions)-1]
+	if c.Raised.Cmp(ether(10)) != 0 || last.Accepted.Cmp(ether(3)) != 0 || last.Refund.Cmp(ether(2)) != 0 || c.Funders[funder1].Cmp(ether(7)) != 0 {
+		t.Fatalf("after capped contribution = %+v", c)
+	}
+	// 达到目标后发出 close，上链前再同步不会重复发送
+	if c.CloseTx == nil || c.Closed {
+		t.Fatalf("close not sent: %+v", c)
+	}
+	pending := *c.CloseTx
+	if c = sync(); c.CloseTx == nil || *c.CloseTx != pending {
+		t.Fatalf("close resent before it was mined: %+v", c)
+	}
+	chain.Commit()
+	if c = sync(); !c.Closed {
+		t.Fatalf("not closed after close was mined: %+v", c)
+	}
+	simchaintest.RequireBalance(t, chain, beneficiary, new(big.Int).Add(simchain.DefaultBalance, ether(10)))
+
+	// 关闭后的捐款在链上 revert，固定 gas 跳过估算让它上链，同步时忽略
+	auth := chain.Transactor(2)
+	auth.Value, auth.GasLimit = ether(1), 100000
+	tx, err := contract.Contribute(auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+	if r, err := chain.Client.TransactionReceipt(ctx, tx.Hash()); err != nil || r.Status != types.ReceiptStatusFailed {
+		t.Fatalf("contribution after close: %+v, %v", r, err)
+	}
+	if c = sync(); len(c.Contributions) != 3 || c.Raised.Cmp(ether(10)) != 0 {
+		t.Fatalf("failed contribution counted: %+v", c)
+	}
+
+	// 重启后从状态文件恢复；另外两个服务分别按回放和读合约跟踪同一个合约，得到相同的捐款人
+	if svc, err = New(ctx, cfg, chain.Client); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := svc.Campaign(c.Address); err != nil || !got.Closed || got.Raised.Cmp(ether(10)) != 0 || got.Synced != c.Synced {
+		t.Fatalf("after restart = %+v, %v", got, err)
+	}
+	for _, from := range []uint64{deployBlock, 0} {
+		other, err := New(ctx, Config{StatePath: filepath.Join(t.TempDir(), "crowdfund.json")}, chain.Client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := other.Track(ctx, c.Address, from); err != nil {
+			t.Fatal(err)
+		}
+		if err := other.Sync(ctx); err != nil {
+			t.Fatal(err)
+		}
+		got, err := other.Campaign(c.Address)
+		if err != nil || len(got.Funders) != 2 || got.Funders[funder1].Cmp(ether(7)) != 0 || got.Funders[funder2].Cmp(ether(3)) != 0 || !got.Closed {
+			t.Fatalf("tracked from block %d = %+v, %v", from, got, err)
+		}
+	}
+}
+
+// slowBackend 让 BlockByNumber 等到 release 关闭，模拟追赶大量区块
+type slowBackend struct {
+	Backend
+	scanning chan struct{}
+	release  chan struct{}
+}
+
+func (b *slowBackend) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	select {
+	case b.scanning <- struct{}{}:
+	default:
+	}
+	<-b.release
+	return b.Backend.BlockByNumber(ctx, number)
+}
+
+// 扫描区块时不持有读锁，Campaigns / Campaign 可以照常返回
+func TestSyncDoesNotBlockReads(t *testing.T) {
+	chain, err := simchain.New(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	ctx := context.Background()
+	address, tx, _, err := crowdfunding.DeployCrowdFunding(chain.Transactor(0), chain.Client, chain.Accounts[1].Address, ether(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chain.Mine(tx); err != nil {
+		t.Fatal(err)
+	}
+	backend := &slowBackend{Backend: chain.Client, scanning: make(chan struct{}, 1), release: make(chan struct{})}
+	svc, err := New(ctx, Config{StatePath: filepath.Join(t.TempDir(), "crowdfund.json")}, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Track(ctx, address, 0); err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+
+	done := make(chan error)
+	go func() { done <- svc.Sync(ctx) }()
+	<-backend.scanning
+	read := make(chan int)
+	go func() { read <- len(svc.Campaigns()) }()
+	select {
+	case n := <-read:
+		if n != 1 {
+			t.Fatalf("campaigns = %d", n)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Campaigns blocked while Sync was scanning")
+	}
+	close(backend.release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
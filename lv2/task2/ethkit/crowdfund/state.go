@@ -0,0 +1,115 @@
+package crowdfund
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Contribution 是一笔成功的 contribute 交易。超过目标的部分合约会退回，Accepted 是实际计入的金额
+type Contribution struct {
+	Funder   common.Address `json:"funder"`
+	Value    *big.Int       `json:"value"`
+	Accepted *big.Int       `json:"accepted"`
+	Refund   *big.Int       `json:"refund"`
+	Block    uint64         `json:"block"`
+	TxHash   common.Hash    `json:"txHash"`
+}
+
+// Campaign 是一个众筹合约的跟踪状态
+type Campaign struct {
+	Address       common.Address              `json:"address"`
+	Beneficiary   common.Address              `json:"beneficiary"`
+	Goal          *big.Int                    `json:"goal"`
+	Raised        *big.Int                    `json:"raised"` // 累计计入的金额，关闭后合约里的 fundingAmount 清零，这里不清
+	Funders       map[common.Address]*big.Int `json:"funders"`
+	Contributions []Contribution              `json:"contributions"`
+	Closed        bool                        `json:"closed"`
+	CloseTx       *common.Hash                `json:"closeTx,omitempty"` // 本服务发出的 close 交易
+	Synced        uint64                      `json:"synced"`            // 已经扫描到的区块
+}
+
+// Progress 返回 Raised / Goal
+func (c *Campaign) Progress() float64 {
+	if c.Goal.Sign() == 0 {
+		return 1
+	}
+	f, _ := new(big.Rat).SetFrac(c.Raised, c.Goal).Float64()
+	return f
+}
+
+// apply 按合约逻辑计入一笔 contribute：目标剩余额度以内的部分计入，超出的部分退回
+func (c *Campaign) apply(funder common.Address, value *big.Int, block uint64, hash common.Hash) Contribution {
+	remaining := new(big.Int).Sub(c.Goal, c.Raised)
+	if remaining.Sign() < 0 {
+		remaining.SetInt64(0)
+	}
+	accepted := new(big.Int).Set(value)
+	if accepted.Cmp(remaining) > 0 {
+		accepted.Set(remaining)
+	}
+	contribution := Contribution{
+		Funder:   funder,
+		Value:    value,
+		Accepted: accepted,
+		Refund:   new(big.Int).Sub(value, accepted),
+		Block:    block,
+		TxHash:   hash,
+	}
+	c.Raised = new(big.Int).Add(c.Raised, accepted)
+	total, ok := c.Funders[funder]
+	if !ok {
+		total = new(big.Int)
+	}
+	c.Funders[funder] = new(big.Int).Add(total, accepted)
+	c.Contributions = append(c.Contributions, contribution)
+	return contribution
+}
+
+// state 是服务的持久化状态，保存在一个 JSON 文件里
+type state struct {
+	Campaigns map[common.Address]*Campaign `json:"campaigns"`
+
+	path string
+}
+
+func loadState(path string) (*state, error) {
+	s := &state{Campaigns: map[common.Address]*Campaign{}, path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("crowdfund state %s: %w", path, err)
+	}
+	if s.Campaigns == nil {
+		s.Campaigns = map[common.Address]*Campaign{}
+	}
+	return s, nil
+}
+
+// save 先写临时文件再改名，避免写到一半退出把状态文件写坏
+func (s *state) save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(s.path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,198 @@
+package devnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"ethkit/artifact"
+	"ethkit/contracts/multicall3"
+	"ethkit/hdwallet"
+	"ethkit/simchain"
+	"store/deploy"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/node"
+)
+
+type Config struct {
+	Host      string
+	HTTPPort  int
+	WSPort    int
+	Mnemonic  string
+	Accounts  int
+	BlockTime time.Duration // 多久检查一次交易池，有交易就出块
+
+	// Deployments 是部署记录目录（store/deploy 格式，按链 ID 分文件），其他命令用 -deployments 从这里找合约地址。
+	// 模拟链每次启动都是同一个创世块、空的状态，所以重启后记录里的合约都会重新部署。
+	// 记录属于另一个创世块时（换了助记词或账户数，或者是同样用 1337 的 geth --dev 写的），
+	// Start 把它改名成 .bak 留作备份，从空记录开始
+	Deployments string
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Host:      "127.0.0.1",
+		HTTPPort:  8545,
+		WSPort:    8546,
+		Mnemonic:  hdwallet.DevMnemonic,
+		Accounts:  10,
+		BlockTime: time.Second,
+
+		Deployments: "deployments",
+	}
+}
+
+// Devnet 是对外暴露 HTTP/WS JSON-RPC 的本地模拟链
+type Devnet struct {
+	Chain    *simchain.Chain
+	cfg      Config
+	manifest *Manifest
+	deployer *deploy.Manager
+}
+
+// Start 由助记词派生账户并启动链，RPC 开放 eth/net/web3/txpool 模块
+func Start(cfg Config) (*Devnet, error) {
+	wallet, err := hdwallet.New(cfg.Mnemonic, "")
+	if err != nil {
+		return nil, err
+	}
+	keys, err := wallet.Accounts(cfg.Accounts)
+	if err != nil {
+		return nil, err
+	}
+	modules := []string{"eth", "net", "web3", "txpool"}
+	chain, err := simchain.NewWithKeys(keys, func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+		nodeConf.HTTPHost = cfg.Host
+		nodeConf.HTTPPort = cfg.HTTPPort
+		nodeConf.HTTPModules = modules
+		nodeConf.HTTPVirtualHosts = []string{"*"}
+		nodeConf.HTTPCors = []string{"*"}
+		nodeConf.WSHost = cfg.Host
+		nodeConf.WSPort = cfg.WSPort
+		nodeConf.WSModules = modules
+		nodeConf.WSOrigins = []string{"*"}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{
+		ChainID:   chain.ChainID.Uint64(),
+		HTTP:      fmt.Sprintf("http://%s:%d", cfg.Host, cfg.HTTPPort),
+		WS:        fmt.Sprintf("ws://%s:%d", cfg.Host, cfg.WSPort),
+		Contracts: map[string]Contract{},
+	}
+	// Multicall3 在创世块里，不用部署
+	m.Contracts["Multicall3"] = Contract{Address: multicall3.Address}
+	for _, a := range chain.Accounts {
+		m.Accounts = append(m.Accounts, Account{Address: a.Address, PrivateKey: crypto.FromECDSA(a.Key)})
+	}
+	deployer, err := deploy.NewManager(context.Background(), chain.Client, cfg.Deployments)
+	if errors.Is(err, deploy.ErrOtherChain) {
+		// 那条链上的合约在这里都不存在，记录留着也用不上
+		path := deploy.ManifestPath(cfg.Deployments, chain.ChainID.Uint64())
+		log.Printf("%v, moving it to %s.bak", err, path)
+		if err = os.Rename(path, path+".bak"); err == nil {
+			deployer, err = deploy.NewManager(context.Background(), chain.Client, cfg.Deployments)
+		}
+	}
+	if err != nil {
+		chain.Close()
+		return nil, err
+	}
+	deployer.WaitMined = func(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+		return chain.Mine(tx)
+	}
+	return &Devnet{Chain: chain, cfg: cfg, manifest: m, deployer: deployer}, nil
+}
+
+func (d *Devnet) Manifest() *Manifest {
+	return d.manifest
+}
+
+// DeployAll 按顺序用账户 0 部署清单里的合约。
+// 地址由部署账户的 nonce 决定，已有部署记录的链重启后按同样的顺序部署才能对上记录，新合约只能加在清单末尾
+func (d *Devnet) DeployAll(entries []Entry) error {
+	for _, e := range entries {
+		c, err := d.Deploy(e)
+		if err != nil {
+			return fmt.Errorf("deploy %s: %w", e.Name, err)
+		}
+		log.Printf("deployed %s at %s (block %d)", e.Name, c.Address.Hex(), c.Block)
+	}
+	return nil
+}
+
+func (d *Devnet) Deploy(e Entry) (Contract, error) {
+	a, err := artifact.Load(e.Artifact)
+	if err != nil {
+		return Contract{}, err
+	}
+	if !a.Deployable() {
+		return Contract{}, fmt.Errorf("%s has no bytecode", e.Artifact)
+	}
+	accounts := make([]common.Address, len(d.Chain.Accounts))
+	for i, acc := range d.Chain.Accounts {
+		accounts[i] = acc.Address
+	}
+	strs, err := stringArgs(e.Args, accounts)
+	if err != nil {
+		return Contract{}, err
+	}
+	args, err := artifact.ParseArgs(a.ABI.Constructor.Inputs, strs)
+	if err != nil {
+		return Contract{}, err
+	}
+
+	contract := deploy.Contract{Name: e.Name, ABI: string(a.RawABI), Bin: hexutil.Encode(a.Bin)}
+	if len(a.Runtime) > 0 {
+		contract.Runtime = hexutil.Encode(a.Runtime)
+	}
+	rec, _, err := d.deployer.Deploy(context.Background(), d.Chain.Transactor(0), contract, args...)
+	if err != nil {
+		return Contract{}, err
+	}
+	c := Contract{
+		Address:  rec.Address,
+		TxHash:   rec.TxHash,
+		Block:    rec.Block,
+		Artifact: e.Artifact,
+	}
+	d.manifest.Contracts[e.Name] = c
+	return c, nil
+}
+
+// Run 定时检查交易池，有待打包的交易就出块，直到 ctx 结束
+func (d *Devnet) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.cfg.BlockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			pending, err := d.Chain.Client.PendingTransactionCount(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			if pending > 0 {
+				d.Chain.Commit()
+			}
+		}
+	}
+}
+
+func (d *Devnet) Close() error {
+	return d.Chain.Close()
+}
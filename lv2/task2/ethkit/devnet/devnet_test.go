@@ -0,0 +1,121 @@
+package devnet
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"testing"
+
+	"ethkit/contracts/multicall3"
+	"store/deploy"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// 清单里的每个合约都要有编译好的字节码，并且能在本地链上部署
+func TestDeploySpec(t *testing.T) {
+	entries, err := LoadSpec("contracts.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := DefaultConfig()
+	cfg.Host = "" // 不开 HTTP/WS
+	cfg.Accounts = 3
+	cfg.Deployments = t.TempDir()
+	d, err := Start(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	if err := d.DeployAll(entries); err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		c, ok := d.Manifest().Contracts[e.Name]
+		if !ok {
+			t.Fatalf("%s not deployed", e.Name)
+		}
+		code, err := d.Chain.Client.CodeAt(context.Background(), c.Address, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(code) == 0 {
+			t.Fatalf("%s: no code at %s", e.Name, c.Address.Hex())
+		}
+	}
+	// Multicall3 不在清单里，创世块自带
+	mc, ok := d.Manifest().Contracts["Multicall3"]
+	if !ok || mc.Address != multicall3.Address {
+		t.Fatalf("Multicall3 in manifest: %+v", mc)
+	}
+	code, err := d.Chain.Client.CodeAt(context.Background(), mc.Address, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.Keccak256Hash(code) != multicall3.CodeHash {
+		t.Fatal("Multicall3 code is not the canonical one")
+	}
+}
+
+// 重启后按同一份记录重新部署；换了助记词就是另一条链，不能沿用这份记录
+func TestRestart(t *testing.T) {
+	entries, err := LoadSpec("contracts.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := DefaultConfig()
+	cfg.Host = ""
+	cfg.Accounts = 3
+	cfg.Deployments = t.TempDir()
+
+	var first Contract
+	for i := 0; i < 2; i++ {
+		d, err := Start(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := d.Deploy(entries[0])
+		d.Close()
+		if err != nil {
+			t.Fatalf("start %d: %v", i, err)
+		}
+		if i == 0 {
+			first = c
+		} else if c.Address != first.Address {
+			// 同一个创世块、同一个部署账户 nonce，地址不变
+			t.Fatalf("address after restart = %s, want %s", c.Address.Hex(), first.Address.Hex())
+		}
+	}
+
+	// 换了助记词就是另一个创世块：旧记录改名备份，新链从空记录开始部署
+	path := deploy.ManifestPath(cfg.Deployments, 1337)
+	old, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Mnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	d, err := Start(cfg)
+	if err != nil {
+		t.Fatalf("start with another mnemonic: %v", err)
+	}
+	defer d.Close()
+	if backup, err := os.ReadFile(path + ".bak"); err != nil || string(backup) != string(old) {
+		t.Fatalf("backup: %v", err)
+	}
+	if len(d.deployer.Manifest().Contracts) != 0 {
+		t.Fatalf("records of the old chain kept: %+v", d.deployer.Manifest().Contracts)
+	}
+	c, err := d.Deploy(entries[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := d.Chain.Client.HeaderByNumber(context.Background(), big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := deploy.LoadManifest(cfg.Deployments, 1337, genesis.Hash())
+	if err != nil || m.Contracts[entries[0].Name].Address != c.Address {
+		t.Fatalf("new records: %+v, %v", m, err)
+	}
+}
@@ -0,0 +1,60 @@
+package devnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Manifest 记录本地链的连接方式、账户和已部署合约，其他命令读取它来找合约地址
+type Manifest struct {
+	ChainID   uint64              `json:"chainId"`
+	HTTP      string              `json:"http"`
+	WS        string              `json:"ws"`
+	Accounts  []Account           `json:"accounts"`
+	Contracts map[string]Contract `json:"contracts"`
+}
+
+type Account struct {
+	Address    common.Address `json:"address"`
+	PrivateKey hexutil.Bytes  `json:"privateKey"` // 只用于本地链
+}
+
+type Contract struct {
+	Address  common.Address `json:"address"`
+	TxHash   common.Hash    `json:"txHash"`
+	Block    uint64         `json:"block"`
+	Artifact string         `json:"artifact"`
+}
+
+func (m *Manifest) Write(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("manifest %s: %w", path, err)
+	}
+	return &m, nil
+}
+
+// Address 按名字查合约地址
+func (m *Manifest) Address(name string) (common.Address, error) {
+	c, ok := m.Contracts[name]
+	if !ok {
+		return common.Address{}, fmt.Errorf("contract %s not in manifest", name)
+	}
+	return c.Address, nil
+}
@@ -0,0 +1,64 @@
+package devnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Entry 描述一个要在启动时部署的合约
+type Entry struct {
+	Name     string            `json:"name"`
+	Artifact string            `json:"artifact"`       // 相对 spec 文件所在目录
+	Args     []json.RawMessage `json:"args,omitempty"` // 构造参数，字符串里的 $accountN 会替换成第 N 个账户地址
+}
+
+// LoadSpec 读取部署清单，并把产物路径解析成相对当前工作目录的路径
+func LoadSpec(path string) ([]Entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("spec %s: %w", path, err)
+	}
+	dir := filepath.Dir(path)
+	for i := range entries {
+		if !filepath.IsAbs(entries[i].Artifact) {
+			entries[i].Artifact = filepath.Join(dir, entries[i].Artifact)
+		}
+	}
+	return entries, nil
+}
+
+var accountRef = regexp.MustCompile(`\$account(\d+)`)
+
+// stringArgs 把 JSON 参数转成字符串交给 artifact.ParseArgs，同时替换 $accountN
+func stringArgs(raw []json.RawMessage, accounts []common.Address) ([]string, error) {
+	args := make([]string, len(raw))
+	for i, r := range raw {
+		var s string
+		if json.Unmarshal(r, &s) != nil {
+			s = string(r)
+		}
+		var err error
+		args[i] = accountRef.ReplaceAllStringFunc(s, func(ref string) string {
+			n, _ := strconv.Atoi(ref[len("$account"):])
+			if n >= len(accounts) {
+				err = fmt.Errorf("%s: only %d accounts", ref, len(accounts))
+				return ref
+			}
+			return accounts[n].Hex()
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
@@ -0,0 +1,102 @@
+package hdwallet
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// DevMnemonic 是 hardhat/anvil 默认使用的助记词，派生出的账户在各种工具里都一样，只能用于本地开发
+const DevMnemonic = "test test test test test test test test test test test junk"
+
+var curveN = crypto.S256().Params().N
+
+// Wallet 是由 BIP-39 助记词得到的 BIP-32 主密钥
+type Wallet struct {
+	key       []byte
+	chainCode []byte
+}
+
+func New(mnemonic, passphrase string) (*Wallet, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	return &Wallet{key: sum[:32], chainCode: sum[32:]}, nil
+}
+
+// Derive 按路径派生私钥，比如 m/44'/60'/0'/0/0
+func (w *Wallet) Derive(path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	key, chainCode := w.key, w.chainCode
+	for _, index := range path {
+		var err error
+		key, chainCode, err = child(key, chainCode, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return crypto.ToECDSA(key)
+}
+
+// Account 派生以太坊默认路径 m/44'/60'/0'/0/i 上的私钥，和 MetaMask、hardhat 一致
+func (w *Wallet) Account(i uint32) (*ecdsa.PrivateKey, error) {
+	path := make(accounts.DerivationPath, len(accounts.DefaultBaseDerivationPath))
+	copy(path, accounts.DefaultBaseDerivationPath)
+	path[len(path)-1] = i
+	return w.Derive(path)
+}
+
+// Accounts 派生前 n 个默认路径上的私钥
+func (w *Wallet) Accounts(n int) ([]*ecdsa.PrivateKey, error) {
+	keys := make([]*ecdsa.PrivateKey, n)
+	for i := range keys {
+		key, err := w.Account(uint32(i))
+		if err != nil {
+			return nil, fmt.Errorf("hdwallet: account %d: %w", i, err)
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+// child 是 BIP-32 的 CKDpriv，index >= 0x80000000 为硬化派生
+func child(key, chainCode []byte, index uint32) ([]byte, []byte, error) {
+	data := make([]byte, 0, 37)
+	if index >= 0x80000000 {
+		data = append(data, 0)
+		data = append(data, key...)
+	} else {
+		priv, err := crypto.ToECDSA(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		data = append(data, crypto.CompressPubkey(&priv.PublicKey)...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(curveN) >= 0 {
+		return nil, nil, errors.New("hdwallet: invalid child key, try next index")
+	}
+	k := il.Add(il, new(big.Int).SetBytes(key))
+	k.Mod(k, curveN)
+	if k.Sign() == 0 {
+		return nil, nil, errors.New("hdwallet: invalid child key, try next index")
+	}
+	return k.FillBytes(make([]byte, 32)), sum[32:], nil
+}
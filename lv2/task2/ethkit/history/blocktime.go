@@ -0,0 +1,123 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrFutureTime 表示时间戳晚于最新区块
+var ErrFutureTime = errors.New("history: timestamp is after the latest block")
+
+// HeaderReader 是按区块号取区块头的接口
+type HeaderReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// BlockAtTime 查找时间戳 >= ts 的第一个区块，只查一次时用；多次查询用 BlockFinder 复用缓存
+func BlockAtTime(ctx context.Context, backend HeaderReader, ts uint64) (*types.Header, error) {
+	return NewBlockFinder(backend, 0).Find(ctx, ts)
+}
+
+// BlockFinder 按时间戳找区块。先按时间插值猜区块号，出块间隔不均匀（比如 PoW 时期、
+// 链停过一段时间）插值收敛慢时改用二分，最多 MaxSteps 次请求。取过的区块头会缓存，
+// 连续查一串时间点（比如每天零点）时后面的查询大多命中缓存
+type BlockFinder struct {
+	MaxSteps int
+
+	backend HeaderReader
+
+	mu      sync.Mutex
+	size    int
+	headers map[uint64]*types.Header
+	order   []uint64 // 按加入顺序淘汰
+}
+
+// NewBlockFinder 创建查找器，cacheSize 是缓存的区块头数量，<= 0 时用 1024
+func NewBlockFinder(backend HeaderReader, cacheSize int) *BlockFinder {
+	if cacheSize <= 0 {
+		cacheSize = 1024
+	}
+	return &BlockFinder{MaxSteps: 128, backend: backend, size: cacheSize, headers: map[uint64]*types.Header{}}
+}
+
+// Find 返回时间戳 >= ts 的第一个区块头，ts 晚于最新区块时返回 ErrFutureTime
+func (f *BlockFinder) Find(ctx context.Context, ts uint64) (*types.Header, error) {
+	// 最新区块一直在变，不走缓存
+	head, err := f.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if head.Time < ts {
+		return nil, fmt.Errorf("%w: latest block %d has time %d, want %d", ErrFutureTime, head.Number, head.Time, ts)
+	}
+	lo, err := f.header(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	if lo.Time >= ts {
+		return lo, nil
+	}
+
+	// 不变式：lo.Time < ts <= hi.Time
+	hi := head
+	bisect := false
+	for steps := 0; hi.Number.Uint64()-lo.Number.Uint64() > 1; steps++ {
+		if steps >= f.MaxSteps {
+			return nil, fmt.Errorf("history: no block found for time %d within %d steps", ts, f.MaxSteps)
+		}
+		l, h := lo.Number.Uint64(), hi.Number.Uint64()
+		guess := l + (h-l)/2
+		if !bisect {
+			frac := float64(ts-lo.Time) / float64(hi.Time-lo.Time)
+			guess = l + uint64(frac*float64(h-l))
+			guess = max(l+1, min(guess, h-1))
+		}
+		mid, err := f.header(ctx, guess)
+		if err != nil {
+			return nil, err
+		}
+		if mid.Time >= ts {
+			hi = mid
+		} else {
+			lo = mid
+		}
+		// 插值一步没把区间缩小到一半以下，说明出块间隔不均匀，下一步改用二分；二分之后再试插值
+		bisect = !bisect && hi.Number.Uint64()-lo.Number.Uint64() > (h-l)/2
+	}
+	return hi, nil
+}
+
+func (f *BlockFinder) header(ctx context.Context, n uint64) (*types.Header, error) {
+	f.mu.Lock()
+	h, ok := f.headers[n]
+	f.mu.Unlock()
+	if ok {
+		return h, nil
+	}
+	h, err := f.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+	if err != nil {
+		return nil, err
+	}
+	f.put(h)
+	return h, nil
+}
+
+func (f *BlockFinder) put(h *types.Header) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := h.Number.Uint64()
+	if _, ok := f.headers[n]; ok {
+		return
+	}
+	if len(f.order) >= f.size {
+		delete(f.headers, f.order[0])
+		f.order = f.order[1:]
+	}
+	f.headers[n] = h
+	f.order = append(f.order, n)
+}
@@ -0,0 +1,205 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// countingReader 记录每次按区块号取区块头的请求，nil 是最新区块
+type countingReader struct {
+	HeaderReader
+
+	mu    sync.Mutex
+	calls []*big.Int
+}
+
+func (r *countingReader) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	r.mu.Lock()
+	r.calls = append(r.calls, number)
+	r.mu.Unlock()
+	return r.HeaderReader.HeaderByNumber(ctx, number)
+}
+
+func (r *countingReader) reset() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := len(r.calls)
+	r.calls = nil
+	return n
+}
+
+// irregularChain 出块间隔忽大忽小：连续的 1 秒区块之间夹着一小时、一天的停顿，返回每个区块的时间
+func irregularChain(t *testing.T) (*simchain.Chain, []uint64) {
+	t.Helper()
+	chain, err := simchain.New(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	for _, gap := range []time.Duration{0, time.Hour, 0, 24 * time.Hour, 0, 0, 90 * time.Second} {
+		for range 5 {
+			chain.Commit()
+		}
+		if gap > 0 {
+			if err := chain.Backend.AdjustTime(gap); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	ctx := context.Background()
+	head, err := chain.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	times := make([]uint64, head.Number.Uint64()+1)
+	for n := range times {
+		h, err := chain.Client.HeaderByNumber(ctx, big.NewInt(int64(n)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		times[n] = h.Time
+	}
+	return chain, times
+}
+
+// firstAt 线性扫描出时间戳 >= ts 的第一个区块，作为期望结果
+func firstAt(times []uint64, ts uint64) uint64 {
+	for n, tm := range times {
+		if tm >= ts {
+			return uint64(n)
+		}
+	}
+	panic("ts after the last block")
+}
+
+func TestBlockFinderFind(t *testing.T) {
+	chain, times := irregularChain(t)
+	ctx := context.Background()
+	f := NewBlockFinder(chain.Client, 0)
+
+	// 每个区块的时间戳正好命中这个区块，前一秒落在它和上一个区块之间（停顿里的时间也找到停顿后的第一个区块）
+	probes := []uint64{0, 1, times[0]}
+	for n := 1; n < len(times); n++ {
+		probes = append(probes, times[n], times[n]-1, times[n-1]+1, (times[n-1]+times[n])/2)
+	}
+	for _, ts := range probes {
+		h, err := f.Find(ctx, ts)
+		if err != nil {
+			t.Fatalf("find %d: %v", ts, err)
+		}
+		if want := firstAt(times, ts); h.Number.Uint64() != want {
+			t.Fatalf("find %d = block %d (time %d), want %d (time %d)", ts, h.Number, h.Time, want, times[want])
+		}
+	}
+
+	// 早于创世块的时间返回创世块
+	if h, err := BlockAtTime(ctx, chain.Client, 0); err != nil || h.Number.Sign() != 0 {
+		t.Fatalf("before genesis: %v, %v", h.Number, err)
+	}
+
+	head := times[len(times)-1]
+	if h, err := f.Find(ctx, head); err != nil || h.Number.Uint64() != uint64(len(times)-1) {
+		t.Fatalf("latest: %v, %v", h, err)
+	}
+	_, err := f.Find(ctx, head+1)
+	if !errors.Is(err, ErrFutureTime) {
+		t.Fatalf("future: %v", err)
+	}
+}
+
+// 重复查询命中缓存，只请求最新区块；缓存满了按加入顺序淘汰
+func TestBlockFinderCache(t *testing.T) {
+	chain, times := irregularChain(t)
+	ctx := context.Background()
+	r := &countingReader{HeaderReader: chain.Client}
+	f := NewBlockFinder(r, 0)
+
+	ts := times[len(times)/2] - 1
+	if _, err := f.Find(ctx, ts); err != nil {
+		t.Fatal(err)
+	}
+	if n := r.reset(); n < 3 {
+		t.Fatalf("first find made %d requests", n)
+	}
+	if _, err := f.Find(ctx, ts); err != nil {
+		t.Fatal(err)
+	}
+	if n := r.reset(); n != 1 {
+		t.Fatalf("repeated find made %d requests, want 1", n)
+	}
+	// 附近的时间点大多用缓存里的区块头
+	if _, err := f.Find(ctx, ts+1); err != nil {
+		t.Fatal(err)
+	}
+	if n := r.reset(); n > 2 {
+		t.Fatalf("nearby find made %d requests", n)
+	}
+
+	small := NewBlockFinder(r, 2)
+	for _, n := range []uint64{0, 1, 2} {
+		if _, err := small.header(ctx, n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(small.headers) != 2 || small.headers[0] != nil {
+		t.Fatalf("cache holds %v", small.order)
+	}
+}
+
+// 插值和二分交替，请求次数按区块数的对数增长；MaxSteps 限制请求次数
+func TestBlockFinderSteps(t *testing.T) {
+	// 前一半区块间隔 1 秒，中间停了一年，后一半间隔 12 秒
+	const n = 1 << 20
+	headers := func(number uint64) *types.Header {
+		tm := number
+		if number >= n/2 {
+			tm = n/2 + 365*24*3600 + (number-n/2)*12
+		}
+		return &types.Header{Number: new(big.Int).SetUint64(number), Time: tm}
+	}
+	r := &countingReader{HeaderReader: headerFunc(func(number *big.Int) *types.Header {
+		if number == nil {
+			return headers(n - 1)
+		}
+		return headers(number.Uint64())
+	})}
+	ctx := context.Background()
+
+	for _, block := range []uint64{1, 1000, n/2 - 1, n / 2, n/2 + 1, n/2 + 777, n - 2} {
+		f := NewBlockFinder(r, 0)
+		ts := headers(block).Time
+		h, err := f.Find(ctx, ts)
+		if err != nil || h.Number.Uint64() != block {
+			t.Fatalf("find block %d: %v, %v", block, h, err)
+		}
+		// 最新区块、创世块，加上每个区块号最多两步（一次插值一次二分）
+		if calls := r.reset(); calls > 2+2*20 {
+			t.Fatalf("find block %d made %d requests", block, calls)
+		}
+	}
+
+	f := NewBlockFinder(r, 0)
+	f.MaxSteps = 3
+	_, err := f.Find(ctx, headers(n/2+777).Time)
+	if err == nil || !strings.Contains(err.Error(), "within 3 steps") {
+		t.Fatalf("max steps: %v", err)
+	}
+	if calls := r.reset(); calls != 2+3 {
+		t.Fatalf("max steps made %d requests, want 5", calls)
+	}
+}
+
+type headerFunc func(number *big.Int) *types.Header
+
+func (f headerFunc) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return f(number), nil
+}
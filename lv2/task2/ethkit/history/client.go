@@ -0,0 +1,184 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ethkit/contracts/token"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Backend 是历史查询需要的链接口，*ethclient.Client 和 simulated.Client 都满足
+type Backend interface {
+	LedgerBackend
+	bind.ContractCaller
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Ref 指定一个区块：按区块号、区块哈希或时间戳（取该时间之后的第一个区块），都为空时是最新区块。
+// Time 是指针，@0 这样的零时间戳也是按时间查
+type Ref struct {
+	Number *big.Int
+	Hash   common.Hash
+	Time   *uint64
+}
+
+// ParseRef 解析区块的文本写法：latest、区块号（十进制或 0x）、66 位的区块哈希、
+// RFC 3339 时间（2024-01-01T00:00:00Z）或 @ 加 Unix 秒
+func ParseRef(s string) (Ref, error) {
+	switch {
+	case s == "" || s == "latest":
+		return Ref{}, nil
+	case strings.HasPrefix(s, "@"):
+		ts, err := strconv.ParseUint(s[1:], 10, 64)
+		if err != nil {
+			return Ref{}, fmt.Errorf("invalid timestamp %q", s)
+		}
+		return Ref{Time: &ts}, nil
+	case len(s) == 66 && strings.HasPrefix(s, "0x"):
+		return Ref{Hash: common.HexToHash(s)}, nil
+	}
+	if n, err := strconv.ParseUint(s, 0, 64); err == nil {
+		return Ref{Number: new(big.Int).SetUint64(n)}, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return Ref{}, fmt.Errorf("invalid block %q: want a number, hash, RFC 3339 time or @unix", s)
+	}
+	if t.Unix() < 0 {
+		return Ref{}, fmt.Errorf("invalid block %q: time before 1970", s)
+	}
+	ts := uint64(t.Unix())
+	return Ref{Time: &ts}, nil
+}
+
+// Balance 是一次历史余额查询的结果，Source 说明数据来自节点状态（state）还是本地账本推算（ledger）
+type Balance struct {
+	Account common.Address `json:"account"`
+	Token   common.Address `json:"token"` // 零地址为 ETH
+	Value   *big.Int       `json:"value"`
+	Block   uint64         `json:"block"`
+	Hash    common.Hash    `json:"hash"`
+	Time    uint64         `json:"time"`
+	Source  string         `json:"source"`
+}
+
+// Client 按区块号、哈希或时间查询历史余额。节点状态被裁剪时返回 *PrunedError，
+// 配置了 Ledger 且覆盖到这个区块时改用账本推算
+type Client struct {
+	Ledger *Ledger
+
+	backend Backend
+	finder  *BlockFinder
+
+	mu      sync.Mutex
+	archive *bool
+}
+
+func New(backend Backend) *Client {
+	return &Client{backend: backend, finder: NewBlockFinder(backend, 0)}
+}
+
+// Archive 检查节点是否保留全部历史状态：查区块 1 的余额，能查到就认为是归档节点。
+// 链上区块数不超过 128 时全节点也保留全部状态，同样返回 true。结果会缓存
+func (c *Client) Archive(ctx context.Context) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.archive != nil {
+		return *c.archive, nil
+	}
+	_, err := c.backend.BalanceAt(ctx, common.Address{}, big.NewInt(1))
+	if err != nil && !IsPruned(err) {
+		return false, err
+	}
+	ok := err == nil
+	c.archive = &ok
+	return ok, nil
+}
+
+// Header 取 ref 指定的区块头
+func (c *Client) Header(ctx context.Context, ref Ref) (*types.Header, error) {
+	switch {
+	case ref.Hash != (common.Hash{}):
+		return c.backend.HeaderByHash(ctx, ref.Hash)
+	case ref.Time != nil:
+		return c.finder.Find(ctx, *ref.Time)
+	default:
+		return c.backend.HeaderByNumber(ctx, ref.Number)
+	}
+}
+
+// BalanceAt 查询 account 在 ref 区块的 ETH 余额
+func (c *Client) BalanceAt(ctx context.Context, account common.Address, ref Ref) (*Balance, error) {
+	return c.balance(ctx, account, common.Address{}, ref)
+}
+
+// TokenBalanceAt 查询 account 在 ref 区块的 ERC-20 余额
+func (c *Client) TokenBalanceAt(ctx context.Context, tokenAddr, account common.Address, ref Ref) (*Balance, error) {
+	return c.balance(ctx, account, tokenAddr, ref)
+}
+
+func (c *Client) balance(ctx context.Context, account, tokenAddr common.Address, ref Ref) (*Balance, error) {
+	head, err := c.Header(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	b := &Balance{Account: account, Token: tokenAddr, Block: head.Number.Uint64(), Hash: head.Hash(), Time: head.Time, Source: "state"}
+	b.Value, err = c.stateBalance(ctx, account, tokenAddr, head.Number)
+	if err == nil || !IsPruned(err) || c.Ledger == nil {
+		return b, err
+	}
+	pruned := err
+	if b.Value, err = c.reconstruct(ctx, account, tokenAddr, b.Block); err != nil {
+		return nil, fmt.Errorf("%w (ledger: %w)", pruned, err)
+	}
+	b.Source = "ledger"
+	return b, nil
+}
+
+func (c *Client) stateBalance(ctx context.Context, account, tokenAddr common.Address, number *big.Int) (*big.Int, error) {
+	if tokenAddr == (common.Address{}) {
+		v, err := c.backend.BalanceAt(ctx, account, number)
+		return v, classify(err, number.Uint64())
+	}
+	caller, err := token.NewTokenCaller(tokenAddr, c.backend)
+	if err != nil {
+		return nil, err
+	}
+	v, err := caller.BalanceOf(&bind.CallOpts{Context: ctx, BlockNumber: number}, account)
+	return v, classify(err, number.Uint64())
+}
+
+// reconstruct 把账本同步到最新区块，用最新余额减去 (block, head] 的净变化
+func (c *Client) reconstruct(ctx context.Context, account, tokenAddr common.Address, block uint64) (*big.Int, error) {
+	head, err := c.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Ledger.Sync(ctx, c.backend, head.Number.Uint64()); err != nil {
+		return nil, err
+	}
+	latest, err := c.stateBalance(ctx, account, tokenAddr, head.Number)
+	if err != nil {
+		return nil, err
+	}
+	net, err := c.Ledger.Net(account, tokenAddr, block, head.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	v := new(big.Int).Sub(latest, net)
+	if v.Sign() < 0 {
+		// 账本漏了流入（比如合约内部转账），推算结果不可信
+		return nil, errors.New("reconstructed balance is negative, the ledger misses transfers")
+	}
+	return v, nil
+}
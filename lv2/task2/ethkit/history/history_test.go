@@ -0,0 +1,447 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"ethkit/contracts/token"
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+func TestParseRef(t *testing.T) {
+	hash := "0x" + strings.Repeat("ab", 32)
+	tests := []struct {
+		in     string
+		number int64 // -1 表示不是按区块号
+		hash   string
+		time   int64 // -1 表示不是按时间
+		err    string
+	}{
+		{in: "", number: -1, time: -1},
+		{in: "latest", number: -1, time: -1},
+		{in: "0", number: 0, time: -1},
+		{in: "12345", number: 12345, time: -1},
+		{in: "0x10", number: 16, time: -1},
+		{in: hash, number: -1, hash: hash, time: -1},
+		{in: "@0", number: -1, time: 0},
+		{in: "@1700000000", number: -1, time: 1700000000},
+		{in: "2024-01-01T00:00:00Z", number: -1, time: 1704067200},
+		{in: "2024-01-01T08:00:00+08:00", number: -1, time: 1704067200},
+		{in: "1970-01-01T00:00:00Z", number: -1, time: 0},
+		{in: "@-1", err: `invalid timestamp "@-1"`},
+		{in: "@now", err: `invalid timestamp "@now"`},
+		{in: "1969-12-31T23:59:59Z", err: "time before 1970"},
+		{in: "yesterday", err: "want a number, hash, RFC 3339 time or @unix"},
+		{in: "-1", err: "want a number, hash, RFC 3339 time or @unix"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			ref, err := ParseRef(tt.in)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (tt.number < 0) != (ref.Number == nil) || (ref.Number != nil && ref.Number.Int64() != tt.number) {
+				t.Fatalf("number = %v, want %d", ref.Number, tt.number)
+			}
+			if want := common.HexToHash(tt.hash); tt.hash != "" && ref.Hash != want || tt.hash == "" && ref.Hash != (common.Hash{}) {
+				t.Fatalf("hash = %s, want %s", ref.Hash.Hex(), tt.hash)
+			}
+			if (tt.time < 0) != (ref.Time == nil) || (ref.Time != nil && *ref.Time != uint64(tt.time)) {
+				t.Fatalf("time = %v, want %d", ref.Time, tt.time)
+			}
+		})
+	}
+}
+
+// nodeError 模拟节点返回的 JSON-RPC 错误，ethclient 收到的就是这样只有错误码和原文的错误
+type nodeError struct {
+	msg string
+}
+
+func (e *nodeError) Error() string  { return e.msg }
+func (e *nodeError) ErrorCode() int { return -32000 }
+
+// missingTrieNode 是 geth path 模式下查已删除状态时的错误原文
+func missingTrieNode(root common.Hash) error {
+	return &nodeError{msg: fmt.Sprintf("missing trie node %x (path ) state %#x is not available, not found", root, root)}
+}
+
+func TestIsPruned(t *testing.T) {
+	root := common.HexToHash("0x0102")
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"geth path scheme", missingTrieNode(root), true},
+		{"geth hash scheme", &nodeError{msg: fmt.Sprintf("missing trie node %x (path ) <nil>", root)}, true},
+		{"geth trace reexec", &nodeError{msg: "required historical state unavailable (reexec=128)"}, true},
+		{"geth trace path scheme", &nodeError{msg: "historical state not available in path scheme yet"}, true},
+		{"erigon", &nodeError{msg: "old data not available due to pruning"}, true},
+		{"wrapped rpc error", fmt.Errorf("balance: %w", missingTrieNode(root)), true},
+		{"pruned error", &PrunedError{Block: 1}, true},
+		{"wrapped pruned error", fmt.Errorf("query: %w", &PrunedError{Block: 1, Err: errors.New("x")}), true},
+		{"header not found", &nodeError{msg: "header not found"}, false},
+		{"revert", &nodeError{msg: "execution reverted"}, false},
+		{"mentions pruned", &nodeError{msg: "request pruned by rate limiter"}, false},
+		{"missing trie node without hash", &nodeError{msg: "missing trie node"}, false},
+		{"message not at start", errors.New("proxy: missing trie node " + strings.Repeat("0", 64) + " (path )"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPruned(tt.err); got != tt.want {
+				t.Fatalf("IsPruned(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+// pruning 模拟只保留区块 cutoff 之后状态的全节点，其他调用交给 simulated 节点
+type pruning struct {
+	simulated.Client
+	cutoff uint64
+	fail   error // 不为空时 BalanceAt 返回这个错误
+
+	mu       sync.Mutex
+	balances int
+	filters  [][2]uint64
+	onFilter func(from, to uint64) error
+}
+
+func (p *pruning) pruned(number *big.Int) bool {
+	return number != nil && number.Uint64() < p.cutoff
+}
+
+func (p *pruning) BalanceAt(ctx context.Context, account common.Address, number *big.Int) (*big.Int, error) {
+	p.mu.Lock()
+	p.balances++
+	p.mu.Unlock()
+	if p.fail != nil {
+		return nil, p.fail
+	}
+	if p.pruned(number) {
+		return nil, missingTrieNode(common.BigToHash(number))
+	}
+	return p.Client.BalanceAt(ctx, account, number)
+}
+
+func (p *pruning) CallContract(ctx context.Context, msg ethereum.CallMsg, number *big.Int) ([]byte, error) {
+	if p.pruned(number) {
+		return nil, missingTrieNode(common.BigToHash(number))
+	}
+	return p.Client.CallContract(ctx, msg, number)
+}
+
+func (p *pruning) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	p.mu.Lock()
+	p.filters = append(p.filters, [2]uint64{from, to})
+	hook := p.onFilter
+	p.mu.Unlock()
+	if hook != nil {
+		if err := hook(from, to); err != nil {
+			return nil, err
+		}
+	}
+	return p.Client.FilterLogs(ctx, q)
+}
+
+func TestArchive(t *testing.T) {
+	chain, err := simchain.New(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	chain.Commit()
+	chain.Commit()
+	ctx := context.Background()
+
+	full := &pruning{Client: chain.Client, cutoff: 2}
+	c := New(full)
+	for range 2 {
+		ok, err := c.Archive(ctx)
+		if err != nil || ok {
+			t.Fatalf("archive = %v, %v; want false", ok, err)
+		}
+	}
+	if full.balances != 1 {
+		t.Fatalf("%d BalanceAt calls, want 1 (cached)", full.balances)
+	}
+
+	if ok, err := New(&pruning{Client: chain.Client}).Archive(ctx); err != nil || !ok {
+		t.Fatalf("archive node = %v, %v", ok, err)
+	}
+
+	// 网络错误不缓存，下次重新检查
+	down := &pruning{Client: chain.Client, fail: errors.New("dial tcp: connection refused")}
+	c = New(down)
+	for range 2 {
+		if _, err := c.Archive(ctx); err == nil {
+			t.Fatal("network error ignored")
+		}
+	}
+	if down.balances != 2 {
+		t.Fatalf("%d BalanceAt calls, want 2", down.balances)
+	}
+}
+
+// ledgerChain 在链上做一串 ETH 和代币转账，包括一笔失败的交易，
+// 返回每个区块上两个账户的真实余额：[区块][账户]{ETH, 代币}
+func ledgerChain(t *testing.T) (*simchain.Chain, common.Address, [][2][2]*big.Int) {
+	t.Helper()
+	chain, err := simchain.New(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	addr, tok, _, err := chain.DeployToken(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a0, a1 := chain.Accounts[0].Address, chain.Accounts[1].Address
+	mine := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := chain.Mine(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := chain.Transfer(0, a1, big.NewInt(1e18)); err != nil {
+		t.Fatal(err)
+	}
+	mine(tok.Transfer(chain.Transactor(0), a1, big.NewInt(500)))
+	mine(tok.Transfer(chain.Transactor(1), a0, big.NewInt(200)))
+	if _, err := chain.Transfer(1, chain.Accounts[2].Address, big.NewInt(3e17)); err != nil {
+		t.Fatal(err)
+	}
+	// 余额不够的转账：固定 GasLimit 跳过估算，上链后失败，只扣手续费
+	auth := chain.Transactor(1)
+	auth.GasLimit = 100000
+	if _, err := tok.Transfer(auth, a0, big.NewInt(1000)); err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+	// 账户 2 转进来的 ETH 和代币
+	if _, err := chain.Transfer(2, a1, big.NewInt(1e17)); err != nil {
+		t.Fatal(err)
+	}
+	mine(tok.Transfer(chain.Transactor(0), chain.Accounts[2].Address, big.NewInt(50)))
+
+	ctx := context.Background()
+	head, err := chain.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caller, err := token.NewTokenCaller(addr, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make([][2][2]*big.Int, head.Number.Uint64()+1)
+	for n := range want {
+		number := big.NewInt(int64(n))
+		for i, a := range []common.Address{a0, a1} {
+			eth, err := chain.Client.BalanceAt(ctx, a, number)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tokens, err := caller.BalanceOf(&bind.CallOpts{BlockNumber: number}, a)
+			if errors.Is(err, bind.ErrNoCode) {
+				tokens, err = new(big.Int), nil // 代币合约还没部署
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			want[n][i] = [2]*big.Int{eth, tokens}
+		}
+	}
+	return chain, addr, want
+}
+
+// 状态被裁剪时用账本推算：最新余额减去之后的净变化，和归档状态里的真实余额一致
+func TestLedgerReconstruct(t *testing.T) {
+	chain, tokenAddr, want := ledgerChain(t)
+	ctx := context.Background()
+	head := uint64(len(want) - 1)
+	accounts := []common.Address{chain.Accounts[0].Address, chain.Accounts[1].Address}
+	backend := &pruning{Client: chain.Client, cutoff: head}
+
+	c := New(backend)
+	if _, err := c.BalanceAt(ctx, accounts[0], Ref{Number: big.NewInt(1)}); !IsPruned(err) {
+		t.Fatalf("without ledger: %v", err)
+	} else if pruned := new(PrunedError); !errors.As(err, &pruned) || pruned.Block != 1 {
+		t.Fatalf("pruned error %+v", pruned)
+	}
+
+	path := filepath.Join(t.TempDir(), "ledger.json")
+	l, err := OpenLedger(path, accounts, []common.Address{tokenAddr}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Ledger = l
+	for n := uint64(0); n <= head; n++ {
+		for i, a := range accounts {
+			ref := Ref{Number: new(big.Int).SetUint64(n)}
+			source := "ledger"
+			if n == head {
+				source = "state"
+			}
+			eth, err := c.BalanceAt(ctx, a, ref)
+			if err != nil {
+				t.Fatalf("block %d account %d: %v", n, i, err)
+			}
+			if eth.Value.Cmp(want[n][i][0]) != 0 || eth.Source != source || eth.Block != n {
+				t.Fatalf("block %d account %d: ETH %v from %s, want %v from %s", n, i, eth.Value, eth.Source, want[n][i][0], source)
+			}
+			tok, err := c.TokenBalanceAt(ctx, tokenAddr, a, ref)
+			if err != nil {
+				t.Fatalf("block %d account %d token: %v", n, i, err)
+			}
+			if tok.Value.Cmp(want[n][i][1]) != 0 || tok.Source != source {
+				t.Fatalf("block %d account %d: token %v from %s, want %v", n, i, tok.Value, tok.Source, want[n][i][1])
+			}
+		}
+	}
+
+	// 账本写到文件里，重新打开接着用；跟踪的账户不同时拒绝
+	reopened, err := OpenLedger(path, nil, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from, next := reopened.Covered(); from != 0 || next != head+1 || len(reopened.Records) != len(l.Records) {
+		t.Fatalf("reopened [%d, %d) with %d records, want [0, %d) with %d", from, next, len(reopened.Records), head+1, len(l.Records))
+	}
+	if _, err := OpenLedger(path, accounts[:1], nil, 0); err == nil {
+		t.Fatal("opened a ledger with different accounts")
+	}
+}
+
+func TestLedgerNotCovered(t *testing.T) {
+	chain, tokenAddr, want := ledgerChain(t)
+	ctx := context.Background()
+	head := uint64(len(want) - 1)
+	a0 := chain.Accounts[0].Address
+	c := New(&pruning{Client: chain.Client, cutoff: head})
+
+	// 账本从区块 3 开始，更早的区块推算不了
+	l, err := OpenLedger("", []common.Address{a0}, nil, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Ledger = l
+	_, err = c.BalanceAt(ctx, a0, Ref{Number: big.NewInt(1)})
+	if !IsPruned(err) || !errors.Is(err, ErrNotCovered) {
+		t.Fatalf("before the ledger: %v", err)
+	}
+	if _, err := c.BalanceAt(ctx, a0, Ref{Number: big.NewInt(3)}); err != nil {
+		t.Fatalf("ledger start: %v", err)
+	}
+	// 没有跟踪的账户和代币
+	if _, err := c.BalanceAt(ctx, chain.Accounts[1].Address, Ref{Number: big.NewInt(3)}); !errors.Is(err, ErrNotCovered) {
+		t.Fatalf("untracked account: %v", err)
+	}
+	if _, err := c.TokenBalanceAt(ctx, tokenAddr, a0, Ref{Number: big.NewInt(3)}); !errors.Is(err, ErrNotCovered) {
+		t.Fatalf("untracked token: %v", err)
+	}
+
+	// 账本里记了一笔不存在的大额流入，推算出负数时报错而不是返回错误的余额
+	c.Ledger = &Ledger{Accounts: []common.Address{a0}, Next: head + 1, Records: []Transfer{
+		{From: common.Address{1}, To: a0, Value: new(big.Int).Lsh(big.NewInt(1), 100), Block: 2},
+	}}
+	if _, err := c.BalanceAt(ctx, a0, Ref{Number: big.NewInt(1)}); err == nil || !strings.Contains(err.Error(), "reconstructed balance is negative") {
+		t.Fatalf("negative balance: %v", err)
+	}
+}
+
+// 日志按 logPage 分段查询，每段保存一次进度；访问节点时账本的锁是放开的
+func TestLedgerSyncPages(t *testing.T) {
+	chain, tokenAddr, want := ledgerChain(t)
+	ctx := context.Background()
+	head := uint64(len(want) - 1)
+	defer func(n uint64) { logPage = n }(logPage)
+	logPage = 2
+
+	path := filepath.Join(t.TempDir(), "ledger.json")
+	l, err := OpenLedger(path, []common.Address{chain.Accounts[0].Address}, []common.Address{tokenAddr}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	failAt := uint64(4)
+	backend := &pruning{Client: chain.Client}
+	backend.onFilter = func(from, to uint64) error {
+		done := make(chan struct{})
+		go func() {
+			l.Covered()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Error("ledger is locked while querying logs")
+		}
+		if from == failAt {
+			return errors.New("query timeout")
+		}
+		return nil
+	}
+
+	// 第三段出错：前两段的进度已经写到文件里
+	if err := l.Sync(ctx, backend, head); err == nil || err.Error() != "query timeout" {
+		t.Fatalf("sync: %v", err)
+	}
+	saved, err := OpenLedger(path, nil, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, next := saved.Covered(); next != failAt {
+		t.Fatalf("saved next = %d, want %d", next, failAt)
+	}
+
+	failAt = head + 1
+	backend.filters = nil
+	if err := l.Sync(ctx, backend, head); err != nil {
+		t.Fatal(err)
+	}
+	var pages []string
+	for _, f := range backend.filters {
+		pages = append(pages, fmt.Sprintf("%d-%d", f[0], f[1]))
+	}
+	// 从上次保存的区块 4 接着同步
+	var expect []string
+	for from := uint64(4); from <= head; from += 2 {
+		page := fmt.Sprintf("%d-%d", from, min(from+1, head))
+		expect = append(expect, page, page) // 转出和转入各查一次
+	}
+	if strings.Join(pages, " ") != strings.Join(expect, " ") {
+		t.Fatalf("pages %v, want %v", pages, expect)
+	}
+	if _, next := l.Covered(); next != head+1 {
+		t.Fatalf("next = %d, want %d", next, head+1)
+	}
+	// 已经同步到 head，再次调用不访问节点
+	backend.filters = nil
+	if err := l.Sync(ctx, backend, head); err != nil || len(backend.filters) != 0 {
+		t.Fatalf("resync: %v, %d queries", err, len(backend.filters))
+	}
+}
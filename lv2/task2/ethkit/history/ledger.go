@@ -0,0 +1,271 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// ErrNotCovered 表示本地账本没有覆盖到要推算的区块
+var ErrNotCovered = errors.New("history: block is outside the synced ledger range")
+
+// Transfer 是一条影响余额的记录。Token 为零地址时是 ETH 转账，Fee 是 From 付的手续费
+// （失败的交易只有手续费，Value 为 0）
+type Transfer struct {
+	Token  common.Address `json:"token"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *big.Int       `json:"value"`
+	Fee    *big.Int       `json:"fee,omitempty"`
+	Block  uint64         `json:"block"`
+	TxHash common.Hash    `json:"txHash"`
+}
+
+// LedgerBackend 是同步账本需要的链接口
+type LedgerBackend interface {
+	ethereum.ChainReader
+	ethereum.LogFilterer
+	ethereum.TransactionReader
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// Ledger 是本地同步的转账记录，覆盖区块 [From, Next)。
+// 没有归档节点时，用最近区块的余额减去之后的净流入就能推算历史余额。
+// ETH 只记录交易层面的转账和手续费：合约内部转账、出块奖励、提款都不在里面
+type Ledger struct {
+	Accounts []common.Address `json:"accounts"`
+	Tokens   []common.Address `json:"tokens"`
+	From     uint64           `json:"from"`
+	Next     uint64           `json:"next"` // 下一个要同步的区块
+	Records  []Transfer       `json:"records"`
+
+	mu     sync.RWMutex
+	syncMu sync.Mutex // 同一时间只有一个 Sync
+	path   string
+}
+
+// OpenLedger 读取账本文件，不存在时新建一个从区块 from 开始的账本。
+// accounts 和 tokens 都为空时沿用文件里的列表；否则和已有账本不同时报错，避免漏记的记录被当成完整的
+func OpenLedger(path string, accounts, tokens []common.Address, from uint64) (*Ledger, error) {
+	l := &Ledger{Accounts: accounts, Tokens: tokens, From: from, Next: from, path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var saved Ledger
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("ledger %s: %w", path, err)
+	}
+	if (len(accounts) > 0 || len(tokens) > 0) && (!sameSet(saved.Accounts, accounts) || !sameSet(saved.Tokens, tokens)) {
+		return nil, fmt.Errorf("ledger %s tracks different accounts or tokens, use another file", path)
+	}
+	saved.path = path
+	return &saved, nil
+}
+
+func sameSet(a, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := map[common.Address]bool{}
+	for _, x := range a {
+		set[x] = true
+	}
+	for _, x := range b {
+		if !set[x] {
+			return false
+		}
+	}
+	return true
+}
+
+// Covered 返回账本覆盖的区块范围 [from, next)
+func (l *Ledger) Covered() (from, next uint64) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.From, l.Next
+}
+
+func (l *Ledger) tracked(addr common.Address) bool {
+	return slices.Contains(l.Accounts, addr)
+}
+
+// logPage 是同步时每一段的区块数：一次 eth_getLogs 查询的范围，也是保存进度的间隔。
+// 很多节点限制单次日志查询的区块数
+var logPage uint64 = 2000
+
+// Sync 把账本同步到区块 to，每 logPage 个区块保存一次进度，中途出错时已经保存的部分不用重新同步。
+// ETH 需要逐个区块扫描交易，代币用 Transfer 日志过滤。访问节点时不持有锁，
+// 同步期间 Net、Covered 照常可用；同时调用的 Sync 会排队
+func (l *Ledger) Sync(ctx context.Context, backend LedgerBackend, to uint64) error {
+	l.syncMu.Lock()
+	defer l.syncMu.Unlock()
+	l.mu.RLock()
+	from := l.Next
+	l.mu.RUnlock()
+	if from > to {
+		return nil
+	}
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	for start := from; ; start += logPage {
+		end := to
+		if to-start >= logPage {
+			end = start + logPage - 1
+		}
+		records, err := l.scan(ctx, backend, signer, start, end)
+		if err != nil {
+			return err
+		}
+		l.mu.Lock()
+		l.Records = append(l.Records, records...)
+		l.Next = end + 1
+		err = l.save()
+		l.mu.Unlock()
+		if err != nil || end == to {
+			return err
+		}
+	}
+}
+
+// scan 读取区块 [from, to] 里跟踪的账户的转账。Accounts 和 Tokens 打开账本后不再改变，读它们不需要锁
+func (l *Ledger) scan(ctx context.Context, backend LedgerBackend, signer types.Signer, from, to uint64) ([]Transfer, error) {
+	var records []Transfer
+	for n := from; n <= to; n++ {
+		block, err := backend.BlockByNumber(ctx, new(big.Int).SetUint64(n))
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range block.Transactions() {
+			sender, err := types.Sender(signer, tx)
+			if err != nil {
+				return nil, fmt.Errorf("ledger: sender of %s: %w", tx.Hash().Hex(), err)
+			}
+			in := tx.To() != nil && l.tracked(*tx.To())
+			if !l.tracked(sender) && !in {
+				continue
+			}
+			receipt, err := backend.TransactionReceipt(ctx, tx.Hash())
+			if err != nil {
+				return nil, err
+			}
+			t := Transfer{From: sender, Value: new(big.Int), Block: n, TxHash: tx.Hash()}
+			if tx.To() != nil {
+				t.To = *tx.To()
+			} else {
+				t.To = receipt.ContractAddress
+			}
+			if receipt.Status == types.ReceiptStatusSuccessful {
+				t.Value = tx.Value()
+			}
+			if l.tracked(sender) {
+				t.Fee = new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+			}
+			records = append(records, t)
+		}
+	}
+
+	if len(l.Tokens) > 0 && len(l.Accounts) > 0 {
+		topics := make([]common.Hash, len(l.Accounts))
+		for i, a := range l.Accounts {
+			topics[i] = common.BytesToHash(a.Bytes())
+		}
+		seen := map[string]bool{}
+		// 转出和转入分两次查，同一条日志可能两边都匹配（自己转给自己或账户之间互转）
+		for _, q := range [][][]common.Hash{{{transferTopic}, topics}, {{transferTopic}, nil, topics}} {
+			logs, err := backend.FilterLogs(ctx, ethereum.FilterQuery{
+				FromBlock: new(big.Int).SetUint64(from),
+				ToBlock:   new(big.Int).SetUint64(to),
+				Addresses: l.Tokens,
+				Topics:    q,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, lg := range logs {
+				key := fmt.Sprintf("%s-%d", lg.TxHash.Hex(), lg.Index)
+				if seen[key] || lg.Removed || len(lg.Topics) != 3 || len(lg.Data) != 32 {
+					continue // ERC-721 的 Transfer 有 4 个 topic，不是代币余额
+				}
+				seen[key] = true
+				records = append(records, Transfer{
+					Token:  lg.Address,
+					From:   common.BytesToAddress(lg.Topics[1].Bytes()),
+					To:     common.BytesToAddress(lg.Topics[2].Bytes()),
+					Value:  new(big.Int).SetBytes(lg.Data),
+					Block:  lg.BlockNumber,
+					TxHash: lg.TxHash,
+				})
+			}
+		}
+	}
+
+	return records, nil
+}
+
+// Net 返回 account 的 token（零地址为 ETH）在区块 (after, upTo] 里的净变化
+func (l *Ledger) Net(account, token common.Address, after, upTo uint64) (*big.Int, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if !l.tracked(account) || (token != (common.Address{}) && !slices.Contains(l.Tokens, token)) {
+		return nil, fmt.Errorf("%w: ledger does not track %s / %s", ErrNotCovered, account.Hex(), token.Hex())
+	}
+	if after+1 < l.From || upTo >= l.Next {
+		return nil, fmt.Errorf("%w: want (%d, %d], ledger has [%d, %d)", ErrNotCovered, after, upTo, l.From, l.Next)
+	}
+	net := new(big.Int)
+	for _, t := range l.Records {
+		if t.Token != token || t.Block <= after || t.Block > upTo {
+			continue
+		}
+		if t.To == account {
+			net.Add(net, t.Value)
+		}
+		if t.From == account {
+			net.Sub(net, t.Value)
+			if t.Fee != nil {
+				net.Sub(net, t.Fee)
+			}
+		}
+	}
+	return net, nil
+}
+
+func (l *Ledger) save() error {
+	if l.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	if dir := filepath.Dir(l.path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	tmp := l.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, l.path)
+}
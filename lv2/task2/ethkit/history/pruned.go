@@ -0,0 +1,67 @@
+package history
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// PrunedError 表示节点已经删掉了这个区块的状态。全节点默认只保留最近 128 个区块的状态，
+// 更早的余额、合约存储只能去归档节点查，或者用本地同步的转账记录推算（见 Ledger）
+type PrunedError struct {
+	Block uint64
+	Err   error
+}
+
+func (e *PrunedError) Error() string {
+	return fmt.Sprintf("history: state of block %d is pruned on this node, use an archive node or a synced ledger", e.Block)
+}
+
+func (e *PrunedError) Unwrap() error {
+	return e.Err
+}
+
+// prunedMessages 匹配节点在历史状态不可用时返回的错误信息，只认这几条原文，
+// 不按 "pruned" 之类的单词猜，免得把别的错误当成状态被裁剪
+var prunedMessages = []*regexp.Regexp{
+	// geth：hash 和 path 模式打开已删除的状态根都是 trie.MissingNodeError，
+	// path 模式后面还跟着 "state 0x... is not available"
+	regexp.MustCompile(`^missing trie node [0-9a-f]{64} `),
+	// geth：debug_trace* 等需要重放出历史状态的接口
+	regexp.MustCompile(`^required historical state unavailable \(reexec=\d+\)$`),
+	regexp.MustCompile(`^historical state not available in path scheme yet$`),
+	// erigon：state.PrunedError
+	regexp.MustCompile(`^old data not available due to pruning$`),
+}
+
+// IsPruned 判断 err 是不是因为历史状态被裁剪。节点的错误按 JSON-RPC 返回的原文匹配，外面包过几层也能认出来
+func IsPruned(err error) bool {
+	if err == nil {
+		return false
+	}
+	var pruned *PrunedError
+	if errors.As(err, &pruned) {
+		return true
+	}
+	msg := err.Error()
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		msg = rpcErr.Error()
+	}
+	for _, re := range prunedMessages {
+		if re.MatchString(msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// classify 把节点返回的状态缺失错误换成 *PrunedError，其他错误原样返回
+func classify(err error, block uint64) error {
+	if err != nil && IsPruned(err) {
+		return &PrunedError{Block: block, Err: err}
+	}
+	return err
+}
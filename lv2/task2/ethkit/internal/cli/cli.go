@@ -0,0 +1,61 @@
+// Package cli 是 cmd 下发交易的命令共用的签名和退出处理
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"ethkit/preflight"
+	"ethkit/signer"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Transactor 用 keystore 里 from 账户签名，每笔交易发送前经过 preflight.Guard 检查，abis 用来解码自定义错误。
+// dryRun 时只检查不发送，发交易的调用返回 *preflight.DryRunError，交给 Fatal 打印报告。
+// 参数不全、口令不对或者连不上节点时直接退出
+func Transactor(ctx context.Context, client *ethclient.Client, keystoreDir, from, passwordFile string, dryRun bool, abis ...abi.ABI) *bind.TransactOpts {
+	if keystoreDir == "" || !common.IsHexAddress(from) {
+		log.Fatal("-keystore and -from are required to send transactions")
+	}
+	passphrase := ""
+	if passwordFile != "" {
+		var err error
+		if passphrase, err = signer.ReadPassphrase(passwordFile); err != nil {
+			log.Fatal(err)
+		}
+	}
+	ks, err := signer.Open(keystoreDir, common.HexToAddress(from), passphrase)
+	if err != nil {
+		log.Fatal(err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth, err := ks.Transactor(chainID)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return preflight.Guard(auth, client, preflight.Options{DryRun: dryRun, ABIs: abis})
+}
+
+// Fatal 和 log.Fatal 一样结束命令，只是 dry run 的结果不当作错误：
+// 打印检查报告，检查通过时退出码为 0，不通过为 1
+func Fatal(err error) {
+	var dry *preflight.DryRunError
+	if !errors.As(err, &dry) {
+		log.Fatal(err)
+	}
+	fmt.Print(dry.Report)
+	if dry.Report.Err() != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
@@ -0,0 +1,210 @@
+package multicall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"ethkit/abicall"
+	"ethkit/contracts/multicall3"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Address 是 Multicall3 在主网、各测试网和大多数 L2 上的地址，simchain 和 devnet 的创世块里也有
+var Address = multicall3.Address
+
+// ErrNoData 表示调用成功但没有返回数据，通常是目标地址没有代码
+var ErrNoData = errors.New("multicall: call returned no data")
+
+var aggregateABI = mustABI()
+
+func mustABI() *abi.ABI {
+	parsed, err := multicall3.Multicall3MetaData.GetAbi()
+	if err != nil {
+		panic(err) // 生成的绑定里的 ABI 不会解析失败
+	}
+	return parsed
+}
+
+// Call 是一次合约只读调用。Do 之后 Out 是解码后的返回值，Err 是这一个调用的错误
+// （revert 时是 *abicall.RevertError），某个调用失败不影响其他调用
+type Call struct {
+	Target common.Address
+	ABI    *abi.ABI
+	Method string
+	Args   []any
+
+	Out []any
+	Err error
+
+	data []byte
+}
+
+// NewCall 构造一个调用，parsed 一般来自生成绑定的 XxxMetaData.GetAbi()
+func NewCall(target common.Address, parsed *abi.ABI, method string, args ...any) *Call {
+	return &Call{Target: target, ABI: parsed, Method: method, Args: args}
+}
+
+// Unpack 把返回值依次赋给 out 里的指针，类型要和 abigen 生成的一致，比如 *big.Int、string、uint8
+func (call *Call) Unpack(out ...any) error {
+	if call.Err != nil {
+		return call.Err
+	}
+	if len(out) > len(call.Out) {
+		return fmt.Errorf("multicall: %s returns %d values, want %d", call.Method, len(call.Out), len(out))
+	}
+	for i, dst := range out {
+		rv := reflect.ValueOf(dst)
+		if rv.Kind() != reflect.Pointer || rv.IsNil() {
+			return fmt.Errorf("multicall: output %d must be a non-nil pointer", i)
+		}
+		src := reflect.ValueOf(call.Out[i])
+		switch {
+		case src.Type().AssignableTo(rv.Elem().Type()):
+			rv.Elem().Set(src)
+		case src.Type().ConvertibleTo(rv.Elem().Type()):
+			// ABI 解码出来的匿名结构体转成绑定里的具名结构体
+			rv.Elem().Set(src.Convert(rv.Elem().Type()))
+		default:
+			return fmt.Errorf("multicall: %s output %d is %s, not %s", call.Method, i, src.Type(), rv.Elem().Type())
+		}
+	}
+	return nil
+}
+
+// Client 把多个只读调用合并成一次 Multicall3.aggregate3 的 eth_call。
+// 链上没有部署 Multicall3 时退回逐个 eth_call，调用方不用区分
+type Client struct {
+	Address   common.Address
+	BatchSize int // 每次 aggregate3 最多带的调用数，太多会超出节点的 eth_call gas 上限
+
+	backend bind.ContractCaller
+
+	mu       sync.Mutex
+	deployed map[common.Address]bool
+}
+
+func New(backend bind.ContractCaller) *Client {
+	return &Client{Address: Address, BatchSize: 100, backend: backend, deployed: map[common.Address]bool{}}
+}
+
+// Available 检查 c.Address 上有没有合约代码，结果按地址缓存
+func (c *Client) Available(ctx context.Context) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ok, cached := c.deployed[c.Address]; cached {
+		return ok, nil
+	}
+	code, err := c.backend.CodeAt(ctx, c.Address, nil)
+	if err != nil {
+		return false, err
+	}
+	c.deployed[c.Address] = len(code) > 0
+	return len(code) > 0, nil
+}
+
+// Do 在区块 block（nil 为最新）上执行全部调用，结果写回每个 Call。
+// 只有节点请求本身失败时返回错误；单个调用 revert 或解码失败记在它的 Err 里。
+// 注意经过 Multicall3 时被调合约看到的 msg.sender 是 Multicall3
+func (c *Client) Do(ctx context.Context, block *big.Int, calls ...*Call) error {
+	var pending []*Call
+	for _, call := range calls {
+		call.Out, call.Err = nil, nil
+		data, err := call.ABI.Pack(call.Method, call.Args...)
+		if err != nil {
+			call.Err = fmt.Errorf("multicall: pack %s: %w", call.Method, err)
+			continue
+		}
+		call.data = data
+		pending = append(pending, call)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	ok, err := c.Available(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return c.each(ctx, block, pending)
+	}
+	size := c.BatchSize
+	if size <= 0 {
+		size = len(pending)
+	}
+	for start := 0; start < len(pending); start += size {
+		end := min(start+size, len(pending))
+		if err := c.aggregate(ctx, block, pending[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) aggregate(ctx context.Context, block *big.Int, calls []*Call) error {
+	args := make([]multicall3.Multicall3Call3, len(calls))
+	for i, call := range calls {
+		args[i] = multicall3.Multicall3Call3{Target: call.Target, AllowFailure: true, CallData: call.data}
+	}
+	input, err := aggregateABI.Pack("aggregate3", args)
+	if err != nil {
+		return err
+	}
+	output, err := c.backend.CallContract(ctx, ethereum.CallMsg{To: &c.Address, Data: input}, block)
+	if err != nil {
+		return fmt.Errorf("multicall: aggregate3: %w", err)
+	}
+	if len(output) == 0 {
+		// 指定的历史区块上 Multicall3 还没部署
+		return c.each(ctx, block, calls)
+	}
+	values, err := aggregateABI.Unpack("aggregate3", output)
+	if err != nil {
+		return fmt.Errorf("multicall: aggregate3: %w", err)
+	}
+	results := *abi.ConvertType(values[0], new([]multicall3.Multicall3Result)).(*[]multicall3.Multicall3Result)
+	if len(results) != len(calls) {
+		return fmt.Errorf("multicall: aggregate3 returned %d results for %d calls", len(results), len(calls))
+	}
+	for i, call := range calls {
+		if !results[i].Success {
+			call.Err = abicall.DecodeRevert(results[i].ReturnData, *call.ABI)
+			continue
+		}
+		call.decode(results[i].ReturnData)
+	}
+	return nil
+}
+
+// each 是没有 Multicall3 时的退路：逐个 eth_call
+func (c *Client) each(ctx context.Context, block *big.Int, calls []*Call) error {
+	for _, call := range calls {
+		to := call.Target
+		output, err := c.backend.CallContract(ctx, ethereum.CallMsg{To: &to, Data: call.data}, block)
+		if err != nil {
+			if _, reverted := abicall.RevertData(err); !reverted {
+				return err
+			}
+			call.Err = abicall.WrapRevert(err, *call.ABI)
+			continue
+		}
+		call.decode(output)
+	}
+	return nil
+}
+
+func (call *Call) decode(output []byte) {
+	method := call.ABI.Methods[call.Method]
+	if len(output) == 0 && len(method.Outputs) > 0 {
+		call.Err = fmt.Errorf("%w: %s on %s", ErrNoData, call.Method, call.Target.Hex())
+		return
+	}
+	call.Out, call.Err = call.ABI.Unpack(call.Method, output)
+}
@@ -0,0 +1,231 @@
+package multicall
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"ethkit/abicall"
+	"ethkit/contracts/multicall3"
+	"ethkit/contracts/token"
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	store "store/contracts"
+)
+
+// countingCaller 记录 eth_call 的次数，用来区分走了 aggregate3 还是逐个调用
+type countingCaller struct {
+	bind.ContractCaller
+	calls int
+}
+
+func (c *countingCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	c.calls++
+	return c.ContractCaller.CallContract(ctx, call, blockNumber)
+}
+
+type fixture struct {
+	chain      *simchain.Chain
+	store      common.Address
+	token      common.Address
+	late       common.Address // 在代币之后另外部署的 Multicall3
+	tokenBlock *big.Int       // 代币已部署、late 还没部署的区块
+}
+
+// 先部署 Store 和代币，再另外部署一份 Multicall3；固定地址上的那份在创世块里
+func setup(t *testing.T) *fixture {
+	t.Helper()
+	chain, err := simchain.New(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	f := &fixture{chain: chain}
+	if f.store, _, _, err = chain.DeployStore(0, "1.0"); err != nil {
+		t.Fatal(err)
+	}
+	tokenAddr, _, r, err := chain.DeployToken(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.token, f.tokenBlock = tokenAddr, r.BlockNumber
+	if f.late, _, err = chain.DeployMulticall3(0); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// calls 覆盖几类结果：正常返回、自定义错误 revert、目标地址没有代码、参数打包失败
+func (f *fixture) calls(t *testing.T) []*Call {
+	t.Helper()
+	storeABI, err := store.StoreMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenABI, err := token.TokenMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	holder, other := f.chain.Accounts[0].Address, f.chain.Accounts[1].Address
+	return []*Call{
+		NewCall(f.store, storeABI, "version"),
+		NewCall(f.token, tokenABI, "symbol"),
+		NewCall(f.token, tokenABI, "balanceOf", holder),
+		// 调用方没有授权，ERC20InsufficientAllowance
+		NewCall(f.token, tokenABI, "transferFrom", holder, other, big.NewInt(1)),
+		NewCall(common.HexToAddress("0x000000000000000000000000000000000000dEaD"), tokenABI, "totalSupply"),
+		NewCall(f.token, tokenABI, "balanceOf", "not an address"),
+	}
+}
+
+func check(t *testing.T, calls []*Call) {
+	t.Helper()
+	var version, symbol string
+	if err := calls[0].Unpack(&version); err != nil || version != "1.0" {
+		t.Fatalf("version = %q, %v", version, err)
+	}
+	if err := calls[1].Unpack(&symbol); err != nil || symbol == "" {
+		t.Fatalf("symbol = %q, %v", symbol, err)
+	}
+	var balance *big.Int
+	if err := calls[2].Unpack(&balance); err != nil || balance.Sign() <= 0 {
+		t.Fatalf("balance = %v, %v", balance, err)
+	}
+	var revert *abicall.RevertError
+	if !errors.As(calls[3].Err, &revert) || revert.Kind != abicall.RevertCustom || revert.Name != "ERC20InsufficientAllowance" {
+		t.Fatalf("transferFrom err = %v", calls[3].Err)
+	}
+	if needed, ok := revert.Arg("needed"); !ok || needed.(*big.Int).Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("needed = %v", needed)
+	}
+	if !errors.Is(calls[4].Err, ErrNoData) {
+		t.Fatalf("call without code: %v", calls[4].Err)
+	}
+	if calls[5].Err == nil || calls[5].Out != nil {
+		t.Fatalf("bad argument: %+v", calls[5])
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+	backend := &countingCaller{ContractCaller: f.chain.Client}
+	c := New(backend)
+	c.BatchSize = 2
+
+	calls := f.calls(t)
+	if err := c.Do(ctx, nil, calls...); err != nil {
+		t.Fatal(err)
+	}
+	check(t, calls)
+	// 能打包的 5 个调用按每批 2 个分 3 次 aggregate3
+	if backend.calls != 3 {
+		t.Fatalf("%d eth_calls, want 3", backend.calls)
+	}
+	// 同一个 Call 可以重复执行，上次的结果会清掉
+	if err := c.Do(ctx, nil, calls...); err != nil {
+		t.Fatal(err)
+	}
+	check(t, calls)
+}
+
+// Multicall3 不存在（地址上没有代码，或者历史区块上还没部署）时逐个 eth_call，结果一样
+func TestFallback(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+
+	backend := &countingCaller{ContractCaller: f.chain.Client}
+	c := New(backend)
+	c.Address = common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	calls := f.calls(t)
+	if ok, err := c.Available(ctx); err != nil || ok {
+		t.Fatalf("address without code available: %v, %v", ok, err)
+	}
+	if err := c.Do(ctx, nil, calls...); err != nil {
+		t.Fatal(err)
+	}
+	check(t, calls)
+	if backend.calls != 5 {
+		t.Fatalf("%d eth_calls, want 5", backend.calls)
+	}
+
+	backend = &countingCaller{ContractCaller: f.chain.Client}
+	c = New(backend)
+	c.Address = f.late
+	if err := c.Do(ctx, f.tokenBlock, calls...); err != nil {
+		t.Fatal(err)
+	}
+	check(t, calls)
+	// 一次返回空的 aggregate3，再逐个调用
+	if backend.calls != 6 {
+		t.Fatalf("%d eth_calls, want 6", backend.calls)
+	}
+}
+
+// 创世块里的是主网原版：allowFailure=false 的调用失败、aggregate3Value 的 msg.value 对不上时整体 revert
+func TestCanonical(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+	code, err := f.chain.Client.CodeAt(ctx, Address, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.Keccak256Hash(code) != multicall3.CodeHash {
+		t.Fatalf("code hash %s, want %s", crypto.Keccak256Hash(code).Hex(), multicall3.CodeHash.Hex())
+	}
+
+	parsed, err := multicall3.Multicall3MetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokenABI, err := token.TokenMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	holder, other := f.chain.Accounts[0].Address, f.chain.Accounts[1].Address
+	transferFrom, err := tokenABI.Pack("transferFrom", holder, other, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	balanceOf, err := tokenABI.Pack("balanceOf", holder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		method string
+		args   any
+		value  *big.Int
+		want   string // 空表示调用成功
+	}{
+		{"allow failure", "aggregate3", []multicall3.Multicall3Call3{{Target: f.token, AllowFailure: true, CallData: transferFrom}}, nil, ""},
+		{"require success", "aggregate3", []multicall3.Multicall3Call3{{Target: f.token, CallData: transferFrom}}, nil, "Multicall3: call failed"},
+		{"value matches", "aggregate3Value", []multicall3.Multicall3Call3Value{{Target: f.token, Value: new(big.Int), CallData: balanceOf}}, nil, ""},
+		{"value mismatch", "aggregate3Value", []multicall3.Multicall3Call3Value{{Target: f.token, Value: new(big.Int), CallData: balanceOf}}, big.NewInt(1), "Multicall3: value mismatch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := parsed.Pack(tt.method, tt.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg := ethereum.CallMsg{From: holder, To: &Address, Data: input, Value: tt.value}
+			_, err = f.chain.Client.CallContract(ctx, msg, nil)
+			if tt.want == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var revert *abicall.RevertError
+			if !errors.As(abicall.WrapRevert(err, *parsed), &revert) || revert.Kind != abicall.RevertString || revert.Message != tt.want {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,393 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"ethkit/abicall"
+	"ethkit/contracts/multisig"
+	"ethkit/signer"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	ErrNotOwner        = errors.New("multisig: sender is not an owner")
+	ErrNotFound        = errors.New("multisig: proposal not found")
+	ErrExecuted        = errors.New("multisig: proposal already executed")
+	ErrAlreadyApproved = errors.New("multisig: already approved")
+	ErrNotApproved     = errors.New("multisig: not approved by sender")
+	ErrBelowThreshold  = errors.New("multisig: approvals below required")
+)
+
+// Backend 在 abicall.Backend 之外还要按哈希取 submit 交易，从 input 里解出提案内容
+type Backend interface {
+	abicall.Backend
+	TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error)
+}
+
+// Client 是 MultiSigWallet 的客户端，提案状态全部由 Submit / Approve / Revoke / Execute 事件重建，
+// 不逐个查询 txId 的批准情况
+type Client struct {
+	address   common.Address
+	backend   Backend
+	contract  *multisig.MultiSigWallet
+	abi       *abi.ABI
+	FromBlock uint64 // 合约部署区块，从这里开始查事件
+
+	mu      sync.Mutex
+	details map[submission]detail // 提案的 to / value / data 不会变，每个提案只取一次
+}
+
+// submission 标识一次 Submit 事件。重组后同一个 txId 可能对应另一笔交易，所以带上交易哈希
+type submission struct {
+	tx common.Hash
+	id uint64
+}
+
+type detail struct {
+	to    common.Address
+	value *big.Int
+	data  []byte
+}
+
+func New(address common.Address, backend Backend) (*Client, error) {
+	contract, err := multisig.NewMultiSigWallet(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := multisig.MultiSigWalletMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Client{address: address, backend: backend, contract: contract, abi: parsed, details: map[submission]detail{}}, nil
+}
+
+func (c *Client) Address() common.Address {
+	return c.address
+}
+
+// Proposal 是一笔多签提案
+type Proposal struct {
+	ID        uint64           `json:"id"`
+	To        common.Address   `json:"to"`
+	Value     *big.Int         `json:"value"`
+	Data      hexutil.Bytes    `json:"data"`
+	Executed  bool             `json:"executed"`
+	Approvals []common.Address `json:"approvals"`
+	Block     uint64           `json:"block"` // Submit 所在区块
+	TxHash    common.Hash      `json:"tx_hash"`
+}
+
+func (p Proposal) Approved(owner common.Address) bool {
+	for _, a := range p.Approvals {
+		if a == owner {
+			return true
+		}
+	}
+	return false
+}
+
+// Info 是钱包的基本信息
+type Info struct {
+	Address  common.Address   `json:"address"`
+	Owners   []common.Address `json:"owners"`
+	Required uint64           `json:"required"`
+	Balance  *big.Int         `json:"balance"`
+}
+
+func (c *Client) Info(ctx context.Context) (Info, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	owners, err := c.Owners(ctx)
+	if err != nil {
+		return Info{}, err
+	}
+	required, err := c.Required(ctx)
+	if err != nil {
+		return Info{}, err
+	}
+	balance, err := c.contract.GetBalance(opts)
+	if err != nil {
+		return Info{}, err
+	}
+	return Info{Address: c.address, Owners: owners, Required: required, Balance: balance}, nil
+}
+
+// Required 返回执行提案需要的批准数
+func (c *Client) Required(ctx context.Context) (uint64, error) {
+	n, err := c.contract.Required(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+	return n.Uint64(), nil
+}
+
+// Owners 读取 owners 数组。合约没有长度 getter，读到越界 revert 为止
+func (c *Client) Owners(ctx context.Context) ([]common.Address, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	var owners []common.Address
+	for i := int64(0); ; i++ {
+		owner, err := c.contract.Owners(opts, big.NewInt(i))
+		if err != nil {
+			if abicall.Reverted(err) {
+				return owners, nil
+			}
+			return nil, err
+		}
+		owners = append(owners, owner)
+	}
+}
+
+// Proposals 用一次 eth_getLogs 取回所有提案相关事件，按顺序回放得到每个提案当前的批准人和执行状态。
+// to / value / data 从 Submit 所在交易的 input 解出并缓存，之后列提案只需要一次 eth_getLogs
+func (c *Client) Proposals(ctx context.Context) ([]Proposal, error) {
+	ids := [][]common.Hash{{
+		c.abi.Events["Submit"].ID,
+		c.abi.Events["Approve"].ID,
+		c.abi.Events["Revoke"].ID,
+		c.abi.Events["Execute"].ID,
+	}}
+	logs, err := c.backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(c.FromBlock),
+		Addresses: []common.Address{c.address},
+		Topics:    ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+
+	byID := map[uint64]*Proposal{}
+	var order []uint64
+	for _, l := range logs {
+		if l.Removed {
+			continue
+		}
+		switch l.Topics[0] {
+		case ids[0][0]:
+			e, err := c.contract.ParseSubmit(l)
+			if err != nil {
+				return nil, err
+			}
+			id := e.TxId.Uint64()
+			byID[id] = &Proposal{ID: id, Block: l.BlockNumber, TxHash: l.TxHash}
+			order = append(order, id)
+		case ids[0][1]:
+			e, err := c.contract.ParseApprove(l)
+			if err != nil {
+				return nil, err
+			}
+			if p := byID[e.Arg1.Uint64()]; p != nil && !p.Approved(e.Owner) {
+				p.Approvals = append(p.Approvals, e.Owner)
+			}
+		case ids[0][2]:
+			e, err := c.contract.ParseRevoke(l)
+			if err != nil {
+				return nil, err
+			}
+			if p := byID[e.TxId.Uint64()]; p != nil {
+				for i, a := range p.Approvals {
+					if a == e.Owner {
+						p.Approvals = append(p.Approvals[:i], p.Approvals[i+1:]...)
+						break
+					}
+				}
+			}
+		case ids[0][3]:
+			e, err := c.contract.ParseExecute(l)
+			if err != nil {
+				return nil, err
+			}
+			if p := byID[e.TxId.Uint64()]; p != nil {
+				p.Executed = true
+			}
+		}
+	}
+
+	proposals := make([]Proposal, 0, len(order))
+	for _, id := range order {
+		p := byID[id]
+		d, err := c.detail(ctx, submission{tx: p.TxHash, id: id})
+		if err != nil {
+			return nil, err
+		}
+		p.To, p.Value, p.Data = d.to, d.value, d.data
+		proposals = append(proposals, *p)
+	}
+	return proposals, nil
+}
+
+// detail 解码 submit 交易的 input。提案经其他合约转发时 input 不是 submit 调用，退回读 transactions(txId)
+func (c *Client) detail(ctx context.Context, key submission) (detail, error) {
+	c.mu.Lock()
+	d, ok := c.details[key]
+	c.mu.Unlock()
+	if ok {
+		return d, nil
+	}
+	tx, _, err := c.backend.TransactionByHash(ctx, key.tx)
+	if err != nil {
+		return detail{}, err
+	}
+	submit := c.abi.Methods["submit"]
+	if input := tx.Data(); tx.To() != nil && *tx.To() == c.address && len(input) >= 4 && bytes.Equal(input[:4], submit.ID) {
+		args, err := submit.Inputs.Unpack(input[4:])
+		if err != nil {
+			return detail{}, err
+		}
+		d = detail{to: args[0].(common.Address), value: args[1].(*big.Int), data: args[2].([]byte)}
+	} else {
+		t, err := c.contract.Transactions(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(key.id))
+		if err != nil {
+			return detail{}, err
+		}
+		d = detail{to: t.To, value: t.Value, data: t.Data}
+	}
+	c.mu.Lock()
+	c.details[key] = d
+	c.mu.Unlock()
+	return d, nil
+}
+
+// Pending 返回还没执行的提案
+func (c *Client) Pending(ctx context.Context) ([]Proposal, error) {
+	all, err := c.Proposals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pending := all[:0]
+	for _, p := range all {
+		if !p.Executed {
+			pending = append(pending, p)
+		}
+	}
+	return pending, nil
+}
+
+func (c *Client) Proposal(ctx context.Context, id uint64) (Proposal, error) {
+	all, err := c.Proposals(ctx)
+	if err != nil {
+		return Proposal{}, err
+	}
+	for _, p := range all {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return Proposal{}, ErrNotFound
+}
+
+// Submit 发起提案，提案 ID 要等交易上链后用 SubmittedID 从回执里取
+func (c *Client) Submit(ctx context.Context, auth *bind.TransactOpts, to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	if err := c.requireOwner(ctx, auth.From); err != nil {
+		return nil, err
+	}
+	return c.contract.Submit(signer.WithContext(ctx, auth), to, value, data)
+}
+
+// SubmittedID 从 submit 交易的回执里解出提案 ID
+func (c *Client) SubmittedID(receipt *types.Receipt) (uint64, error) {
+	for _, l := range receipt.Logs {
+		if l.Address != c.address || len(l.Topics) == 0 || l.Topics[0] != c.abi.Events["Submit"].ID {
+			continue
+		}
+		e, err := c.contract.ParseSubmit(*l)
+		if err != nil {
+			return 0, err
+		}
+		return e.TxId.Uint64(), nil
+	}
+	return 0, fmt.Errorf("multisig: no Submit event in tx %s", receipt.TxHash.Hex())
+}
+
+// Approve 批准提案，先按事件状态检查，避免发出注定 revert 的交易
+func (c *Client) Approve(ctx context.Context, auth *bind.TransactOpts, id uint64) (*types.Transaction, error) {
+	p, err := c.check(ctx, auth.From, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Approved(auth.From) {
+		return nil, ErrAlreadyApproved
+	}
+	return c.contract.Approv(signer.WithContext(ctx, auth), new(big.Int).SetUint64(id))
+}
+
+// Revoke 撤回自己的批准
+func (c *Client) Revoke(ctx context.Context, auth *bind.TransactOpts, id uint64) (*types.Transaction, error) {
+	p, err := c.check(ctx, auth.From, id)
+	if err != nil {
+		return nil, err
+	}
+	if !p.Approved(auth.From) {
+		return nil, ErrNotApproved
+	}
+	return c.contract.Revoke(signer.WithContext(ctx, auth), new(big.Int).SetUint64(id))
+}
+
+// Execute 在批准数达到 required 后执行提案
+func (c *Client) Execute(ctx context.Context, auth *bind.TransactOpts, id uint64) (*types.Transaction, error) {
+	p, err := c.check(ctx, auth.From, id)
+	if err != nil {
+		return nil, err
+	}
+	required, err := c.Required(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(p.Approvals)) < required {
+		return nil, fmt.Errorf("%w: %d/%d", ErrBelowThreshold, len(p.Approvals), required)
+	}
+	return c.contract.Execute(signer.WithContext(ctx, auth), new(big.Int).SetUint64(id))
+}
+
+// Wait 等待交易上链，revert 时返回错误
+func (c *Client) Wait(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, c.backend, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("multisig: tx %s reverted in block %s", tx.Hash().Hex(), receipt.BlockNumber)
+	}
+	return receipt, nil
+}
+
+func (c *Client) check(ctx context.Context, from common.Address, id uint64) (Proposal, error) {
+	if err := c.requireOwner(ctx, from); err != nil {
+		return Proposal{}, err
+	}
+	p, err := c.Proposal(ctx, id)
+	if err != nil {
+		return Proposal{}, err
+	}
+	if p.Executed {
+		return Proposal{}, ErrExecuted
+	}
+	return p, nil
+}
+
+func (c *Client) requireOwner(ctx context.Context, from common.Address) error {
+	ok, err := c.contract.IsOwner(&bind.CallOpts{Context: ctx}, from)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotOwner
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"ethkit/contracts/multisig"
+	"ethkit/simchain"
+	"ethkit/simchain/simchaintest"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var ether = big.NewInt(params.Ether)
+
+// countingBackend 记录读提案内容的调用次数，用来确认提案状态只靠事件
+type countingBackend struct {
+	Backend
+	byHash       int
+	transactions int
+}
+
+func (b *countingBackend) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	b.byHash++
+	return b.Backend.TransactionByHash(ctx, hash)
+}
+
+func (b *countingBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	parsed, _ := multisig.MultiSigWalletMetaData.GetAbi()
+	if bytes.Equal(call.Data[:4], parsed.Methods["transactions"].ID) {
+		b.transactions++
+	}
+	return b.Backend.CallContract(ctx, call, blockNumber)
+}
+
+// deploy 部署一个多签钱包并返回指向它的客户端，FromBlock 设为部署区块
+func deploy(t *testing.T, chain *simchain.Chain, owners []common.Address, required int64) (*Client, *countingBackend) {
+	t.Helper()
+	addr, tx, _, err := multisig.DeployMultiSigWallet(chain.Transactor(0), chain.Client, owners, big.NewInt(required))
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := chain.Mine(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := &countingBackend{Backend: chain.Client}
+	c, err := New(addr, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.FromBlock = receipt.BlockNumber.Uint64()
+	return c, backend
+}
+
+// miner 返回一个出块并取回执的函数，可以直接包住客户端的 (tx, err) 返回值
+func miner(t *testing.T, chain *simchain.Chain) func(*types.Transaction, error) *types.Receipt {
+	return func(tx *types.Transaction, err error) *types.Receipt {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipt, err := chain.Mine(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return receipt
+	}
+}
+
+func approvals(p Proposal) string {
+	return fmt.Sprint(p.Approvals)
+}
+
+func TestWorkflow(t *testing.T) {
+	chain, err := simchain.New(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	ctx := context.Background()
+	acc := func(i int) common.Address { return chain.Accounts[i].Address }
+	mine := miner(t, chain)
+	// 账户 0、1、2 是 owner，需要 2 个批准；账户 3 不是 owner，也是转账的收款方
+	c, backend := deploy(t, chain, []common.Address{acc(0), acc(1), acc(2)}, 2)
+	if _, err := chain.Fund(c.Address(), new(big.Int).Mul(big.NewInt(5), ether)); err != nil {
+		t.Fatal(err)
+	}
+	info, err := c.Info(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Owners) != 3 || info.Owners[2] != acc(2) || info.Required != 2 || info.Balance.Cmp(new(big.Int).Mul(big.NewInt(5), ether)) != 0 {
+		t.Fatalf("info = %+v", info)
+	}
+
+	if _, err := c.Submit(ctx, chain.Transactor(3), acc(3), ether, nil); !errors.Is(err, ErrNotOwner) {
+		t.Fatalf("submit by non-owner: %v", err)
+	}
+	receipt := mine(c.Submit(ctx, chain.Transactor(0), acc(3), ether, nil))
+	if id, err := c.SubmittedID(receipt); err != nil || id != 0 {
+		t.Fatalf("first proposal id = %d, %v", id, err)
+	}
+	simchaintest.RequireEvent(t, receipt, c.Address(), multisig.MultiSigWalletMetaData.ABI, "Submit", map[string]any{"txId": big.NewInt(0)})
+	receipt = mine(c.Submit(ctx, chain.Transactor(1), acc(3), big.NewInt(0), []byte{0x12, 0x34}))
+	if id, err := c.SubmittedID(receipt); err != nil || id != 1 {
+		t.Fatalf("second proposal id = %d, %v", id, err)
+	}
+
+	all, err := c.Proposals(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].To != acc(3) || all[0].Value.Cmp(ether) != 0 || len(all[0].Data) != 0 ||
+		all[1].Value.Sign() != 0 || !bytes.Equal(all[1].Data, []byte{0x12, 0x34}) || all[1].Executed || len(all[1].Approvals) != 0 {
+		t.Fatalf("proposals = %+v", all)
+	}
+
+	if _, err := c.Execute(ctx, chain.Transactor(0), 0); !errors.Is(err, ErrBelowThreshold) {
+		t.Fatalf("execute without approvals: %v", err)
+	}
+	mine(c.Approve(ctx, chain.Transactor(0), 0))
+	mine(c.Approve(ctx, chain.Transactor(1), 0))
+	if _, err := c.Approve(ctx, chain.Transactor(0), 0); !errors.Is(err, ErrAlreadyApproved) {
+		t.Fatalf("double approve: %v", err)
+	}
+	mine(c.Revoke(ctx, chain.Transactor(1), 0))
+	if _, err := c.Revoke(ctx, chain.Transactor(2), 0); !errors.Is(err, ErrNotApproved) {
+		t.Fatalf("revoke without approval: %v", err)
+	}
+	p, err := c.Proposal(ctx, 0)
+	if err != nil || approvals(p) != fmt.Sprint([]common.Address{acc(0)}) {
+		t.Fatalf("after revoke = %+v, %v", p, err)
+	}
+	if _, err := c.Execute(ctx, chain.Transactor(0), 0); !errors.Is(err, ErrBelowThreshold) {
+		t.Fatalf("execute with 1/2 approvals: %v", err)
+	}
+
+	mine(c.Approve(ctx, chain.Transactor(2), 0))
+	before, err := chain.BalanceAt(acc(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt = mine(c.Execute(ctx, chain.Transactor(1), 0))
+	simchaintest.RequireEvent(t, receipt, c.Address(), multisig.MultiSigWalletMetaData.ABI, "Execute", map[string]any{"txId": big.NewInt(0)})
+	simchaintest.RequireBalance(t, chain, acc(3), new(big.Int).Add(before, ether))
+
+	p, err = c.Proposal(ctx, 0)
+	if err != nil || !p.Executed || approvals(p) != fmt.Sprint([]common.Address{acc(0), acc(2)}) {
+		t.Fatalf("executed proposal = %+v, %v", p, err)
+	}
+	if _, err := c.Approve(ctx, chain.Transactor(1), 0); !errors.Is(err, ErrExecuted) {
+		t.Fatalf("approve executed: %v", err)
+	}
+	if _, err := c.Proposal(ctx, 7); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("unknown proposal: %v", err)
+	}
+	pending, err := c.Pending(ctx)
+	if err != nil || len(pending) != 1 || pending[0].ID != 1 {
+		t.Fatalf("pending = %+v, %v", pending, err)
+	}
+
+	// 每个提案的内容只从 submit 交易取一次，之后全靠事件，不读 transactions(txId)
+	if backend.byHash != 2 || backend.transactions != 0 {
+		t.Fatalf("TransactionByHash %d times, transactions() %d times", backend.byHash, backend.transactions)
+	}
+}
+
+// 提案经另一个多签转发时 submit 交易的 input 是 execute，内容退回从 transactions(txId) 读
+func TestForwardedSubmit(t *testing.T) {
+	chain, err := simchain.New(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	ctx := context.Background()
+	acc := func(i int) common.Address { return chain.Accounts[i].Address }
+	mine := miner(t, chain)
+
+	outer, _ := deploy(t, chain, []common.Address{acc(0)}, 1)
+	inner, backend := deploy(t, chain, []common.Address{acc(1), outer.Address()}, 1)
+	parsed, err := multisig.MultiSigWalletMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	call, err := parsed.Pack("submit", acc(1), ether, []byte{0xab})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine(outer.Submit(ctx, chain.Transactor(0), inner.Address(), big.NewInt(0), call))
+	mine(outer.Approve(ctx, chain.Transactor(0), 0))
+	mine(outer.Execute(ctx, chain.Transactor(0), 0))
+
+	for i := 0; i < 2; i++ {
+		all, err := inner.Proposals(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(all) != 1 || all[0].To != acc(1) || all[0].Value.Cmp(ether) != 0 || !bytes.Equal(all[0].Data, []byte{0xab}) {
+			t.Fatalf("forwarded proposal = %+v", all)
+		}
+	}
+	if backend.byHash != 1 || backend.transactions != 1 {
+		t.Fatalf("TransactionByHash %d times, transactions() %d times", backend.byHash, backend.transactions)
+	}
+}
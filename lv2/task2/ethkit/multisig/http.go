@@ -0,0 +1,203 @@
+package multisig
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"ethkit/preflight"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gin-gonic/gin"
+)
+
+// Register 注册多签的 HTTP 接口，写操作用 auth 签名；auth 为 nil 时只注册只读接口
+//
+//	GET  /multisig                    钱包信息
+//	GET  /multisig/proposals          提案列表，?pending=true 只返回未执行的
+//	GET  /multisig/proposals/:id
+//	POST /multisig/proposals          {"to": "0x...", "value": "wei", "data": "0x..."}
+//	POST /multisig/proposals/:id/approve | revoke | execute
+func Register(r gin.IRouter, c *Client, auth *bind.TransactOpts) {
+	h := &handler{client: c, auth: auth}
+	g := r.Group("/multisig")
+	g.GET("", h.info)
+	g.GET("/proposals", h.list)
+	g.GET("/proposals/:id", h.get)
+	if auth != nil {
+		g.POST("/proposals", h.submit)
+		g.POST("/proposals/:id/:action", h.act)
+	}
+}
+
+type handler struct {
+	client *Client
+	auth   *bind.TransactOpts
+}
+
+type proposalView struct {
+	Proposal
+	Required uint64 `json:"required"`
+	Ready    bool   `json:"ready"` // 批准数已经达到 required，可以执行
+}
+
+func (h *handler) info(c *gin.Context) {
+	info, err := h.client.Info(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, info)
+}
+
+func (h *handler) list(c *gin.Context) {
+	ctx := c.Request.Context()
+	fetch := h.client.Proposals
+	if c.Query("pending") == "true" {
+		fetch = h.client.Pending
+	}
+	proposals, err := fetch(ctx)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+		return
+	}
+	required, err := h.client.Required(ctx)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+		return
+	}
+	views := make([]proposalView, len(proposals))
+	for i, p := range proposals {
+		views[i] = view(p, required)
+	}
+	c.JSON(http.StatusOK, gin.H{"proposals": views})
+}
+
+func (h *handler) get(c *gin.Context) {
+	id, ok := proposalID(c)
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+	p, err := h.client.Proposal(ctx, id)
+	if err != nil {
+		c.JSON(status(err), gin.H{"msg": err.Error()})
+		return
+	}
+	required, err := h.client.Required(ctx)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, view(p, required))
+}
+
+type submitRequest struct {
+	To    string        `json:"to" binding:"required"`
+	Value string        `json:"value"`
+	Data  hexutil.Bytes `json:"data"`
+}
+
+func (h *handler) submit(c *gin.Context) {
+	var req submitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	if !common.IsHexAddress(req.To) {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid to address"})
+		return
+	}
+	value := new(big.Int)
+	if req.Value != "" {
+		if _, ok := value.SetString(req.Value, 10); !ok || value.Sign() < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid value"})
+			return
+		}
+	}
+	ctx := c.Request.Context()
+	tx, err := h.client.Submit(ctx, h.auth, common.HexToAddress(req.To), value, req.Data)
+	if err != nil {
+		sendFailed(c, err)
+		return
+	}
+	receipt, err := h.client.Wait(ctx, tx)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error(), "tx": tx.Hash()})
+		return
+	}
+	id, err := h.client.SubmittedID(receipt)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error(), "tx": tx.Hash()})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"id": id, "tx": tx.Hash()})
+}
+
+func (h *handler) act(c *gin.Context) {
+	id, ok := proposalID(c)
+	if !ok {
+		return
+	}
+	ctx := c.Request.Context()
+	var send func() (*types.Transaction, error)
+	switch c.Param("action") {
+	case "approve":
+		send = func() (*types.Transaction, error) { return h.client.Approve(ctx, h.auth, id) }
+	case "revoke":
+		send = func() (*types.Transaction, error) { return h.client.Revoke(ctx, h.auth, id) }
+	case "execute":
+		send = func() (*types.Transaction, error) { return h.client.Execute(ctx, h.auth, id) }
+	default:
+		c.JSON(http.StatusNotFound, gin.H{"msg": "unknown action"})
+		return
+	}
+	tx, err := send()
+	if err != nil {
+		sendFailed(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"tx": tx.Hash()})
+}
+
+func view(p Proposal, required uint64) proposalView {
+	return proposalView{Proposal: p, Required: required, Ready: !p.Executed && uint64(len(p.Approvals)) >= required}
+}
+
+func proposalID(c *gin.Context) (uint64, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid proposal id"})
+		return 0, false
+	}
+	return id, true
+}
+
+// sendFailed 回复发交易失败；dry run 的服务不发交易，回复 200 并带上检查报告
+func sendFailed(c *gin.Context, err error) {
+	var dry *preflight.DryRunError
+	if errors.As(err, &dry) {
+		c.JSON(http.StatusOK, gin.H{"msg": err.Error(), "report": dry.Report.String()})
+		return
+	}
+	c.JSON(status(err), gin.H{"msg": err.Error()})
+}
+
+// status 把客户端的预检错误映射成 HTTP 状态码，其他错误视为节点错误
+func status(err error) int {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrNotOwner):
+		return http.StatusForbidden
+	case errors.Is(err, ErrExecuted), errors.Is(err, ErrAlreadyApproved),
+		errors.Is(err, ErrNotApproved), errors.Is(err, ErrBelowThreshold):
+		return http.StatusConflict
+	default:
+		return http.StatusBadGateway
+	}
+}
@@ -0,0 +1,122 @@
+package orders
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+)
+
+// Register 注册订单的 HTTP 接口
+//
+//	GET  /orders                    所有订单的当前状态
+//	GET  /orders/:address           状态、各状态到达时间和变化历史
+//	POST /orders                    {"address": "0x...", "kind": "shipping|shopping", "fromBlock": 123}
+//	GET  /orders/:address/events    Server-Sent Events，只推送这个订单的变化
+//	GET  /events                    Server-Sent Events，推送所有订单的变化
+func Register(r gin.IRouter, w *Watcher, hub *Hub) {
+	h := &handler{watcher: w, hub: hub}
+	g := r.Group("/orders")
+	g.GET("", h.list)
+	g.GET("/:address", h.get)
+	g.GET("/:address/events", h.events)
+	g.POST("", h.add)
+	r.GET("/events", h.events)
+}
+
+type handler struct {
+	watcher *Watcher
+	hub     *Hub
+}
+
+func (h *handler) list(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"orders": h.watcher.Orders()})
+}
+
+func (h *handler) get(c *gin.Context) {
+	address, ok := orderAddress(c)
+	if !ok {
+		return
+	}
+	o, err := h.watcher.Order(address)
+	if errors.Is(err, ErrUnknownOrder) {
+		c.JSON(http.StatusNotFound, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, o)
+}
+
+func (h *handler) add(c *gin.Context) {
+	var req struct {
+		Address   string `json:"address" binding:"required"`
+		Kind      string `json:"kind" binding:"required"`
+		FromBlock uint64 `json:"fromBlock"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	if !common.IsHexAddress(req.Address) {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid address"})
+		return
+	}
+	kind, err := KindByName(req.Kind)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	o, err := h.watcher.Add(c.Request.Context(), common.HexToAddress(req.Address), kind, req.FromBlock)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, o)
+}
+
+// events 以 SSE 推送状态变化，事件名是 status，数据是 Change 的 JSON
+func (h *handler) events(c *gin.Context) {
+	var filter *common.Address
+	if c.Param("address") != "" {
+		address, ok := orderAddress(c)
+		if !ok {
+			return
+		}
+		if _, err := h.watcher.Order(address); err != nil {
+			c.JSON(http.StatusNotFound, gin.H{"msg": err.Error()})
+			return
+		}
+		filter = &address
+	}
+	changes, cancel := h.hub.Subscribe(16)
+	defer cancel()
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Header("Content-Type", "text/event-stream")
+	// 先把响应头发出去，否则客户端要等到第一条变化才算连上
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case change, ok := <-changes:
+			if !ok {
+				return false
+			}
+			if filter == nil || change.Order == *filter {
+				c.SSEvent("status", change)
+			}
+			return true
+		}
+	})
+}
+
+func orderAddress(c *gin.Context) (common.Address, bool) {
+	if !common.IsHexAddress(c.Param("address")) {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid address"})
+		return common.Address{}, false
+	}
+	return common.HexToAddress(c.Param("address")), true
+}
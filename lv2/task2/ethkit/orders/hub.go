@@ -0,0 +1,275 @@
+package orders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// Hub 把状态变化广播给所有订阅者。SSE 这类 channel 订阅者处理不过来时丢弃这条推送而不是阻塞 Watcher；
+// Queue 订阅者不限长度，一条都不丢
+type Hub struct {
+	mu     sync.Mutex
+	subs   map[chan Change]struct{}
+	queues map[*Queue]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{subs: map[chan Change]struct{}{}, queues: map[*Queue]struct{}{}}
+}
+
+// Subscribe 返回一个接收变化的 channel 和取消订阅的函数
+func (h *Hub) Subscribe(buffer int) (<-chan Change, func()) {
+	ch := make(chan Change, buffer)
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subs, ch)
+			h.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// Queue 打开 path 上的队列（为空时只在内存里）并订阅 hub，返回队列和取消订阅的函数。
+// 要在 Watcher 开始推送之前调用，否则之前的变化不会进队列
+func (h *Hub) Queue(path string) (*Queue, func(), error) {
+	q, err := OpenQueue(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	h.mu.Lock()
+	h.queues[q] = struct{}{}
+	h.mu.Unlock()
+	return q, func() {
+		h.mu.Lock()
+		delete(h.queues, q)
+		h.mu.Unlock()
+	}, nil
+}
+
+func (h *Hub) Publish(c Change) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for q := range h.queues {
+		q.push(c)
+	}
+	for ch := range h.subs {
+		select {
+		case ch <- c:
+		default:
+			log.Printf("orders: subscriber is full, dropped %s %s -> %s", c.Order.Hex(), c.Transition.From, c.Transition.To)
+		}
+	}
+}
+
+// Queue 是一个订阅者待推送的变化，按推送顺序排队。设置了 path 时每次变动都落盘，
+// 进程重启后没送达的变化还在
+type Queue struct {
+	path string
+
+	mu      sync.Mutex
+	pending []Change
+	ready   chan struct{} // 队列从空变成非空时发信号
+}
+
+// OpenQueue 读取 path 上没送达的变化，文件不存在时是空队列
+func OpenQueue(path string) (*Queue, error) {
+	q := &Queue{path: path, ready: make(chan struct{}, 1)}
+	if path == "" {
+		return q, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return q, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &q.pending); err != nil {
+		return nil, fmt.Errorf("orders queue %s: %w", path, err)
+	}
+	if len(q.pending) > 0 {
+		q.ready <- struct{}{}
+	}
+	return q, nil
+}
+
+// Len 返回还没送达的变化数
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+func (q *Queue) push(c Change) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.pending = append(q.pending, c)
+	if err := q.save(); err != nil {
+		log.Printf("orders: save queue %s: %v", q.path, err)
+	}
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Next 返回队首的变化，队列为空时等到有变化或 ctx 取消。送达后调用 Done 出队
+func (q *Queue) Next(ctx context.Context) (Change, error) {
+	for {
+		q.mu.Lock()
+		if len(q.pending) > 0 {
+			c := q.pending[0]
+			q.mu.Unlock()
+			return c, nil
+		}
+		q.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return Change{}, ctx.Err()
+		case <-q.ready:
+		}
+	}
+}
+
+// Done 把 Next 返回的队首出队
+func (q *Queue) Done() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.pending) == 0 {
+		return nil
+	}
+	q.pending = q.pending[1:]
+	return q.save()
+}
+
+// save 先写临时文件再改名，调用方持有 mu
+func (q *Queue) save() error {
+	if q.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(q.pending, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := q.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, q.path)
+}
+
+// Webhook 把队列里的变化逐条以 JSON POST 到 URL。失败时按 Backoff、2×Backoff… 重试 Retries 次，
+// 仍然失败就等 Pause 再从这条重来，变化留在队首不会丢，也不会乱序。
+// 对方返回 4xx（408、429 除外）时重试没有意义，记日志后跳过这条
+type Webhook struct {
+	URL     string
+	Retries int
+	Backoff time.Duration // 默认 1s
+	Pause   time.Duration // 默认 1 分钟
+	Client  *http.Client
+}
+
+// Run 推送 q 里的变化，直到 ctx 取消
+func (wh *Webhook) Run(ctx context.Context, q *Queue) {
+	client := wh.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	pause := wh.Pause
+	if pause == 0 {
+		pause = time.Minute
+	}
+	for {
+		c, err := q.Next(ctx)
+		if err != nil {
+			return
+		}
+		err = wh.deliver(ctx, client, c)
+		if ctx.Err() != nil {
+			return
+		}
+		var rejected *rejectedError
+		switch {
+		case err == nil:
+		case errors.As(err, &rejected):
+			log.Printf("orders: webhook %s rejected %s %s -> %s: %v", wh.URL, c.Order.Hex(), c.Transition.From, c.Transition.To, err)
+		default:
+			log.Printf("orders: webhook %s: %v, %d changes queued", wh.URL, err, q.Len())
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(pause):
+			}
+			continue
+		}
+		if err := q.Done(); err != nil {
+			log.Printf("orders: save queue %s: %v", q.path, err)
+		}
+	}
+}
+
+func (wh *Webhook) deliver(ctx context.Context, client *http.Client, c Change) error {
+	body, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	delay := wh.Backoff
+	if delay == 0 {
+		delay = time.Second
+	}
+	for attempt := 0; ; attempt++ {
+		err = wh.post(ctx, client, body)
+		var rejected *rejectedError
+		if err == nil || errors.As(err, &rejected) || attempt >= wh.Retries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func (wh *Webhook) post(ctx context.Context, client *http.Client, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode < 500 && resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests:
+		return &rejectedError{resp.Status}
+	}
+	return fmt.Errorf("status %s", resp.Status)
+}
+
+// rejectedError 是对方明确拒收的响应，重试也不会成功
+type rejectedError struct {
+	status string
+}
+
+func (e *rejectedError) Error() string {
+	return "status " + e.status
+}
@@ -0,0 +1,140 @@
+package orders
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func change(i int) Change {
+	return Change{Order: common.BigToAddress(common.Big1), Kind: "shipping", Transition: Transition{Block: uint64(i)}}
+}
+
+// webhookServer 记录收到的变化；fail 返回下一次请求应答的状态码，0 表示成功
+type webhookServer struct {
+	mu       sync.Mutex
+	requests int
+	received []uint64
+	fail     func(requests int, c Change) int
+}
+
+func (s *webhookServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var c Change
+	if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if code := s.fail(s.requests, c); code != 0 {
+		w.WriteHeader(code)
+		return
+	}
+	s.received = append(s.received, c.Transition.Block)
+}
+
+// waitEmpty 等 webhook 把队列推完
+func waitEmpty(t *testing.T, q *Queue) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for q.Len() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d changes still queued", q.Len())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// 对方暂时不可用时变化留在队列里，超过 channel 缓冲的数量也一条不丢、不乱序；4xx 的那条跳过
+func TestWebhookQueue(t *testing.T) {
+	srv := &webhookServer{fail: func(requests int, c Change) int {
+		switch {
+		case requests <= 5: // 第一条的 Retries+1 次尝试全失败，暂停后再成功
+			return http.StatusServiceUnavailable
+		case c.Transition.Block == 50:
+			return http.StatusUnprocessableEntity
+		}
+		return 0
+	}}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	hub := NewHub()
+	q, cancel, err := hub.Queue("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+	sse, stop := hub.Subscribe(1)
+	defer stop()
+	for i := 0; i < 100; i++ {
+		hub.Publish(change(i))
+	}
+	if q.Len() != 100 || len(sse) != 1 {
+		t.Fatalf("queued %d, sse buffered %d", q.Len(), len(sse))
+	}
+
+	ctx, done := context.WithCancel(context.Background())
+	defer done()
+	go (&Webhook{URL: ts.URL, Retries: 2, Backoff: time.Millisecond, Pause: 10 * time.Millisecond}).Run(ctx, q)
+	waitEmpty(t, q)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if len(srv.received) != 99 {
+		t.Fatalf("received %d changes", len(srv.received))
+	}
+	for i, block := range srv.received {
+		want := uint64(i)
+		if i >= 50 {
+			want++
+		}
+		if block != want {
+			t.Fatalf("change %d is block %d, want %d", i, block, want)
+		}
+	}
+	// 5 次 503，被拒的那条只发一次
+	if srv.requests != 105 {
+		t.Fatalf("%d requests", srv.requests)
+	}
+}
+
+// 队列落盘，重启后没送达的变化还在
+func TestQueuePersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue.json")
+	hub := NewHub()
+	q, cancel, err := hub.Queue(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		hub.Publish(change(i))
+	}
+	cancel()
+	hub.Publish(change(3))
+	if err := q.Done(); err != nil {
+		t.Fatal(err)
+	}
+
+	q, err = OpenQueue(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Len() != 2 {
+		t.Fatalf("reopened queue has %d changes", q.Len())
+	}
+	ctx, done := context.WithTimeout(context.Background(), time.Second)
+	defer done()
+	c, err := q.Next(ctx)
+	if err != nil || c.Transition.Block != 1 {
+		t.Fatalf("next = %+v, %v", c, err)
+	}
+}
@@ -0,0 +1,66 @@
+package orders
+
+import (
+	"fmt"
+
+	"ethkit/contracts/shipping"
+)
+
+// Kind 描述一种订单合约：状态的先后顺序，以及 LogNewAlert 的文案对应哪个状态。
+// 两个合约的事件只带一句描述，状态只能从文案推断，文案对不上时回退到读取 Status()
+type Kind struct {
+	Name     string
+	ABI      string
+	Statuses []string          // 第一个是初始状态，正常流程按顺序逐个前进
+	Alerts   map[string]string // 事件文案 -> 状态
+}
+
+var (
+	// Shipping 是 lv0/task3 的 Shipping.sol
+	Shipping = Kind{
+		Name:     "shipping",
+		ABI:      shipping.ShippingMetaData.ABI,
+		Statuses: []string{"Pending", "Shipped", "Delivered"},
+		Alerts: map[string]string{
+			"Your package has been shipped": "Shipped",
+			"Your package has arrived":      "Delivered",
+		},
+	}
+	// Shopping 是 lv0/task3 的 Shopping.sol
+	Shopping = Kind{
+		Name:     "shopping",
+		ABI:      shipping.ShoppingMetaData.ABI,
+		Statuses: []string{"Pending", "Shopped", "Delivered"},
+		Alerts: map[string]string{
+			"Your food order has been start to delivery": "Shopped",
+			"Your food order has arrived":                "Delivered",
+		},
+	}
+)
+
+// KindByName 按名称查找 Kind
+func KindByName(name string) (Kind, error) {
+	switch name {
+	case Shipping.Name:
+		return Shipping, nil
+	case Shopping.Name:
+		return Shopping, nil
+	}
+	return Kind{}, fmt.Errorf("orders: unknown kind %q", name)
+}
+
+func (k Kind) index(status string) int {
+	for i, s := range k.Statuses {
+		if s == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// Valid 报告 from -> to 是不是正常流程里的下一步。合约本身不限制状态跳转，
+// 比如可以直接 Delivered 或者重复 Shipped，这类跳转照样记录，只是标记为不合规
+func (k Kind) Valid(from, to string) bool {
+	i := k.index(from)
+	return i >= 0 && k.index(to) == i+1
+}
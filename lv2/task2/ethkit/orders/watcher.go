@@ -0,0 +1,326 @@
+package orders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var ErrUnknownOrder = errors.New("orders: unknown order")
+
+// Transition 是一次状态变化，对应一条 LogNewAlert
+type Transition struct {
+	From        string      `json:"from"`
+	To          string      `json:"to"`
+	Description string      `json:"description"`
+	Valid       bool        `json:"valid"` // 是否是正常流程里的下一步
+	Block       uint64      `json:"block"`
+	TxHash      common.Hash `json:"txHash"`
+	LogIndex    uint        `json:"logIndex"`
+	Time        time.Time   `json:"time"`
+}
+
+// Order 是一个订单合约实例的状态机
+type Order struct {
+	Address    common.Address       `json:"address"`
+	Kind       string               `json:"kind"`
+	Status     string               `json:"status"`
+	Reached    map[string]time.Time `json:"reached"` // 每个状态第一次到达的区块时间
+	History    []Transition         `json:"history"`
+	Synced     uint64               `json:"synced"` // 已经处理到的区块
+	LastUpdate time.Time            `json:"lastUpdate"`
+}
+
+// Change 是推送给订阅者的状态变化
+type Change struct {
+	Order      common.Address `json:"order"`
+	Kind       string         `json:"kind"`
+	Transition Transition     `json:"transition"`
+}
+
+// Backend 是 Watcher 需要的链接口，*ethclient.Client 和 simulated.Client 都满足
+type Backend interface {
+	bind.ContractBackend
+	ethereum.BlockNumberReader
+}
+
+type Config struct {
+	Interval      time.Duration
+	Confirmations uint64 // 只处理至少这么多确认的区块，避免重组后推送错误的状态
+	StatePath     string // 为空时不落盘
+}
+
+// Watcher 轮询订单合约的 LogNewAlert，维护每个订单的状态并推送变化
+type Watcher struct {
+	cfg     Config
+	backend Backend
+	hub     *Hub
+
+	mu     sync.RWMutex
+	orders map[common.Address]*Order
+}
+
+func New(cfg Config, backend Backend, hub *Hub) (*Watcher, error) {
+	if cfg.Interval == 0 {
+		cfg.Interval = 5 * time.Second
+	}
+	w := &Watcher{cfg: cfg, backend: backend, hub: hub, orders: map[common.Address]*Order{}}
+	if cfg.StatePath == "" {
+		return w, nil
+	}
+	data, err := os.ReadFile(cfg.StatePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return w, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &w.orders); err != nil {
+		return nil, fmt.Errorf("orders state %s: %w", cfg.StatePath, err)
+	}
+	return w, nil
+}
+
+// Add 开始跟踪一个订单合约。fromBlock 为合约部署区块时从初始状态开始回放事件；
+// 为 0 时读取当前的 Status() 作为起点，只跟踪之后的变化
+func (w *Watcher) Add(ctx context.Context, address common.Address, kind Kind, fromBlock uint64) (Order, error) {
+	o := &Order{Address: address, Kind: kind.Name, Status: kind.Statuses[0], Reached: map[string]time.Time{}}
+	if fromBlock > 0 {
+		o.Synced = fromBlock - 1
+	} else {
+		head, err := w.backend.BlockNumber(ctx)
+		if err != nil {
+			return Order{}, err
+		}
+		if o.Status, err = w.status(ctx, address, kind, new(big.Int).SetUint64(head)); err != nil {
+			return Order{}, err
+		}
+		o.Synced = head
+		o.LastUpdate = time.Now()
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.orders[address]; ok {
+		return Order{}, fmt.Errorf("orders: %s is already tracked", address.Hex())
+	}
+	w.orders[address] = o
+	return copyOrder(o), w.save()
+}
+
+// Run 轮询直到 ctx 取消
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if err := w.Poll(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("orders: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll 拉取所有订单从各自进度到安全高度之间的 LogNewAlert，按链上顺序推进状态机
+func (w *Watcher) Poll(ctx context.Context) error {
+	head, err := w.backend.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if head < w.cfg.Confirmations {
+		return nil
+	}
+	safe := head - w.cfg.Confirmations
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	from := safe + 1
+	var addresses []common.Address
+	for address, o := range w.orders {
+		if o.Synced < safe {
+			addresses = append(addresses, address)
+			from = min(from, o.Synced+1)
+		}
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+	logs, err := w.backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(safe),
+		Addresses: addresses,
+		Topics:    [][]common.Hash{{alertTopic}},
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+
+	times := map[uint64]time.Time{}
+	var changes []Change
+	for _, l := range logs {
+		o := w.orders[l.Address]
+		if o == nil || l.Removed || l.BlockNumber <= o.Synced {
+			continue
+		}
+		t, err := w.transition(ctx, o, l, times)
+		if err != nil {
+			return err
+		}
+		o.apply(t)
+		changes = append(changes, Change{Order: o.Address, Kind: o.Kind, Transition: t})
+	}
+	for _, address := range addresses {
+		w.orders[address].Synced = safe
+	}
+	if err := w.save(); err != nil {
+		return err
+	}
+	// 先落盘再推送，推送出去的变化重启后不会再推一次
+	for _, c := range changes {
+		if !c.Transition.Valid {
+			log.Printf("orders: %s %s: unexpected transition %s -> %s in tx %s",
+				c.Kind, c.Order.Hex(), c.Transition.From, c.Transition.To, c.Transition.TxHash.Hex())
+		}
+		w.hub.Publish(c)
+	}
+	return nil
+}
+
+func (w *Watcher) transition(ctx context.Context, o *Order, l types.Log, times map[uint64]time.Time) (Transition, error) {
+	kind, err := KindByName(o.Kind)
+	if err != nil {
+		return Transition{}, err
+	}
+	values, err := alertABI.Unpack("LogNewAlert", l.Data)
+	if err != nil {
+		return Transition{}, fmt.Errorf("decode LogNewAlert in tx %s: %w", l.TxHash.Hex(), err)
+	}
+	description := values[0].(string)
+	to, ok := kind.Alerts[description]
+	if !ok {
+		// 文案对不上（合约改过文案），读取该区块末尾的状态
+		if to, err = w.status(ctx, o.Address, kind, new(big.Int).SetUint64(l.BlockNumber)); err != nil {
+			return Transition{}, err
+		}
+	}
+	at, ok := times[l.BlockNumber]
+	if !ok {
+		header, err := w.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(l.BlockNumber))
+		if err != nil {
+			return Transition{}, err
+		}
+		at = time.Unix(int64(header.Time), 0).UTC()
+		times[l.BlockNumber] = at
+	}
+	return Transition{
+		From:        o.Status,
+		To:          to,
+		Description: description,
+		Valid:       kind.Valid(o.Status, to),
+		Block:       l.BlockNumber,
+		TxHash:      l.TxHash,
+		LogIndex:    l.Index,
+		Time:        at,
+	}, nil
+}
+
+func (o *Order) apply(t Transition) {
+	o.Status = t.To
+	if _, ok := o.Reached[t.To]; !ok {
+		o.Reached[t.To] = t.Time
+	}
+	o.History = append(o.History, t)
+	o.LastUpdate = t.Time
+}
+
+// status 调用合约的 Status()
+func (w *Watcher) status(ctx context.Context, address common.Address, kind Kind, block *big.Int) (string, error) {
+	parsed, err := abi.JSON(strings.NewReader(kind.ABI))
+	if err != nil {
+		return "", err
+	}
+	contract := bind.NewBoundContract(address, parsed, w.backend, w.backend, w.backend)
+	var out []any
+	if err := contract.Call(&bind.CallOpts{Context: ctx, BlockNumber: block}, &out, "Status"); err != nil {
+		return "", err
+	}
+	return out[0].(string), nil
+}
+
+// Orders 返回所有订单，按地址排序
+func (w *Watcher) Orders() []Order {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	out := make([]Order, 0, len(w.orders))
+	for _, o := range w.orders {
+		out = append(out, copyOrder(o))
+	}
+	sort.Slice(out, func(i, j int) bool { return bytes.Compare(out[i].Address[:], out[j].Address[:]) < 0 })
+	return out
+}
+
+func (w *Watcher) Order(address common.Address) (Order, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	o, ok := w.orders[address]
+	if !ok {
+		return Order{}, ErrUnknownOrder
+	}
+	return copyOrder(o), nil
+}
+
+// save 先写临时文件再改名，调用方持有 mu
+func (w *Watcher) save() error {
+	if w.cfg.StatePath == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(w.orders, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := w.cfg.StatePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, w.cfg.StatePath)
+}
+
+func copyOrder(o *Order) Order {
+	out := *o
+	out.Reached = make(map[string]time.Time, len(o.Reached))
+	for k, v := range o.Reached {
+		out.Reached[k] = v
+	}
+	out.History = append([]Transition(nil), o.History...)
+	return out
+}
+
+// 两个合约的 LogNewAlert 签名相同（参数名不同不影响 topic）
+var (
+	alertABI, _ = abi.JSON(strings.NewReader(Shipping.ABI))
+	alertTopic  = alertABI.Events["LogNewAlert"].ID
+)
@@ -0,0 +1,121 @@
+package orders
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"ethkit/contracts/shipping"
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func history(o Order) string {
+	var out []string
+	for _, t := range o.History {
+		out = append(out, fmt.Sprintf("%s->%s:%v", t.From, t.To, t.Valid))
+	}
+	return fmt.Sprint(out)
+}
+
+// 按事件回放的 Shipping 和从当前 Status() 开始跟踪的 Shopping，重启后从状态文件恢复，不重复推送
+func TestWatcher(t *testing.T) {
+	chain, err := simchain.New(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	ctx := context.Background()
+	auth := chain.Transactor(0)
+	mine := func(tx *types.Transaction, err error) *types.Receipt {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipt, err := chain.Mine(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return receipt
+	}
+
+	shipAddr, tx, ship, err := shipping.DeployShipping(auth, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployBlock := mine(tx, nil).BlockNumber.Uint64()
+	shopAddr, tx, shop, err := shipping.DeployShopping(auth, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine(tx, nil)
+	mine(ship.Shipped(auth))
+	mine(shop.Shopped(auth))
+
+	statePath := filepath.Join(t.TempDir(), "orders.json")
+	hub := NewHub()
+	q, cancel, err := hub.Queue("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+	w, err := New(Config{StatePath: statePath}, chain.Client, hub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Add(ctx, shipAddr, Shipping, deployBlock); err != nil {
+		t.Fatal(err)
+	}
+	o, err := w.Add(ctx, shopAddr, Shopping, 0)
+	if err != nil || o.Status != "Shopped" || len(o.History) != 0 {
+		t.Fatalf("shopping added at = %+v, %v", o, err)
+	}
+	if _, err := w.Add(ctx, shipAddr, Shipping, 0); err == nil {
+		t.Fatal("added the same order twice")
+	}
+
+	mine(ship.Delivered(auth))
+	// 合约不限制跳转，再 Shipped 一次照样记录，标记为不合规
+	mine(ship.Shipped(auth))
+	mine(shop.Devliverd(auth))
+	if err := w.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	o, err = w.Order(shipAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shipped := o
+	if got := history(o); o.Status != "Shipped" || got != "[Pending->Shipped:true Shipped->Delivered:true Delivered->Shipped:false]" {
+		t.Fatalf("shipping %s: %s", o.Status, got)
+	}
+	if len(o.Reached) != 2 || o.Reached["Shipped"] != o.History[0].Time || o.Reached["Delivered"] != o.History[1].Time {
+		t.Fatalf("reached = %v", o.Reached)
+	}
+	if o, err = w.Order(shopAddr); err != nil || o.Status != "Delivered" || history(o) != "[Shopped->Delivered:true]" {
+		t.Fatalf("shopping = %+v, %v", o, err)
+	}
+	if q.Len() != 4 {
+		t.Fatalf("published %d changes", q.Len())
+	}
+
+	// 重启后状态一致，再轮询不会重复推送
+	if w, err = New(Config{StatePath: statePath}, chain.Client, hub); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []Order{shipped, o} {
+		got, err := w.Order(want.Address)
+		if err != nil || got.Status != want.Status || history(got) != history(want) || got.Synced != want.Synced {
+			t.Fatalf("restored %s = %+v, %v", want.Kind, got, err)
+		}
+	}
+	chain.Commit()
+	if err := w.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if q.Len() != 4 {
+		t.Fatalf("republished after restart, %d changes", q.Len())
+	}
+}
@@ -0,0 +1,125 @@
+package portfolio
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+)
+
+// Register 注册查询接口，address 可以重复或用逗号分隔
+//
+//	GET /tokens                                    代币列表
+//	GET /portfolio?address=0x..&block=123          某个区块（默认最新）上的余额
+//	GET /portfolio/history?address=0x..&blocks=100,200,300
+//	GET /portfolio/history?address=0x..&from=100&to=300&step=100
+func Register(r gin.IRouter, s *Service) {
+	r.GET("/tokens", func(c *gin.Context) {
+		tokens, err := s.Tokens(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, tokens)
+	})
+
+	r.GET("/portfolio", func(c *gin.Context) {
+		owners, err := addresses(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+			return
+		}
+		block, err := blockParam(c.Query("block"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+			return
+		}
+		snap, err := s.At(c.Request.Context(), owners, block)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, snap)
+	})
+
+	r.GET("/portfolio/history", func(c *gin.Context) {
+		owners, err := addresses(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+			return
+		}
+		blocks, err := historyBlocks(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+			return
+		}
+		snaps, err := s.History(c.Request.Context(), owners, blocks)
+		if err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, snaps)
+	})
+}
+
+// maxSnapshots 限制一次 history 请求的快照数，每个快照都要对节点发一轮请求
+const maxSnapshots = 100
+
+func addresses(c *gin.Context) ([]common.Address, error) {
+	var owners []common.Address
+	for _, v := range c.QueryArray("address") {
+		for _, s := range strings.Split(v, ",") {
+			if !common.IsHexAddress(s) {
+				return nil, fmt.Errorf("invalid address %q", s)
+			}
+			owners = append(owners, common.HexToAddress(s))
+		}
+	}
+	if len(owners) == 0 {
+		return nil, fmt.Errorf("address is required")
+	}
+	return owners, nil
+}
+
+// blockParam 解析区块号，空字符串和 latest 表示最新区块
+func blockParam(s string) (*big.Int, error) {
+	if s == "" || s == "latest" {
+		return nil, nil
+	}
+	n, err := strconv.ParseUint(s, 0, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block %q", s)
+	}
+	return new(big.Int).SetUint64(n), nil
+}
+
+func historyBlocks(c *gin.Context) ([]*big.Int, error) {
+	if list := c.Query("blocks"); list != "" {
+		var blocks []*big.Int
+		for _, s := range strings.Split(list, ",") {
+			b, err := blockParam(strings.TrimSpace(s))
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, b)
+		}
+		if len(blocks) > maxSnapshots {
+			return nil, fmt.Errorf("at most %d blocks", maxSnapshots)
+		}
+		return blocks, nil
+	}
+	from, err1 := strconv.ParseUint(c.Query("from"), 10, 64)
+	to, err2 := strconv.ParseUint(c.Query("to"), 10, 64)
+	step, err3 := strconv.ParseUint(c.DefaultQuery("step", "1"), 10, 64)
+	if err1 != nil || err2 != nil || err3 != nil || step == 0 || to < from {
+		return nil, fmt.Errorf("want blocks=a,b,c or from, to and step")
+	}
+	if (to-from)/step+1 > maxSnapshots {
+		return nil, fmt.Errorf("at most %d snapshots, increase step", maxSnapshots)
+	}
+	return Range(from, to, step), nil
+}
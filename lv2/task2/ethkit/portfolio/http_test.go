@@ -0,0 +1,100 @@
+package portfolio
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ethkit/multicall"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestHTTP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	f := setup(t)
+	r := gin.New()
+	Register(r, f.service(t, f.chain.Client, multicall.New(f.chain.Client)))
+
+	owners := f.owners[1].Hex() + "," + f.owners[2].Hex()
+	last := f.blocks[2].Uint64()
+	tests := []struct {
+		name  string
+		path  string
+		code  int
+		check func(t *testing.T, body []byte)
+	}{
+		{name: "tokens", path: "/tokens", code: http.StatusOK, check: func(t *testing.T, body []byte) {
+			var tokens []Token
+			if err := json.Unmarshal(body, &tokens); err != nil || len(tokens) != 2 || tokens[0].Symbol != "RCC" || *tokens[0].Decimals != 18 {
+				t.Fatalf("%s", body)
+			}
+		}},
+		{name: "latest", path: "/portfolio?address=" + f.owners[0].Hex() + "," + owners, code: http.StatusOK, check: func(t *testing.T, body []byte) {
+			var snap Snapshot
+			if err := json.Unmarshal(body, &snap); err != nil {
+				t.Fatal(err)
+			}
+			f.checkSnapshot(t, &snap, f.blocks[2])
+		}},
+		{name: "repeated address parameter", path: fmt.Sprintf("/portfolio?address=%s&address=%s&block=%d", f.owners[1].Hex(), f.owners[2].Hex(), last), code: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				var snap Snapshot
+				if err := json.Unmarshal(body, &snap); err != nil || snap.Block != last || len(snap.Holdings) != 2 ||
+					snap.Holdings[0].Tokens[0].Formatted != "1.5" || snap.Holdings[1].Tokens[1].Formatted != "2.5" {
+					t.Fatalf("%s", body)
+				}
+			}},
+		{name: "hex block", path: fmt.Sprintf("/portfolio?address=%s&block=%#x", owners, f.blocks[0]), code: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				var snap Snapshot
+				if err := json.Unmarshal(body, &snap); err != nil || snap.Block != f.blocks[0].Uint64() || snap.Holdings[1].Tokens[1].Error == "" {
+					t.Fatalf("%s", body)
+				}
+			}},
+		{name: "history blocks", path: fmt.Sprintf("/portfolio/history?address=%s&blocks=%d,%d", owners, last-1, last), code: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				var snaps []Snapshot
+				if err := json.Unmarshal(body, &snaps); err != nil || len(snaps) != 2 || snaps[0].Block != last-1 || snaps[1].Block != last ||
+					snaps[0].Holdings[1].Tokens[1].Formatted != "0" || snaps[1].Holdings[1].Tokens[1].Formatted != "2.5" {
+					t.Fatalf("%s", body)
+				}
+			}},
+		{name: "history range", path: fmt.Sprintf("/portfolio/history?address=%s&from=1&to=%d&step=2", owners, last), code: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				var snaps []Snapshot
+				if err := json.Unmarshal(body, &snaps); err != nil || len(snaps) != len(Range(1, last, 2)) || snaps[len(snaps)-1].Block != last {
+					t.Fatalf("%s", body)
+				}
+			}},
+		{name: "missing address", path: "/portfolio", code: http.StatusBadRequest},
+		{name: "invalid address", path: "/portfolio?address=0x1234", code: http.StatusBadRequest},
+		{name: "invalid block", path: "/portfolio?address=" + owners + "&block=abc", code: http.StatusBadRequest},
+		{name: "future block", path: "/portfolio?address=" + owners + "&block=100000", code: http.StatusBadGateway},
+		{name: "history without blocks", path: "/portfolio/history?address=" + owners, code: http.StatusBadRequest},
+		{name: "history to before from", path: "/portfolio/history?address=" + owners + "&from=5&to=1", code: http.StatusBadRequest},
+		{name: "history zero step", path: "/portfolio/history?address=" + owners + "&from=1&to=5&step=0", code: http.StatusBadRequest},
+		{name: "history too many snapshots", path: "/portfolio/history?address=" + owners + "&from=0&to=1000", code: http.StatusBadRequest},
+		{name: "history too many blocks", path: "/portfolio/history?address=" + owners + "&blocks=" + strings.Repeat("1,", maxSnapshots) + "1", code: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if w.Code != tt.code {
+				t.Fatalf("status %d, want %d: %s", w.Code, tt.code, w.Body)
+			}
+			if tt.code != http.StatusOK {
+				var resp struct{ Msg string }
+				if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || resp.Msg == "" {
+					t.Fatalf("error body %s", w.Body)
+				}
+				return
+			}
+			tt.check(t, w.Body.Bytes())
+		})
+	}
+}
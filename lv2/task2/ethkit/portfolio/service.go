@@ -0,0 +1,190 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"ethkit/contracts/token"
+	"ethkit/multicall"
+	"ethkit/units"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Backend 是查询余额需要的链接口，*ethclient.Client 和 simulated.Client 都满足
+type Backend interface {
+	bind.ContractCaller
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Balance 是一个地址持有的一种资产，Token 为零地址表示 ETH
+type Balance struct {
+	Token     common.Address `json:"token"`
+	Symbol    string         `json:"symbol"`
+	Decimals  uint8          `json:"decimals"`
+	Raw       *big.Int       `json:"raw,omitempty"`
+	Formatted string         `json:"formatted,omitempty"`
+	Error     string         `json:"error,omitempty"` // 比如代币在这个区块还没部署
+}
+
+type Holding struct {
+	Owner  common.Address `json:"owner"`
+	ETH    Balance        `json:"eth"`
+	Tokens []Balance      `json:"tokens"`
+}
+
+// Snapshot 是一组地址在同一个区块上的全部余额
+type Snapshot struct {
+	Block    uint64      `json:"block"`
+	Hash     common.Hash `json:"hash"`
+	Time     uint64      `json:"time"`
+	Holdings []Holding   `json:"holdings"`
+}
+
+// Service 查询一组地址在某个区块上的 ETH 和代币余额，代币余额通过 multicall 一次读完
+type Service struct {
+	backend Backend
+	mc      *multicall.Client
+	erc20   *abi.ABI
+
+	mu       sync.Mutex
+	tokens   []Token
+	resolved bool
+}
+
+func New(backend Backend, mc *multicall.Client, tokens []Token) (*Service, error) {
+	parsed, err := token.TokenMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Service{backend: backend, mc: mc, erc20: parsed, tokens: tokens}, nil
+}
+
+// Tokens 返回代币列表，没填的 symbol、decimals 会先从合约读取
+func (s *Service) Tokens(ctx context.Context) ([]Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.resolved {
+		if err := s.resolve(ctx); err != nil {
+			return nil, err
+		}
+		s.resolved = true
+	}
+	return append([]Token(nil), s.tokens...), nil
+}
+
+// resolve 在最新区块上补齐 symbol 和 decimals。读不到 decimals 的代币没法格式化，直接报错
+func (s *Service) resolve(ctx context.Context) error {
+	var calls []*multicall.Call
+	for _, t := range s.tokens {
+		if t.Symbol == "" {
+			calls = append(calls, multicall.NewCall(t.Address, s.erc20, "symbol"))
+		}
+		if t.Decimals == nil {
+			calls = append(calls, multicall.NewCall(t.Address, s.erc20, "decimals"))
+		}
+	}
+	if err := s.mc.Do(ctx, nil, calls...); err != nil {
+		return err
+	}
+	next := 0
+	for i := range s.tokens {
+		t := &s.tokens[i]
+		if t.Symbol == "" {
+			if err := calls[next].Unpack(&t.Symbol); err != nil {
+				t.Symbol = t.Address.Hex()[:10] // 非标准代币（比如 symbol 返回 bytes32）用地址代替
+			}
+			next++
+		}
+		if t.Decimals == nil {
+			var decimals uint8
+			if err := calls[next].Unpack(&decimals); err != nil {
+				return fmt.Errorf("portfolio: decimals of %s: %w", t.Address.Hex(), err)
+			}
+			t.Decimals = &decimals
+			next++
+		}
+	}
+	return nil
+}
+
+// At 查询 owners 在区块 block（nil 为最新）上的余额。所有查询都固定在同一个区块号上，
+// 中途出新块也不会混进不同区块的数据
+func (s *Service) At(ctx context.Context, owners []common.Address, block *big.Int) (*Snapshot, error) {
+	tokens, err := s.Tokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	head, err := s.backend.HeaderByNumber(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	number := head.Number
+
+	calls := make([]*multicall.Call, 0, len(owners)*len(tokens))
+	for _, owner := range owners {
+		for _, t := range tokens {
+			calls = append(calls, multicall.NewCall(t.Address, s.erc20, "balanceOf", owner))
+		}
+	}
+	if err := s.mc.Do(ctx, number, calls...); err != nil {
+		return nil, err
+	}
+
+	snap := &Snapshot{Block: number.Uint64(), Hash: head.Hash(), Time: head.Time}
+	for i, owner := range owners {
+		wei, err := s.backend.BalanceAt(ctx, owner, number)
+		if err != nil {
+			return nil, err
+		}
+		h := Holding{
+			Owner:  owner,
+			ETH:    Balance{Symbol: "ETH", Decimals: 18, Raw: wei, Formatted: units.Format(wei, 18)},
+			Tokens: make([]Balance, len(tokens)),
+		}
+		for j, t := range tokens {
+			b := Balance{Token: t.Address, Symbol: t.Symbol, Decimals: *t.Decimals}
+			var raw *big.Int
+			if err := calls[i*len(tokens)+j].Unpack(&raw); err != nil {
+				b.Error = err.Error()
+			} else {
+				b.Raw, b.Formatted = raw, units.Format(raw, b.Decimals)
+			}
+			h.Tokens[j] = b
+		}
+		snap.Holdings = append(snap.Holdings, h)
+	}
+	return snap, nil
+}
+
+// History 在多个区块上各取一次快照，用于看余额变化；需要节点保留这些区块的状态（归档节点）
+func (s *Service) History(ctx context.Context, owners []common.Address, blocks []*big.Int) ([]*Snapshot, error) {
+	snaps := make([]*Snapshot, 0, len(blocks))
+	for _, block := range blocks {
+		snap, err := s.At(ctx, owners, block)
+		if err != nil {
+			return nil, fmt.Errorf("portfolio: block %s: %w", block, err)
+		}
+		snaps = append(snaps, snap)
+	}
+	return snaps, nil
+}
+
+// Range 返回 from 到 to（含）每隔 step 的区块号，to 不在步长上时也会带上
+func Range(from, to, step uint64) []*big.Int {
+	var blocks []*big.Int
+	for n := from; n <= to; n += step {
+		blocks = append(blocks, new(big.Int).SetUint64(n))
+		if n+step > to && n != to {
+			blocks = append(blocks, new(big.Int).SetUint64(to))
+			break
+		}
+	}
+	return blocks
+}
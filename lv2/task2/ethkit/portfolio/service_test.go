@@ -0,0 +1,356 @@
+package portfolio
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"ethkit/contracts/token"
+	"ethkit/multicall"
+	"ethkit/simchain"
+	"ethkit/units"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// countingCaller 记录 eth_call 的次数，用来区分走了 Multicall3 还是逐个调用
+type countingCaller struct {
+	Backend
+	calls int
+}
+
+func (c *countingCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	c.calls++
+	return c.Backend.CallContract(ctx, call, blockNumber)
+}
+
+type fixture struct {
+	chain  *simchain.Chain
+	tokens []common.Address // 两个代币
+	owners []common.Address
+	blocks [3]*big.Int // 第一个代币部署后、第二个代币部署后、转账后
+}
+
+// setup 部署两个代币，账户 0 给账户 1、2 转 ETH 和代币
+func setup(t *testing.T) *fixture {
+	t.Helper()
+	chain, err := simchain.New(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	f := &fixture{chain: chain}
+	for _, a := range chain.Accounts {
+		f.owners = append(f.owners, a.Address)
+	}
+	var bindings []*token.Token
+	for i := range 2 {
+		addr, tok, r, err := chain.DeployToken(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.tokens, bindings = append(f.tokens, addr), append(bindings, tok)
+		f.blocks[i] = r.BlockNumber
+	}
+	if _, err := chain.Fund(f.owners[1], big.NewInt(params.Ether)); err != nil {
+		t.Fatal(err)
+	}
+	transfers := []struct {
+		tok   *token.Token
+		to    common.Address
+		value *big.Int
+	}{
+		{bindings[0], f.owners[1], big.NewInt(15e17)},
+		{bindings[1], f.owners[2], big.NewInt(2_500_000)},
+	}
+	for _, tr := range transfers {
+		tx, err := tr.tok.Transfer(chain.Transactor(0), tr.to, tr.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := chain.Mine(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.blocks[2] = r.BlockNumber
+	}
+	return f
+}
+
+// service 的代币列表：第一个代币的 symbol、decimals 从合约读，第二个在列表里写成 6 位小数
+func (f *fixture) service(t *testing.T, backend Backend, mc *multicall.Client) *Service {
+	t.Helper()
+	six := uint8(6)
+	s, err := New(backend, mc, []Token{{Address: f.tokens[0]}, {Address: f.tokens[1], Symbol: "USDX", Decimals: &six}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func (f *fixture) tokenBalance(t *testing.T, tok, owner common.Address, block *big.Int) *big.Int {
+	t.Helper()
+	caller, err := token.NewTokenCaller(tok, f.chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := caller.BalanceOf(&bind.CallOpts{BlockNumber: block}, owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+// checkSnapshot 和直接查节点、用合约绑定读到的余额逐项比较
+func (f *fixture) checkSnapshot(t *testing.T, snap *Snapshot, block *big.Int) {
+	t.Helper()
+	if snap.Block != block.Uint64() || len(snap.Holdings) != len(f.owners) {
+		t.Fatalf("snapshot of block %d with %d holdings, want block %s", snap.Block, len(snap.Holdings), block)
+	}
+	for i, h := range snap.Holdings {
+		wei, err := f.chain.Client.BalanceAt(context.Background(), f.owners[i], block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.Owner != f.owners[i] || h.ETH.Raw.Cmp(wei) != 0 || h.ETH.Formatted != units.Format(wei, 18) || h.ETH.Symbol != "ETH" {
+			t.Fatalf("holding %d ETH %+v, want %s", i, h.ETH, wei)
+		}
+		if len(h.Tokens) != 2 {
+			t.Fatalf("holding %d has %d tokens", i, len(h.Tokens))
+		}
+		for j, b := range h.Tokens {
+			if block.Cmp(f.blocks[j]) < 0 {
+				// 代币在这个区块还没部署：记在 Error 里，不影响其他余额
+				if b.Error == "" || b.Raw != nil {
+					t.Fatalf("holding %d token %d before deployment: %+v", i, j, b)
+				}
+				continue
+			}
+			want := f.tokenBalance(t, f.tokens[j], f.owners[i], block)
+			if b.Error != "" || b.Raw.Cmp(want) != 0 || b.Formatted != units.Format(want, b.Decimals) {
+				t.Fatalf("holding %d token %d: %+v, want %s", i, j, b, want)
+			}
+		}
+	}
+}
+
+func TestAt(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+	s := f.service(t, f.chain.Client, multicall.New(f.chain.Client))
+
+	tokens, err := s.Tokens(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens[0].Symbol != "RCC" || *tokens[0].Decimals != 18 || tokens[1].Symbol != "USDX" || *tokens[1].Decimals != 6 {
+		t.Fatalf("tokens %+v %+v", tokens[0], tokens[1])
+	}
+
+	snap, err := s.At(ctx, f.owners, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.checkSnapshot(t, snap, f.blocks[2])
+	h1, h2 := snap.Holdings[1], snap.Holdings[2]
+	if h1.ETH.Formatted != "1001" || h1.Tokens[0].Formatted != "1.5" || h1.Tokens[1].Formatted != "0" {
+		t.Fatalf("account 1: %+v", h1)
+	}
+	if h2.ETH.Formatted != "1000" || h2.Tokens[0].Formatted != "0" || h2.Tokens[1].Formatted != "2.5" || h2.Tokens[1].Symbol != "USDX" {
+		t.Fatalf("account 2: %+v", h2)
+	}
+
+	// 同一个地址可以查多次，顺序和请求一致
+	snap, err = s.At(ctx, []common.Address{f.owners[2], f.owners[2]}, nil)
+	if err != nil || len(snap.Holdings) != 2 || snap.Holdings[1].Tokens[1].Raw.Int64() != 2_500_000 {
+		t.Fatalf("repeated owner: %+v, %v", snap, err)
+	}
+}
+
+// 历史快照：每个区块上的余额都和节点一致，代币部署前的区块记录错误
+func TestHistory(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+	s := f.service(t, f.chain.Client, multicall.New(f.chain.Client))
+
+	blocks := Range(f.blocks[0].Uint64()-1, f.blocks[2].Uint64(), 1)
+	snaps, err := s.History(ctx, f.owners, blocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snaps) != len(blocks) {
+		t.Fatalf("%d snapshots for %d blocks", len(snaps), len(blocks))
+	}
+	for i, snap := range snaps {
+		f.checkSnapshot(t, snap, blocks[i])
+	}
+	if first := snaps[1].Holdings[1].Tokens[0]; first.Raw.Sign() != 0 || first.Formatted != "0" {
+		t.Fatalf("account 1 before the transfer: %+v", first)
+	}
+
+	if _, err := s.History(ctx, f.owners, []*big.Int{big.NewInt(1000)}); err == nil || !strings.HasPrefix(err.Error(), "portfolio: block 1000: ") {
+		t.Fatalf("future block: %v", err)
+	}
+}
+
+// 没有 Multicall3 时逐个 eth_call，结果和走 Multicall3 的一样。
+// simchain 的创世块里固定地址上总有 Multicall3，所以把地址换成没有代码的地址
+func TestWithoutMulticall(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+	backend := &countingCaller{Backend: f.chain.Client}
+	mc := multicall.New(backend)
+	mc.Address = common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	s := f.service(t, backend, mc)
+
+	if _, err := s.Tokens(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// 第一个代币的 symbol 和 decimals
+	if backend.calls != 2 {
+		t.Fatalf("%d eth_calls resolving tokens, want 2", backend.calls)
+	}
+	for _, block := range f.blocks {
+		backend.calls = 0
+		snap, err := s.At(ctx, f.owners, block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.checkSnapshot(t, snap, block)
+		if want := len(f.owners) * 2; backend.calls != want {
+			t.Fatalf("%d eth_calls, want %d", backend.calls, want)
+		}
+	}
+
+	// 同样的查询走 Multicall3 只要一次 eth_call
+	backend = &countingCaller{Backend: f.chain.Client}
+	s = f.service(t, backend, multicall.New(backend))
+	if _, err := s.Tokens(ctx); err != nil {
+		t.Fatal(err)
+	}
+	backend.calls = 0
+	if _, err := s.At(ctx, f.owners, nil); err != nil || backend.calls != 1 {
+		t.Fatalf("multicall: %d eth_calls, %v", backend.calls, err)
+	}
+}
+
+// 不是 ERC-20 的地址：读不到 symbol 用地址代替，读不到 decimals 报错
+func TestResolve(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+	store, _, _, err := f.chain.DeployStore(0, "1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	eighteen := uint8(18)
+	s, err := New(f.chain.Client, multicall.New(f.chain.Client), []Token{{Address: store, Decimals: &eighteen}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := s.Tokens(ctx)
+	if err != nil || tokens[0].Symbol != store.Hex()[:10] {
+		t.Fatalf("symbol fallback: %+v, %v", tokens, err)
+	}
+
+	s, err = New(f.chain.Client, multicall.New(f.chain.Client), []Token{{Address: store, Symbol: "STORE"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Tokens(ctx); err == nil || !strings.HasPrefix(err.Error(), "portfolio: decimals of "+store.Hex()) {
+		t.Fatalf("decimals: %v", err)
+	}
+	if _, err := s.At(ctx, f.owners, nil); err == nil {
+		t.Fatal("snapshot without decimals")
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		from, to, step uint64
+		want           string
+	}{
+		{1, 10, 4, "1 5 9 10"},
+		{1, 9, 4, "1 5 9"},
+		{0, 0, 1, "0"},
+		{3, 5, 1, "3 4 5"},
+		{3, 5, 100, "3 5"},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, b := range Range(tt.from, tt.to, tt.step) {
+			got = append(got, b.String())
+		}
+		if strings.Join(got, " ") != tt.want {
+			t.Errorf("Range(%d, %d, %d) = %v, want %s", tt.from, tt.to, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestLoadTokens(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	list := write("list.json", `{"name": "test", "tokens": [
+		{"chainId": 1, "address": "0x00000000000000000000000000000000000000a1", "symbol": "A", "decimals": 6},
+		{"chainId": 1337, "address": "0x00000000000000000000000000000000000000b2", "symbol": "B"},
+		{"address": "0x00000000000000000000000000000000000000c3"},
+		{"chainId": 1337, "address": "0x00000000000000000000000000000000000000b2", "symbol": "B2"}
+	]}`)
+	array := write("array.json", ` [{"address": "0x00000000000000000000000000000000000000a1", "decimals": 0}]`)
+	tests := []struct {
+		name    string
+		path    string
+		chainID uint64
+		want    string // 地址末两位和 symbol
+		err     string
+	}{
+		{name: "all chains", path: list, want: "a1:A b2:B c3:"},
+		{name: "one chain", path: list, chainID: 1337, want: "b2:B c3:"},
+		{name: "array", path: array, want: "a1:"},
+		{name: "example", path: "tokens.example.json", chainID: 1337, want: "12:RCC"},
+		{name: "missing address", path: write("bad.json", `[{"symbol": "X"}]`), err: `token "X" has no address`},
+		{name: "invalid json", path: write("broken.json", `{"tokens": [}`), err: "invalid character"},
+		{name: "missing file", path: filepath.Join(dir, "none.json"), err: "no such file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := LoadTokens(tt.path, tt.chainID)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, tok := range tokens {
+				hex := tok.Address.Hex()
+				got = append(got, strings.ToLower(hex[len(hex)-2:])+":"+tok.Symbol)
+			}
+			if strings.Join(got, " ") != tt.want {
+				t.Fatalf("tokens %v, want %s", got, tt.want)
+			}
+		})
+	}
+	// decimals 写 0 和没写要区分开
+	tokens, _ := LoadTokens(array, 0)
+	if tokens[0].Decimals == nil || *tokens[0].Decimals != 0 {
+		t.Fatalf("decimals = %v", tokens[0].Decimals)
+	}
+	if tokens, _ := LoadTokens(list, 0); tokens[1].Decimals != nil {
+		t.Fatal("missing decimals loaded as a value")
+	}
+}
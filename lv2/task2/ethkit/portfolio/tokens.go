@@ -0,0 +1,57 @@
+package portfolio
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Token 是代币列表里的一项。Symbol 和 Decimals 可以不填，第一次查询时从合约读取
+type Token struct {
+	Address  common.Address `json:"address"`
+	Symbol   string         `json:"symbol,omitempty"`
+	Name     string         `json:"name,omitempty"`
+	Decimals *uint8         `json:"decimals,omitempty"`
+	ChainID  uint64         `json:"chainId,omitempty"`
+}
+
+// LoadTokens 读取代币列表，支持两种格式：
+//   - 数组：[{"address": "0x...", "symbol": "RCC", "decimals": 18}]
+//   - Uniswap token list：{"name": "...", "tokens": [{"chainId": 1, "address": "0x...", ...}]}
+//
+// chainID 不为 0 时只保留 chainId 相同或没写 chainId 的代币
+func LoadTokens(path string, chainID uint64) ([]Token, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var tokens []Token
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+		err = json.Unmarshal(data, &tokens)
+	} else {
+		var list struct {
+			Tokens []Token `json:"tokens"`
+		}
+		err = json.Unmarshal(data, &list)
+		tokens = list.Tokens
+	}
+	if err != nil {
+		return nil, fmt.Errorf("token list %s: %w", path, err)
+	}
+	seen := map[common.Address]bool{}
+	var out []Token
+	for _, t := range tokens {
+		if t.Address == (common.Address{}) {
+			return nil, fmt.Errorf("token list %s: token %q has no address", path, t.Symbol)
+		}
+		if (chainID != 0 && t.ChainID != 0 && t.ChainID != chainID) || seen[t.Address] {
+			continue
+		}
+		seen[t.Address] = true
+		out = append(out, t)
+	}
+	return out, nil
+}
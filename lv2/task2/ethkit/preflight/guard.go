@@ -0,0 +1,129 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"ethkit/signer"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrDryRun 是 dry run 时签名器返回的错误，交易没有签名也没有发出
+var ErrDryRun = errors.New("preflight: dry run, transaction not sent")
+
+// DryRunError 带着 dry run 的检查报告，errors.Is(err, ErrDryRun) 为 true。
+// 检查不通过时 Unwrap 返回 Report.Err()，比如 *abicall.RevertError
+type DryRunError struct {
+	Report *Report
+}
+
+func (e *DryRunError) Error() string {
+	if err := e.Report.Err(); err != nil {
+		return fmt.Sprintf("%v: %v", ErrDryRun, err)
+	}
+	return ErrDryRun.Error()
+}
+
+func (e *DryRunError) Is(target error) bool {
+	return target == ErrDryRun
+}
+
+func (e *DryRunError) Unwrap() error {
+	return e.Report.Err()
+}
+
+// guardTag 标记经过 Guard 的签名器。autoGas 表示包装时 auth 没有指定 GasLimit，由 Check 估算
+type guardTag struct {
+	autoGas bool
+}
+
+// Options 控制 Guard 的行为
+type Options struct {
+	Margin float64       // gas 余量，0 为 DefaultMargin
+	DryRun bool          // 只检查不发送，签名器返回 *DryRunError
+	Report func(*Report) // 每次检查完都会调用，比如记日志
+	ABIs   []abi.ABI     // 解码 revert 里的自定义错误
+}
+
+// Guard 包装 auth 的签名器，让经过它的每笔交易在签名前先做一次 Check：
+// GasLimit 换成估算值加余量（包装时 auth 已经指定了 GasLimit 的不改），
+// 会 revert、gas 不够或余额不足时返回错误，交易不会发出。返回的是 auth 的副本。
+// 检查用的 ctx 是发送时 TransactOpts 上的 Context，经 signer.WithContext 换过的也跟着换。
+// 已经经过 Guard 的 auth 原样复制一份，不会检查两次
+func Guard(auth *bind.TransactOpts, backend Backend, opts Options) *bind.TransactOpts {
+	if _, ok := signer.Tag(auth).(guardTag); ok {
+		guarded := *auth
+		return &guarded
+	}
+	tag := guardTag{autoGas: auth.GasLimit == 0}
+	sign := auth.Signer
+	guarded := signer.WithTaggedSigner(auth, func(ctx context.Context, from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		msg := ethereum.CallMsg{From: from, To: tx.To(), Value: tx.Value(), Data: tx.Data()}
+		if !tag.autoGas {
+			msg.Gas = tx.Gas()
+		}
+		switch tx.Type() {
+		case types.LegacyTxType:
+			msg.GasPrice = tx.GasPrice()
+		case types.DynamicFeeTxType:
+			msg.GasFeeCap, msg.GasTipCap = tx.GasFeeCap(), tx.GasTipCap()
+		default:
+			return nil, fmt.Errorf("preflight: unsupported transaction type %d", tx.Type())
+		}
+		report, err := Check(ctx, backend, msg, opts.Margin, opts.ABIs...)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Report != nil {
+			opts.Report(report)
+		}
+		if opts.DryRun {
+			return nil, &DryRunError{Report: report}
+		}
+		if err := report.Err(); err != nil {
+			return nil, err
+		}
+		return sign(from, withGas(tx, report.GasLimit))
+	}, tag)
+	if tag.autoGas {
+		// GasLimit 为 0 时绑定代码会先自己估算，遇到 revert 直接返回，走不到 Check 和 dry run。
+		// 填一个不为 0 的值让它跳过，签名前换成 Check 的结果，所以值本身不会被用到
+		guarded.GasLimit = math.MaxUint64
+	}
+	return guarded
+}
+
+// withGas 返回只改了 gas 上限的同一笔交易
+func withGas(tx *types.Transaction, gas uint64) *types.Transaction {
+	if tx.Gas() == gas {
+		return tx
+	}
+	if tx.Type() == types.LegacyTxType {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: tx.GasPrice(),
+			Gas:      gas,
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		})
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    tx.ChainId(),
+		Nonce:      tx.Nonce(),
+		GasTipCap:  tx.GasTipCap(),
+		GasFeeCap:  tx.GasFeeCap(),
+		Gas:        gas,
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	})
+}
@@ -0,0 +1,137 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"ethkit/abicall"
+	"ethkit/contracts/token"
+	"ethkit/signer"
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func setup(t *testing.T) (*simchain.Chain, *token.Token, abi.ABI) {
+	t.Helper()
+	chain, err := simchain.New(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	_, tok, _, err := chain.DeployToken(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := token.TokenMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return chain, tok, *parsed
+}
+
+// requireNotSent 确认账户 i 没有发出任何交易
+func requireNotSent(t *testing.T, chain *simchain.Chain, i int) {
+	t.Helper()
+	nonce, err := chain.Client.PendingNonceAt(context.Background(), chain.Accounts[i].Address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonce != 0 {
+		t.Fatalf("account %d sent %d txs", i, nonce)
+	}
+}
+
+func TestGuard(t *testing.T) {
+	chain, tok, parsed := setup(t)
+	to := chain.Accounts[1].Address
+	var reports []*Report
+	opts := Options{ABIs: []abi.ABI{parsed}, Report: func(r *Report) { reports = append(reports, r) }}
+
+	// gas 换成估算值加余量；已经经过 Guard 的再包一次也只检查一次，中间换过 ctx 也一样
+	inner := signer.WithContext(context.Background(), Guard(chain.Transactor(0), chain.Client, opts))
+	auth := Guard(inner, chain.Client, opts)
+	tx, err := tok.Transfer(auth, to, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("checked %d times", len(reports))
+	}
+	if r := reports[0]; tx.Gas() != r.GasLimit || r.GasLimit <= r.GasEstimate {
+		t.Fatalf("tx gas %d, estimate %d, limit %d", tx.Gas(), r.GasEstimate, r.GasLimit)
+	}
+	if _, err := chain.Mine(tx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 指定了 GasLimit 的不改
+	fixed := chain.Transactor(0)
+	fixed.GasLimit = 100000
+	if tx, err = tok.Transfer(Guard(fixed, chain.Client, opts), to, big.NewInt(1)); err != nil || tx.Gas() != 100000 {
+		t.Fatalf("fixed gas: %v, %v", tx, err)
+	}
+	chain.Commit()
+
+	// 账户 1 只有 101，转 1000 在签名前就 revert，按 ABI 解码出自定义错误，交易不发出
+	_, err = tok.Transfer(Guard(chain.Transactor(1), chain.Client, opts), chain.Accounts[0].Address, big.NewInt(1000))
+	var revert *abicall.RevertError
+	if !errors.As(err, &revert) || revert.Name != "ERC20InsufficientBalance" {
+		t.Fatalf("transfer over balance: %v", err)
+	}
+	requireNotSent(t, chain, 1)
+}
+
+func TestGuardDryRun(t *testing.T) {
+	chain, tok, parsed := setup(t)
+	auth := Guard(chain.Transactor(1), chain.Client, Options{DryRun: true, ABIs: []abi.ABI{parsed}})
+	to := chain.Accounts[0].Address
+
+	// 检查通过：带着报告返回 ErrDryRun
+	_, err := tok.Transfer(auth, to, big.NewInt(0))
+	var dry *DryRunError
+	if !errors.Is(err, ErrDryRun) || !errors.As(err, &dry) || dry.Report.Err() != nil || dry.Report.GasLimit == 0 {
+		t.Fatalf("dry run: %v", err)
+	}
+	// 检查不通过：仍然是 dry run，同时能取到 revert
+	_, err = tok.Transfer(auth, to, big.NewInt(1))
+	var revert *abicall.RevertError
+	if !errors.Is(err, ErrDryRun) || !errors.As(err, &revert) || revert.Name != "ERC20InsufficientBalance" {
+		t.Fatalf("dry run over balance: %v", err)
+	}
+	requireNotSent(t, chain, 1)
+}
+
+// 检查用的是发送时 TransactOpts 上的 ctx，不是 Guard 创建时的
+func TestGuardContext(t *testing.T) {
+	chain, tok, parsed := setup(t)
+	to := chain.Accounts[1].Address
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	// 指定 nonce 和 gas 单价，绑定代码发送前不会先访问节点；nonce 0 是部署代币的交易
+	fill := func(auth *bind.TransactOpts) *bind.TransactOpts {
+		auth.Nonce, auth.GasPrice = big.NewInt(1), big.NewInt(10e9)
+		return auth
+	}
+	opts := Options{ABIs: []abi.ABI{parsed}}
+
+	auth := Guard(fill(chain.Transactor(0)), chain.Client, opts)
+	if _, err := tok.Transfer(signer.WithContext(cancelled, auth), to, big.NewInt(1)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled send: %v", err)
+	}
+
+	stale := fill(chain.Transactor(0))
+	stale.Context = cancelled
+	auth = Guard(stale, chain.Client, opts)
+	tx, err := tok.Transfer(signer.WithContext(context.Background(), auth), to, big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt, err := chain.Mine(tx); err != nil || receipt.Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("send with fresh ctx: %v", err)
+	}
+}
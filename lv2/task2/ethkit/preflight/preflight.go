@@ -0,0 +1,241 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"ethkit/abicall"
+	"ethkit/units"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DefaultMargin 是估算 gas 之后加的余量：估算结果基于当前状态，真正执行时状态可能已经变了
+const DefaultMargin = 0.2
+
+var (
+	// ErrInsufficientFunds 表示余额付不起 value + 最大手续费
+	ErrInsufficientFunds = errors.New("preflight: insufficient funds for value + max fee")
+	// ErrGasTooLow 表示指定的 gas 上限低于估算值，交易会 out of gas
+	ErrGasTooLow = errors.New("preflight: gas limit is below the estimate")
+)
+
+// Backend 是发送前检查需要的链接口，*ethclient.Client 和 simulated.Client 都满足
+type Backend interface {
+	ethereum.ContractCaller
+	ethereum.GasEstimator
+	ethereum.GasPricer
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Report 是一笔交易发送前的检查结果，金额单位都是 wei。
+// London 之后按 EIP-1559 算：实际单价是 min(FeeCap, baseFee + TipCap)，
+// 下一个区块的 baseFee 最多比当前低 1/8，MinFee 按这个下限算；
+// 节点要求余额够付 gas 上限 × FeeCap，所以 MaxFee 和 Required 用的是上限。
+// London 之前只有 GasPrice，MinFee 和 ExpectedFee 相同
+type Report struct {
+	From  common.Address
+	To    *common.Address // nil 为部署合约
+	Value *big.Int
+
+	GasEstimate uint64
+	GasLimit    uint64
+
+	London   bool
+	BaseFee  *big.Int
+	TipCap   *big.Int
+	FeeCap   *big.Int
+	GasPrice *big.Int
+
+	MinFee      *big.Int
+	ExpectedFee *big.Int
+	MaxFee      *big.Int
+
+	Balance    *big.Int
+	Required   *big.Int
+	Affordable bool
+
+	Revert error // 模拟执行失败的原因，通常是 *abicall.RevertError
+}
+
+// Err 返回会导致交易失败的问题：revert、gas 上限不够或余额不足，都没有时返回 nil
+func (r *Report) Err() error {
+	switch {
+	case r.Revert != nil:
+		return r.Revert
+	case r.GasEstimate > r.GasLimit:
+		return fmt.Errorf("%w: limit %d, estimate %d", ErrGasTooLow, r.GasLimit, r.GasEstimate)
+	case !r.Affordable:
+		return fmt.Errorf("%w: balance %s, need %s", ErrInsufficientFunds, r.Balance, r.Required)
+	}
+	return nil
+}
+
+func (r *Report) String() string {
+	var b strings.Builder
+	to := "(create)"
+	if r.To != nil {
+		to = r.To.Hex()
+	}
+	fmt.Fprintf(&b, "from:         %s\n", r.From.Hex())
+	fmt.Fprintf(&b, "to:           %s\n", to)
+	fmt.Fprintf(&b, "value:        %s ETH\n", units.Format(r.Value, 18))
+	if r.GasEstimate == 0 {
+		fmt.Fprintf(&b, "gas:          estimate failed, limit %d\n", r.GasLimit)
+	} else {
+		fmt.Fprintf(&b, "gas:          estimate %d, limit %d\n", r.GasEstimate, r.GasLimit)
+	}
+	if r.London {
+		fmt.Fprintf(&b, "fee per gas:  base %s gwei, tip %s gwei, cap %s gwei\n", gwei(r.BaseFee), gwei(r.TipCap), gwei(r.FeeCap))
+	} else {
+		fmt.Fprintf(&b, "gas price:    %s gwei\n", gwei(r.GasPrice))
+	}
+	fmt.Fprintf(&b, "fee:          min %s, expected %s, max %s ETH\n", eth(r.MinFee), eth(r.ExpectedFee), eth(r.MaxFee))
+	fmt.Fprintf(&b, "balance:      %s ETH, need %s ETH\n", eth(r.Balance), eth(r.Required))
+	if err := r.Err(); err != nil {
+		fmt.Fprintf(&b, "result:       FAIL %v\n", err)
+	} else {
+		fmt.Fprintf(&b, "result:       ok\n")
+	}
+	return b.String()
+}
+
+func gwei(v *big.Int) string {
+	if v == nil {
+		return "-"
+	}
+	return units.Format(v, 9)
+}
+
+func eth(v *big.Int) string {
+	if v == nil {
+		return "-"
+	}
+	return units.Format(v, 18)
+}
+
+// Check 在发送前检查 msg：估算 gas 并加上 margin 的余量（msg.Gas 不为 0 时按它作为上限），
+// 按 msg 里的费用参数（没填时用节点建议值）算出手续费范围，检查余额够不够，
+// 再用最终的 gas 上限模拟执行一次。revert、gas 不够、余额不足都记在 Report 里（见 Report.Err），
+// 只有 RPC 本身出错时才返回 error。margin 为 0 时用 DefaultMargin，abis 用来解码自定义错误
+func Check(ctx context.Context, backend Backend, msg ethereum.CallMsg, margin float64, abis ...abi.ABI) (*Report, error) {
+	if margin == 0 {
+		margin = DefaultMargin
+	}
+	if margin < 0 {
+		return nil, fmt.Errorf("preflight: negative margin %v", margin)
+	}
+	r := &Report{From: msg.From, To: msg.To, Value: msg.Value}
+	if r.Value == nil {
+		r.Value = new(big.Int)
+	}
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if r.Balance, err = backend.BalanceAt(ctx, msg.From, nil); err != nil {
+		return nil, err
+	}
+
+	// 估算时不带费用参数：带了的话节点会按余额压低 gas 上限，余额不足时只返回一句 insufficient funds
+	estimate := msg
+	estimate.Gas, estimate.GasPrice, estimate.GasFeeCap, estimate.GasTipCap = 0, nil, nil, nil
+	r.GasEstimate, err = backend.EstimateGas(ctx, estimate)
+	if err != nil {
+		if _, ok := abicall.RevertData(err); !ok && !isRevert(err) {
+			if r.Value.Cmp(r.Balance) <= 0 {
+				return nil, err
+			}
+			// value 超过余额时估算也会失败，按余额不足报告
+		} else {
+			r.Revert = abicall.WrapRevert(err, abis...)
+		}
+	}
+	r.GasLimit = msg.Gas
+	if r.GasLimit == 0 {
+		r.GasLimit = uint64(float64(r.GasEstimate) * (1 + margin))
+		if head.GasLimit > 0 {
+			r.GasLimit = min(r.GasLimit, head.GasLimit)
+		}
+	}
+
+	if err := r.fees(ctx, backend, head, msg); err != nil {
+		return nil, err
+	}
+	r.Required = new(big.Int).Add(r.Value, r.MaxFee)
+	r.Affordable = r.Balance.Cmp(r.Required) >= 0
+
+	// 用最终的上限再执行一次：上限是手动指定的时候，估算通过不代表这个上限够用
+	if r.Revert == nil && r.GasLimit >= r.GasEstimate && r.Value.Cmp(r.Balance) <= 0 {
+		call := estimate
+		call.Gas = r.GasLimit
+		if _, err := backend.CallContract(ctx, call, nil); err != nil {
+			if _, ok := abicall.RevertData(err); !ok && !isRevert(err) {
+				return nil, err
+			}
+			r.Revert = abicall.WrapRevert(err, abis...)
+		}
+	}
+	return r, nil
+}
+
+// fees 按 London 前后的规则算单价和手续费范围
+func (r *Report) fees(ctx context.Context, backend Backend, head *types.Header, msg ethereum.CallMsg) error {
+	estimate := new(big.Int).SetUint64(r.GasEstimate)
+	limit := new(big.Int).SetUint64(r.GasLimit)
+	var err error
+	if head.BaseFee == nil || msg.GasPrice != nil {
+		r.GasPrice = msg.GasPrice
+		if r.GasPrice == nil {
+			if r.GasPrice, err = backend.SuggestGasPrice(ctx); err != nil {
+				return err
+			}
+		}
+		r.ExpectedFee = new(big.Int).Mul(estimate, r.GasPrice)
+		r.MinFee = new(big.Int).Set(r.ExpectedFee)
+		r.MaxFee = new(big.Int).Mul(limit, r.GasPrice)
+		return nil
+	}
+
+	r.London = true
+	r.BaseFee = head.BaseFee
+	r.TipCap = msg.GasTipCap
+	if r.TipCap == nil {
+		if r.TipCap, err = backend.SuggestGasTipCap(ctx); err != nil {
+			return err
+		}
+	}
+	r.FeeCap = msg.GasFeeCap
+	if r.FeeCap == nil {
+		// 和 abigen 绑定的默认值一样：2 倍 baseFee 加小费，连续几个满区块之后仍然够用
+		r.FeeCap = new(big.Int).Add(r.TipCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	}
+	lowBase := new(big.Int).Sub(head.BaseFee, new(big.Int).Div(head.BaseFee, big.NewInt(8)))
+	r.MinFee = new(big.Int).Mul(estimate, effective(lowBase, r.TipCap, r.FeeCap))
+	r.ExpectedFee = new(big.Int).Mul(estimate, effective(head.BaseFee, r.TipCap, r.FeeCap))
+	r.MaxFee = new(big.Int).Mul(limit, r.FeeCap)
+	return nil
+}
+
+// effective 是 EIP-1559 交易实际付的单价 min(feeCap, baseFee + tip)
+func effective(baseFee, tip, feeCap *big.Int) *big.Int {
+	price := new(big.Int).Add(baseFee, tip)
+	if price.Cmp(feeCap) > 0 {
+		return new(big.Int).Set(feeCap)
+	}
+	return price
+}
+
+// isRevert 识别不带 revert 数据的失败，比如 require(false) 没写原因或者 out of gas
+func isRevert(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "execution reverted") || strings.Contains(msg, "out of gas") || strings.Contains(msg, "invalid opcode")
+}
@@ -0,0 +1,145 @@
+package preflight
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func mul(a uint64, b *big.Int) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(a), b)
+}
+
+// 手续费按 London 前后的规则算；gas 上限和余额不够时记在报告里
+func TestCheck(t *testing.T) {
+	chain, err := simchain.New(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	ctx := context.Background()
+
+	// 余额只有 1 gwei 的账户，付不起手续费
+	poor := crypto.PubkeyToAddress(simchain.DeterministicKey(99).PublicKey)
+	if _, err := chain.Fund(poor, big.NewInt(params.GWei)); err != nil {
+		t.Fatal(err)
+	}
+	head, err := chain.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tip, err := chain.Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := head.BaseFee
+	lowBase := new(big.Int).Sub(base, new(big.Int).Div(base, big.NewInt(8)))
+	gasPrice := big.NewInt(3 * params.GWei)
+	lowCap := new(big.Int).Add(lowBase, big.NewInt(1)) // 介于 lowBase 和 base 之间，压住实际单价
+
+	to := chain.Accounts[1].Address
+	transfer := ethereum.CallMsg{From: chain.Accounts[0].Address, To: &to, Value: big.NewInt(1)}
+	const estimate = params.TxGas // 转给普通账户，估算值就是 21000
+
+	tests := []struct {
+		name   string
+		msg    func(m *ethereum.CallMsg)
+		margin float64
+
+		limit                 uint64
+		london                bool
+		minFee, expected, max *big.Int
+		err                   error
+	}{
+		{
+			name: "eip1559 defaults", limit: estimate * 12 / 10, london: true,
+			minFee:   mul(estimate, new(big.Int).Add(lowBase, tip)),
+			expected: mul(estimate, new(big.Int).Add(base, tip)),
+			max:      mul(estimate*12/10, new(big.Int).Add(tip, new(big.Int).Mul(base, big.NewInt(2)))),
+		},
+		{
+			name: "eip1559 fee cap below base + tip", msg: func(m *ethereum.CallMsg) { m.GasFeeCap, m.GasTipCap = lowCap, tip },
+			limit: estimate * 12 / 10, london: true,
+			minFee:   mul(estimate, lowCap),
+			expected: mul(estimate, lowCap),
+			max:      mul(estimate*12/10, lowCap),
+		},
+		{
+			name: "legacy gas price", msg: func(m *ethereum.CallMsg) { m.GasPrice = gasPrice },
+			limit:  estimate * 12 / 10,
+			minFee: mul(estimate, gasPrice), expected: mul(estimate, gasPrice), max: mul(estimate*12/10, gasPrice),
+		},
+		{
+			name: "custom margin", msg: func(m *ethereum.CallMsg) { m.GasPrice = gasPrice }, margin: 1,
+			limit:  estimate * 2,
+			minFee: mul(estimate, gasPrice), expected: mul(estimate, gasPrice), max: mul(estimate*2, gasPrice),
+		},
+		{
+			name: "explicit gas limit", msg: func(m *ethereum.CallMsg) { m.Gas, m.GasPrice = 50000, gasPrice },
+			limit:  50000,
+			minFee: mul(estimate, gasPrice), expected: mul(estimate, gasPrice), max: mul(50000, gasPrice),
+		},
+		{
+			name: "gas limit below estimate", msg: func(m *ethereum.CallMsg) { m.Gas, m.GasPrice = estimate-1, gasPrice },
+			limit:  estimate - 1,
+			minFee: mul(estimate, gasPrice), expected: mul(estimate, gasPrice), max: mul(estimate-1, gasPrice),
+			err: ErrGasTooLow,
+		},
+		{
+			name: "underfunded", msg: func(m *ethereum.CallMsg) { m.From, m.GasPrice = poor, gasPrice },
+			limit:  estimate * 12 / 10,
+			minFee: mul(estimate, gasPrice), expected: mul(estimate, gasPrice), max: mul(estimate*12/10, gasPrice),
+			err: ErrInsufficientFunds,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := transfer
+			if tt.msg != nil {
+				tt.msg(&msg)
+			}
+			r, err := Check(ctx, chain.Client, msg, tt.margin)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r.GasEstimate != estimate || r.GasLimit != tt.limit || r.London != tt.london {
+				t.Fatalf("estimate %d, limit %d, london %v; want %d, %d, %v", r.GasEstimate, r.GasLimit, r.London, estimate, tt.limit, tt.london)
+			}
+			if r.MinFee.Cmp(tt.minFee) != 0 || r.ExpectedFee.Cmp(tt.expected) != 0 || r.MaxFee.Cmp(tt.max) != 0 {
+				t.Fatalf("fees min %s, expected %s, max %s; want %s, %s, %s", r.MinFee, r.ExpectedFee, r.MaxFee, tt.minFee, tt.expected, tt.max)
+			}
+			if want := new(big.Int).Add(msg.Value, tt.max); r.Required.Cmp(want) != 0 || r.Affordable != (r.Balance.Cmp(want) >= 0) {
+				t.Fatalf("required %s, affordable %v, balance %s", r.Required, r.Affordable, r.Balance)
+			}
+			if err := r.Err(); !errors.Is(err, tt.err) || (tt.err == nil && err != nil) {
+				t.Fatalf("Err() = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+// value 超过余额时节点估算不了 gas，按余额不足报告而不是返回 RPC 错误
+func TestCheckValueOverBalance(t *testing.T) {
+	chain, err := simchain.New(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	msg := ethereum.CallMsg{From: chain.Accounts[0].Address, To: &to, Value: new(big.Int).Mul(simchain.DefaultBalance, big.NewInt(2))}
+	r, err := Check(context.Background(), chain.Client, msg, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Affordable || r.Revert != nil || !errors.Is(r.Err(), ErrInsufficientFunds) {
+		t.Fatalf("report: affordable %v, revert %v, err %v", r.Affordable, r.Revert, r.Err())
+	}
+}
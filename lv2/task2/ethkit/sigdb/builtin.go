@@ -0,0 +1,61 @@
+package sigdb
+
+import (
+	"ethkit/contracts/crowdfunding"
+	"ethkit/contracts/multicall3"
+	"ethkit/contracts/multisig"
+	"ethkit/contracts/rccstake"
+	"ethkit/contracts/shipping"
+	"ethkit/contracts/todolist"
+	"ethkit/contracts/token"
+	"ethkit/contracts/weth"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	store "store/contracts"
+)
+
+// builtin 是本仓库已经生成绑定的合约
+var builtin = map[string]*bind.MetaData{
+	"ethkit/contracts/crowdfunding/CrowdFunding": crowdfunding.CrowdFundingMetaData,
+	"ethkit/contracts/multicall3/Multicall3":     multicall3.Multicall3MetaData,
+	"ethkit/contracts/multisig/MultiSigWallet":   multisig.MultiSigWalletMetaData,
+	"ethkit/contracts/rccstake/RCCStake":         rccstake.RCCStakeMetaData,
+	"ethkit/contracts/shipping/Shipping":         shipping.ShippingMetaData,
+	"ethkit/contracts/shipping/Shopping":         shipping.ShoppingMetaData,
+	"ethkit/contracts/todolist/TodoList":         todolist.TodoListMetaData,
+	"ethkit/contracts/token/RccToken":            token.TokenMetaData,
+	"ethkit/contracts/weth/WETH":                 weth.WETHMetaData,
+	"ethkit/contracts/weth/WETH9":                weth.WETH9MetaData,
+	"17/store/Store":                             store.StoreMetaData,
+}
+
+// standard 是常用标准里绑定没有覆盖到的签名
+var standard = []string{
+	"event Transfer(address indexed from,address indexed to,uint256 value)",
+	"event Approval(address indexed owner,address indexed spender,uint256 value)",
+	"event Transfer(address indexed from,address indexed to,uint256 indexed tokenId)",
+	"event Approval(address indexed owner,address indexed approved,uint256 indexed tokenId)",
+	"event ApprovalForAll(address indexed owner,address indexed operator,bool approved)",
+	"function safeTransferFrom(address from,address to,uint256 tokenId)",
+	"function safeTransferFrom(address from,address to,uint256 tokenId,bytes data)",
+	"function setApprovalForAll(address operator,bool approved)",
+	"function multicall(bytes[] data)",
+}
+
+// Builtin 返回包含本仓库合约绑定和常用标准签名的数据库
+func Builtin() *DB {
+	db := New()
+	for source, meta := range builtin {
+		parsed, err := meta.GetAbi()
+		if err != nil {
+			panic(source + ": " + err.Error()) // 生成的绑定里的 ABI 不会解析失败
+		}
+		db.AddABI(source, *parsed)
+	}
+	for _, sig := range standard {
+		if err := db.AddSignature("standard", sig); err != nil {
+			panic(err)
+		}
+	}
+	return db
+}
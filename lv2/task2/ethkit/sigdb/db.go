@@ -0,0 +1,339 @@
+package sigdb
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"ethkit/artifact"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Method 是数据库里的一个函数签名，Source 是它来自哪个 ABI 文件或导入文件
+type Method struct {
+	abi.Method
+	Source string
+}
+
+type Event struct {
+	abi.Event
+	Source string
+	// Guessed 表示导入的签名只有类型，参数是否 indexed 是按 topic 数量推断的
+	Guessed bool
+}
+
+type Error struct {
+	abi.Error
+	Source string
+}
+
+// DB 是 4 字节选择器和事件 topic 到签名的索引。同一个选择器可能对应多个签名（碰撞或同名不同参数名），都会保留
+type DB struct {
+	mu      sync.RWMutex
+	methods map[[4]byte][]Method
+	events  map[common.Hash][]Event
+	errors  map[[4]byte][]Error
+}
+
+func New() *DB {
+	return &DB{
+		methods: map[[4]byte][]Method{},
+		events:  map[common.Hash][]Event{},
+		errors:  map[[4]byte][]Error{},
+	}
+}
+
+// AddABI 把一个 ABI 里的函数、事件和自定义错误加入数据库，已有的相同签名会跳过
+func (db *DB) AddABI(source string, parsed abi.ABI) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, m := range parsed.Methods {
+		db.addMethod(Method{Method: m, Source: source})
+	}
+	for _, e := range parsed.Events {
+		if !e.Anonymous {
+			db.addEvent(Event{Event: e, Source: source})
+		}
+	}
+	for _, e := range parsed.Errors {
+		db.addError(Error{Error: e, Source: source})
+	}
+}
+
+// AddSignature 加入一条文本签名，格式见 Parse
+func (db *DB) AddSignature(source, sig string) error {
+	kind, name, inputs, err := Parse(sig)
+	if err != nil {
+		return err
+	}
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	switch kind {
+	case "event":
+		typesOnly := true
+		for _, in := range inputs {
+			typesOnly = typesOnly && !in.Indexed && in.Name == ""
+		}
+		e := abi.NewEvent(name, name, false, inputs)
+		// 只有类型的事件签名不知道哪些参数是 indexed，解码时按 topic 数量推断；
+		// 带参数名的是 Solidity 写法，没写 indexed 就是没有 indexed 参数
+		db.addEvent(Event{Event: e, Source: source, Guessed: typesOnly && len(inputs) > 0})
+	case "error":
+		db.addError(Error{Error: abi.NewError(name, inputs), Source: source})
+	default:
+		db.addMethod(Method{Method: abi.NewMethod(name, name, abi.Function, "", false, false, inputs, nil), Source: source})
+	}
+	return nil
+}
+
+func (db *DB) addMethod(m Method) {
+	key := [4]byte(m.ID)
+	for _, have := range db.methods[key] {
+		if have.Sig == m.Sig && sameNames(have.Inputs, m.Inputs) {
+			return
+		}
+	}
+	db.methods[key] = append(db.methods[key], m)
+}
+
+func (db *DB) addEvent(e Event) {
+	for _, have := range db.events[e.ID] {
+		if have.Sig == e.Sig && sameIndexed(have.Inputs, e.Inputs) && sameNames(have.Inputs, e.Inputs) {
+			return
+		}
+	}
+	db.events[e.ID] = append(db.events[e.ID], e)
+}
+
+func (db *DB) addError(e Error) {
+	key := [4]byte(e.ID[:4])
+	for _, have := range db.errors[key] {
+		if have.Sig == e.Sig && sameNames(have.Inputs, e.Inputs) {
+			return
+		}
+	}
+	db.errors[key] = append(db.errors[key], e)
+}
+
+func sameNames(a, b abi.Arguments) bool {
+	for i := range a {
+		if a[i].Name != b[i].Name {
+			return false
+		}
+	}
+	return true
+}
+
+func sameIndexed(a, b abi.Arguments) bool {
+	for i := range a {
+		if a[i].Indexed != b[i].Indexed {
+			return false
+		}
+	}
+	return true
+}
+
+// Methods 返回选择器对应的所有函数签名
+func (db *DB) Methods(selector [4]byte) []Method {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return append([]Method(nil), db.methods[selector]...)
+}
+
+// Events 返回 topic0 对应的所有事件签名
+func (db *DB) Events(topic common.Hash) []Event {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return append([]Event(nil), db.events[topic]...)
+}
+
+// Errors 返回选择器对应的所有自定义错误
+func (db *DB) Errors(selector [4]byte) []Error {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return append([]Error(nil), db.errors[selector]...)
+}
+
+// Len 返回函数、事件和错误签名的数量
+func (db *DB) Len() (methods, events, errors int) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	for _, m := range db.methods {
+		methods += len(m)
+	}
+	for _, e := range db.events {
+		events += len(e)
+	}
+	for _, e := range db.errors {
+		errors += len(e)
+	}
+	return
+}
+
+// LoadDir 递归读取 root 下的 ABI：solc 输出的 .abi 和 hardhat / Remix 的产物 .json。
+// 不是产物的 .json（package.json、配置文件等）会被跳过，返回读入的文件数
+func (db *DB) LoadDir(root string) (int, error) {
+	n := 0
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			switch d.Name() {
+			case "node_modules", ".git", "cache", "build-info":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		ext := filepath.Ext(path)
+		if ext != ".abi" && ext != ".json" {
+			return nil
+		}
+		a, err := artifact.Load(path)
+		if err != nil {
+			if ext == ".abi" {
+				return err
+			}
+			return nil
+		}
+		rel, _ := filepath.Rel(root, path)
+		db.AddABI(filepath.ToSlash(rel), a.ABI)
+		n++
+		return nil
+	})
+	return n, err
+}
+
+// Import 导入签名文件，返回导入的签名数。支持两种格式：
+//   - .json：{"0xa9059cbb": ["transfer(address,uint256)"], "0xddf252ad...": "event Transfer(...)"}，
+//     键是选择器或 topic，会和按签名算出来的值核对；Export 的输出就是这种格式
+//   - 其他：每行一条签名，# 开头的行是注释
+func (db *DB) Import(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	source := filepath.Base(path)
+	if filepath.Ext(path) != ".json" {
+		n := 0
+		scanner := bufio.NewScanner(f)
+		for line := 1; scanner.Scan(); line++ {
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" || strings.HasPrefix(text, "#") {
+				continue
+			}
+			if err := db.AddSignature(source, text); err != nil {
+				return n, fmt.Errorf("%s:%d: %w", path, line, err)
+			}
+			n++
+		}
+		return n, scanner.Err()
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.NewDecoder(f).Decode(&raw); err != nil {
+		return 0, fmt.Errorf("%s: %w", path, err)
+	}
+	n := 0
+	for key, value := range raw {
+		var sigs []string
+		if err := json.Unmarshal(value, &sigs); err != nil {
+			var one string
+			if err := json.Unmarshal(value, &one); err != nil {
+				return n, fmt.Errorf("%s: %s: want a signature or a list of signatures", path, key)
+			}
+			sigs = []string{one}
+		}
+		for _, sig := range sigs {
+			id, err := ID(sig)
+			if err != nil {
+				return n, fmt.Errorf("%s: %w", path, err)
+			}
+			if !strings.EqualFold(id, key) {
+				return n, fmt.Errorf("%s: %s hashes to %s, not %s", path, sig, id, key)
+			}
+			if err := db.AddSignature(source, sig); err != nil {
+				return n, err
+			}
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Export 以 Import 能读回的 JSON 格式写出全部签名，带参数名和 indexed
+func (db *DB) Export(w io.Writer) error {
+	db.mu.RLock()
+	out := map[string][]string{}
+	for key, ms := range db.methods {
+		for _, m := range ms {
+			out[hexutil.Encode(key[:])] = append(out[hexutil.Encode(key[:])], "function "+human(m.RawName, m.Inputs))
+		}
+	}
+	for key, es := range db.events {
+		for _, e := range es {
+			prefix := "event "
+			sig := human(e.RawName, e.Inputs)
+			if e.Guessed {
+				sig = e.Sig // 推断出来的 indexed 不写回去
+			}
+			out[key.Hex()] = append(out[key.Hex()], prefix+sig)
+		}
+	}
+	for key, es := range db.errors {
+		for _, e := range es {
+			out[hexutil.Encode(key[:])] = append(out[hexutil.Encode(key[:])], "error "+human(e.Name, e.Inputs))
+		}
+	}
+	db.mu.RUnlock()
+	for _, sigs := range out {
+		sort.Strings(sigs)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// ID 计算文本签名的选择器（函数、错误）或 topic（事件）
+func ID(sig string) (string, error) {
+	kind, name, inputs, err := Parse(sig)
+	if err != nil {
+		return "", err
+	}
+	switch kind {
+	case "event":
+		return abi.NewEvent(name, name, false, inputs).ID.Hex(), nil
+	case "error":
+		e := abi.NewError(name, inputs)
+		return hexutil.Encode(e.ID[:4]), nil
+	default:
+		return hexutil.Encode(abi.NewMethod(name, name, abi.Function, "", false, false, inputs, nil).ID), nil
+	}
+}
+
+// human 生成带参数名和 indexed 的签名，比如 Transfer(address indexed from,address indexed to,uint256 value)
+func human(name string, inputs abi.Arguments) string {
+	params := make([]string, len(inputs))
+	for i, in := range inputs {
+		p := in.Type.String()
+		if in.Indexed {
+			p += " indexed"
+		}
+		if in.Name != "" {
+			p += " " + in.Name
+		}
+		params[i] = p
+	}
+	return name + "(" + strings.Join(params, ",") + ")"
+}
@@ -0,0 +1,167 @@
+package sigdb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"ethkit/abicall"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var ErrUnknown = errors.New("sigdb: no matching signature")
+
+// Call 是解码后的 calldata
+type Call struct {
+	Selector  string          `json:"selector"`
+	Name      string          `json:"name"`
+	Signature string          `json:"signature"`
+	Source    string          `json:"source"`
+	Args      []abicall.Value `json:"args"`
+	// Others 是同一个选择器下其他也能解码的签名
+	Others []string `json:"others,omitempty"`
+}
+
+// DecodeCalldata 按选择器查找签名并解码参数。同一个选择器有多个候选时，
+// 优先选解码后重新编码和原数据完全一致的那个，避免选中碰撞的签名
+func (db *DB) DecodeCalldata(data []byte) (*Call, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("sigdb: calldata shorter than a selector")
+	}
+	selector := [4]byte(data[:4])
+	var best *Call
+	exact := false
+	var others []string
+	for _, m := range db.Methods(selector) {
+		values, err := m.Inputs.Unpack(data[4:])
+		if err != nil {
+			continue
+		}
+		repacked, err := m.Inputs.Pack(values...)
+		strict := err == nil && bytes.Equal(repacked, data[4:])
+		call := &Call{
+			Selector:  hexutil.Encode(data[:4]),
+			Name:      m.RawName,
+			Signature: m.Sig,
+			Source:    m.Source,
+			Args:      namedValues(m.Inputs, values),
+		}
+		if best == nil || (strict && !exact) {
+			if best != nil {
+				others = append(others, best.Signature)
+			}
+			best, exact = call, strict
+			continue
+		}
+		others = append(others, m.Sig)
+	}
+	if best == nil {
+		return nil, fmt.Errorf("%w for selector %s", ErrUnknown, hexutil.Encode(data[:4]))
+	}
+	best.Others = dedup(others, best.Signature)
+	return best, nil
+}
+
+// Log 是解码后的日志
+type Log struct {
+	Address   common.Address  `json:"address"`
+	Name      string          `json:"name"`
+	Signature string          `json:"signature"`
+	Source    string          `json:"source"`
+	Guessed   bool            `json:"guessed,omitempty"` // indexed 参数是按 topic 数量推断的
+	Args      []abicall.Value `json:"args"`
+	Block     uint64          `json:"block"`
+	TxHash    common.Hash     `json:"txHash"`
+	Index     uint            `json:"logIndex"`
+}
+
+// DecodeLog 按 topic0 查找事件并解码。同名事件 indexed 参数数量不同的（比如 ERC-20 和 ERC-721 的 Transfer）按 topic 数量区分
+func (db *DB) DecodeLog(l types.Log) (*Log, error) {
+	if len(l.Topics) == 0 {
+		return nil, fmt.Errorf("%w: anonymous log", ErrUnknown)
+	}
+	for _, e := range db.Events(l.Topics[0]) {
+		event := e.Event
+		if e.Guessed {
+			event = guessIndexed(e.Event, len(l.Topics)-1)
+		}
+		args, err := abicall.DecodeEvent(event, l)
+		if err != nil {
+			continue
+		}
+		return &Log{
+			Address:   l.Address,
+			Name:      e.RawName,
+			Signature: e.Sig,
+			Source:    e.Source,
+			Guessed:   e.Guessed,
+			Args:      args,
+			Block:     l.BlockNumber,
+			TxHash:    l.TxHash,
+			Index:     l.Index,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w for topic %s", ErrUnknown, l.Topics[0].Hex())
+}
+
+// DecodeError 解码自定义错误的 revert 数据，Error(string) 和 Panic(uint256) 用 abi.UnpackRevert
+func (db *DB) DecodeError(data []byte) (*Call, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("sigdb: revert data shorter than a selector")
+	}
+	for _, e := range db.Errors([4]byte(data[:4])) {
+		values, err := e.Inputs.Unpack(data[4:])
+		if err != nil {
+			continue
+		}
+		return &Call{
+			Selector:  hexutil.Encode(data[:4]),
+			Name:      e.Name,
+			Signature: e.Sig,
+			Source:    e.Source,
+			Args:      namedValues(e.Inputs, values),
+		}, nil
+	}
+	return nil, fmt.Errorf("%w for error selector %s", ErrUnknown, hexutil.Encode(data[:4]))
+}
+
+// guessIndexed 假设前 n 个参数是 indexed，大多数合约的事件都是这样声明的
+func guessIndexed(e abi.Event, n int) abi.Event {
+	if n > len(e.Inputs) {
+		return e
+	}
+	inputs := make(abi.Arguments, len(e.Inputs))
+	copy(inputs, e.Inputs)
+	for i := range inputs {
+		inputs[i].Indexed = i < n
+	}
+	return abi.NewEvent(e.Name, e.RawName, e.Anonymous, inputs)
+}
+
+func namedValues(args abi.Arguments, values []any) []abicall.Value {
+	out := make([]abicall.Value, len(values))
+	for i, v := range values {
+		name := args[i].Name
+		if name == "" {
+			name = fmt.Sprintf("[%d]", i)
+		}
+		out[i] = abicall.Value{Name: name, Type: args[i].Type.String(), Value: v}
+	}
+	return out
+}
+
+func dedup(sigs []string, skip string) []string {
+	seen := map[string]bool{skip: true}
+	var out []string
+	for _, s := range sigs {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,200 @@
+package sigdb
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/gin-gonic/gin"
+)
+
+// Register 注册解码接口，backend 为 nil 时不注册按交易哈希解码的接口
+//
+//	POST /decode/calldata    {"data": "0x..."}
+//	POST /decode/log         {"topics": ["0x..."], "data": "0x..."}
+//	POST /decode/error       {"data": "0x..."} 自定义错误的 revert 数据
+//	GET  /decode/tx/:hash    解码交易的 calldata 和收据里的全部日志
+//	GET  /signatures/:id     选择器或 topic 对应的签名
+//	POST /signatures         {"signatures": ["event Foo(uint256 indexed a)"]} 导入签名
+func Register(r gin.IRouter, db *DB, backend ethereum.TransactionReader) {
+	h := &handler{db: db, backend: backend}
+	r.POST("/decode/calldata", h.calldata)
+	r.POST("/decode/log", h.log)
+	r.POST("/decode/error", h.revert)
+	if backend != nil {
+		r.GET("/decode/tx/:hash", h.tx)
+	}
+	r.GET("/signatures/:id", h.lookup)
+	r.POST("/signatures", h.add)
+}
+
+type handler struct {
+	db      *DB
+	backend ethereum.TransactionReader
+}
+
+type dataRequest struct {
+	Data hexutil.Bytes `json:"data" binding:"required"`
+}
+
+func (h *handler) calldata(c *gin.Context) {
+	var req dataRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	call, err := h.db.DecodeCalldata(req.Data)
+	if err != nil {
+		c.JSON(statusCode(err), gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, call)
+}
+
+func (h *handler) revert(c *gin.Context) {
+	var req dataRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	call, err := h.db.DecodeError(req.Data)
+	if err != nil {
+		c.JSON(statusCode(err), gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, call)
+}
+
+func (h *handler) log(c *gin.Context) {
+	var req struct {
+		Address common.Address `json:"address"`
+		Topics  []common.Hash  `json:"topics" binding:"required"`
+		Data    hexutil.Bytes  `json:"data"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	l, err := h.db.DecodeLog(types.Log{Address: req.Address, Topics: req.Topics, Data: req.Data})
+	if err != nil {
+		c.JSON(statusCode(err), gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, l)
+}
+
+// tx 解码不了的 calldata 和日志不算错误，对应字段为空并带上原因
+func (h *handler) tx(c *gin.Context) {
+	hash := c.Param("hash")
+	if len(hash) != 66 || !strings.HasPrefix(hash, "0x") {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid tx hash"})
+		return
+	}
+	ctx := c.Request.Context()
+	tx, pending, err := h.backend.TransactionByHash(ctx, common.HexToHash(hash))
+	if errors.Is(err, ethereum.NotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"msg": err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+		return
+	}
+	resp := gin.H{"hash": tx.Hash(), "to": tx.To(), "value": tx.Value().String(), "pending": pending}
+	if len(tx.Data()) >= 4 {
+		if call, err := h.db.DecodeCalldata(tx.Data()); err == nil {
+			resp["call"] = call
+		} else {
+			resp["callError"] = err.Error()
+		}
+	}
+	if pending {
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+	receipt, err := h.backend.TransactionReceipt(ctx, tx.Hash())
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+		return
+	}
+	type logView struct {
+		*Log
+		Raw   *types.Log `json:"raw,omitempty"`
+		Error string     `json:"error,omitempty"`
+	}
+	logs := make([]logView, len(receipt.Logs))
+	for i, l := range receipt.Logs {
+		decoded, err := h.db.DecodeLog(*l)
+		if err != nil {
+			logs[i] = logView{Raw: l, Error: err.Error()}
+			continue
+		}
+		logs[i] = logView{Log: decoded}
+	}
+	resp["status"] = receipt.Status
+	resp["logs"] = logs
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *handler) lookup(c *gin.Context) {
+	id, err := hexutil.Decode(c.Param("id"))
+	if err != nil || (len(id) != 4 && len(id) != 32) {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "want a 4-byte selector or a 32-byte topic"})
+		return
+	}
+	var sigs []gin.H
+	if len(id) == 32 {
+		for _, e := range h.db.Events(common.BytesToHash(id)) {
+			sigs = append(sigs, gin.H{"kind": "event", "signature": human(e.RawName, e.Inputs), "source": e.Source})
+		}
+	} else {
+		for _, m := range h.db.Methods([4]byte(id)) {
+			sigs = append(sigs, gin.H{"kind": "function", "signature": human(m.RawName, m.Inputs), "source": m.Source})
+		}
+		for _, e := range h.db.Errors([4]byte(id)) {
+			sigs = append(sigs, gin.H{"kind": "error", "signature": human(e.Name, e.Inputs), "source": e.Source})
+		}
+	}
+	if len(sigs) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"msg": ErrUnknown.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"signatures": sigs})
+}
+
+func (h *handler) add(c *gin.Context) {
+	var req struct {
+		Signatures []string `json:"signatures" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	ids := make([]string, len(req.Signatures))
+	for i, sig := range req.Signatures {
+		id, err := ID(sig)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+			return
+		}
+		ids[i] = id
+	}
+	for _, sig := range req.Signatures {
+		if err := h.db.AddSignature("api", sig); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+			return
+		}
+	}
+	c.JSON(http.StatusCreated, gin.H{"ids": ids})
+}
+
+func statusCode(err error) int {
+	if errors.Is(err, ErrUnknown) {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,211 @@
+package sigdb
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gin-gonic/gin"
+)
+
+type apiResponse struct {
+	Msg        string `json:"msg"`
+	Name       string `json:"name"`
+	Signature  string `json:"signature"`
+	Others     []string
+	Args       []struct{ Name, Type, Value string }
+	Signatures []struct{ Kind, Signature, Source string }
+	IDs        []string `json:"ids"`
+
+	Call      *struct{ Signature string }
+	CallError string
+	Status    *uint64
+	Pending   bool
+	Logs      []struct {
+		Signature string
+		Error     string
+		Args      []struct{ Name, Value string }
+	}
+}
+
+func serve(t *testing.T, r *gin.Engine, method, path, body string) (int, apiResponse) {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	var resp apiResponse
+	if !strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		return w.Code, resp // 没有注册的路由是 gin 的纯文本 404
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("%s %s: %v: %s", method, path, err, w.Body)
+	}
+	return w.Code, resp
+}
+
+func TestHTTP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db := Builtin()
+	mustAdd(t, db, "many_msg_babbage(bytes1)")
+	r := gin.New()
+	Register(r, db, nil)
+
+	to := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	transfer := hexutil.Encode(pack(t, "transfer(address,uint256)", to, big.NewInt(5)))
+	insufficient := hexutil.Encode(pack(t, "ERC20InsufficientBalance(address,uint256,uint256)", to, big.NewInt(1), big.NewInt(2)))
+	topic := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	fromTopic := common.BytesToHash(to.Bytes()).Hex()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		code   int
+		check  func(t *testing.T, resp apiResponse)
+	}{
+		{name: "calldata", method: http.MethodPost, path: "/decode/calldata", body: `{"data":"` + transfer + `"}`, code: http.StatusOK,
+			check: func(t *testing.T, resp apiResponse) {
+				if resp.Name != "transfer" || len(resp.Args) != 2 || resp.Args[1].Value != "5" || len(resp.Others) != 1 {
+					t.Fatalf("%+v", resp)
+				}
+			}},
+		{name: "calldata unknown", method: http.MethodPost, path: "/decode/calldata", body: `{"data":"0x12345678"}`, code: http.StatusNotFound},
+		{name: "calldata short", method: http.MethodPost, path: "/decode/calldata", body: `{"data":"0x12"}`, code: http.StatusBadRequest},
+		{name: "calldata bad hex", method: http.MethodPost, path: "/decode/calldata", body: `{"data":"xyz"}`, code: http.StatusBadRequest},
+		{name: "calldata missing", method: http.MethodPost, path: "/decode/calldata", body: `{}`, code: http.StatusBadRequest},
+		{name: "log", method: http.MethodPost, path: "/decode/log", code: http.StatusOK,
+			body: `{"topics":["` + topic + `","` + fromTopic + `","` + fromTopic + `"],"data":"` + hexutil.Encode(common.LeftPadBytes([]byte{9}, 32)) + `"}`,
+			check: func(t *testing.T, resp apiResponse) {
+				if resp.Signature != "Transfer(address,address,uint256)" || len(resp.Args) != 3 || resp.Args[2].Value != "9" {
+					t.Fatalf("%+v", resp)
+				}
+			}},
+		{name: "log unknown topic", method: http.MethodPost, path: "/decode/log", body: `{"topics":["0x` + strings.Repeat("1", 64) + `"]}`, code: http.StatusNotFound},
+		{name: "log without topics", method: http.MethodPost, path: "/decode/log", body: `{"data":"0x"}`, code: http.StatusBadRequest},
+		{name: "error", method: http.MethodPost, path: "/decode/error", body: `{"data":"` + insufficient + `"}`, code: http.StatusOK,
+			check: func(t *testing.T, resp apiResponse) {
+				if resp.Name != "ERC20InsufficientBalance" || len(resp.Args) != 3 {
+					t.Fatalf("%+v", resp)
+				}
+			}},
+		{name: "error unknown", method: http.MethodPost, path: "/decode/error", body: `{"data":"0xdeadbeef"}`, code: http.StatusNotFound},
+		{name: "lookup selector with collision", method: http.MethodGet, path: "/signatures/0xa9059cbb", code: http.StatusOK,
+			check: func(t *testing.T, resp apiResponse) {
+				var sigs []string
+				for _, s := range resp.Signatures {
+					sigs = append(sigs, s.Kind+" "+s.Signature)
+				}
+				if !contains(sigs, "function many_msg_babbage(bytes1)") || !contains(sigs, "function transfer(address to,uint256 value)") {
+					t.Fatalf("%v", sigs)
+				}
+			}},
+		{name: "lookup topic", method: http.MethodGet, path: "/signatures/" + topic, code: http.StatusOK,
+			check: func(t *testing.T, resp apiResponse) {
+				if len(resp.Signatures) < 2 || resp.Signatures[0].Kind != "event" {
+					t.Fatalf("%+v", resp.Signatures)
+				}
+			}},
+		{name: "lookup error selector", method: http.MethodGet, path: "/signatures/0xe450d38c", code: http.StatusOK,
+			check: func(t *testing.T, resp apiResponse) {
+				if len(resp.Signatures) != 1 || resp.Signatures[0].Kind != "error" {
+					t.Fatalf("%+v", resp.Signatures)
+				}
+			}},
+		{name: "lookup unknown", method: http.MethodGet, path: "/signatures/0x12345678", code: http.StatusNotFound},
+		{name: "lookup bad length", method: http.MethodGet, path: "/signatures/0x1234", code: http.StatusBadRequest},
+		{name: "add", method: http.MethodPost, path: "/signatures", code: http.StatusCreated,
+			body: `{"signatures":["function frob(uint256 times)","event Frobbed(address indexed by)"]}`,
+			check: func(t *testing.T, resp apiResponse) {
+				if len(resp.IDs) != 2 || resp.IDs[0] != hexID("frob(uint256)") {
+					t.Fatalf("%+v", resp.IDs)
+				}
+			}},
+		{name: "added signature is used", method: http.MethodPost, path: "/decode/calldata", code: http.StatusOK,
+			body: `{"data":"` + hexutil.Encode(pack(t, "frob(uint256)", big.NewInt(3))) + `"}`,
+			check: func(t *testing.T, resp apiResponse) {
+				if resp.Name != "frob" || resp.Args[0].Name != "times" {
+					t.Fatalf("%+v", resp)
+				}
+			}},
+		{name: "add invalid keeps db unchanged", method: http.MethodPost, path: "/signatures", code: http.StatusBadRequest,
+			body: `{"signatures":["function ok(uint256)","broken"]}`},
+		{name: "nothing added by the invalid request", method: http.MethodGet, path: "/signatures/" + hexID("ok(uint256)"), code: http.StatusNotFound},
+		{name: "tx route needs a backend", method: http.MethodGet, path: "/decode/tx/0x" + strings.Repeat("0", 64), code: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, resp := serve(t, r, tt.method, tt.path, tt.body)
+			if code != tt.code {
+				t.Fatalf("status %d, want %d: %+v", code, tt.code, resp)
+			}
+			if tt.check != nil {
+				tt.check(t, resp)
+			}
+		})
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// 按交易哈希解码：calldata 和收据里的日志都解出来，解不了的日志保留原文
+func TestHTTPTx(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	chain, err := simchain.New(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+	_, tok, _, err := chain.DeployToken(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := tok.Transfer(chain.Transactor(0), chain.Accounts[1].Address, big.NewInt(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chain.Mine(tx); err != nil {
+		t.Fatal(err)
+	}
+
+	r := gin.New()
+	Register(r, Builtin(), chain.Client)
+	code, resp := serve(t, r, http.MethodGet, "/decode/tx/"+tx.Hash().Hex(), "")
+	if code != http.StatusOK || resp.Call == nil || resp.Call.Signature != "transfer(address,uint256)" || resp.Status == nil || *resp.Status != 1 {
+		t.Fatalf("status %d: %+v", code, resp)
+	}
+	if len(resp.Logs) != 1 || resp.Logs[0].Signature != "Transfer(address,address,uint256)" || resp.Logs[0].Args[2].Value != "42" {
+		t.Fatalf("logs: %+v", resp.Logs)
+	}
+
+	// 数据库里没有的合约：calldata 和日志都解不了，但接口不报错
+	r = gin.New()
+	Register(r, New(), chain.Client)
+	code, resp = serve(t, r, http.MethodGet, "/decode/tx/"+tx.Hash().Hex(), "")
+	if code != http.StatusOK || resp.Call != nil || !strings.Contains(resp.CallError, "no matching signature") ||
+		len(resp.Logs) != 1 || !strings.Contains(resp.Logs[0].Error, "no matching signature") {
+		t.Fatalf("empty db: %d %+v", code, resp)
+	}
+
+	if code, _ := serve(t, r, http.MethodGet, "/decode/tx/0x"+strings.Repeat("1", 64), ""); code != http.StatusNotFound {
+		t.Fatalf("unknown tx: %d", code)
+	}
+	if code, _ := serve(t, r, http.MethodGet, "/decode/tx/0x1234", ""); code != http.StatusBadRequest {
+		t.Fatalf("bad hash: %d", code)
+	}
+}
@@ -0,0 +1,163 @@
+package sigdb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Parse 解析一条文本签名，支持两种写法：
+//
+//	transfer(address,uint256)                                        只有类型，4byte.directory 的格式
+//	event Transfer(address indexed from, address indexed to, uint256 value)   Solidity 风格，可带参数名和 indexed
+//
+// 前缀 function / event / error 决定种类，没有前缀时按函数处理；tuple 写成 (address,uint256)[]
+func Parse(sig string) (kind string, name string, inputs abi.Arguments, err error) {
+	sig = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(sig), ";"))
+	kind = "function"
+	for _, k := range []string{"function ", "event ", "error "} {
+		if strings.HasPrefix(sig, k) {
+			kind, sig = strings.TrimSpace(k), strings.TrimSpace(sig[len(k):])
+			break
+		}
+	}
+	open := strings.Index(sig, "(")
+	end := matching(sig, open)
+	if open <= 0 || end < 0 {
+		return "", "", nil, fmt.Errorf("sigdb: invalid signature %q", sig)
+	}
+	name = sig[:open]
+	params, err := parseParams(sig[open+1 : end])
+	if err != nil {
+		return "", "", nil, fmt.Errorf("sigdb: %q: %w", sig, err)
+	}
+	for i, p := range params {
+		t, err := abi.NewType(p.Type, "", p.Components)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("sigdb: %q: parameter %d: %w", sig, i, err)
+		}
+		inputs = append(inputs, abi.Argument{Name: p.Name, Type: t, Indexed: p.Indexed})
+	}
+	return kind, name, inputs, nil
+}
+
+// parseParams 解析逗号分隔的参数列表，每个参数是 "类型 [indexed] [名称]"
+func parseParams(s string) ([]abi.ArgumentMarshaling, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var out []abi.ArgumentMarshaling
+	for _, part := range split(s) {
+		part = strings.TrimSpace(part)
+		var p abi.ArgumentMarshaling
+		if strings.HasPrefix(part, "(") {
+			end := matching(part, 0)
+			if end < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses in %q", part)
+			}
+			components, err := parseParams(part[1:end])
+			if err != nil {
+				return nil, err
+			}
+			for i := range components {
+				if components[i].Name == "" {
+					components[i].Name = fmt.Sprintf("field%d", i)
+				}
+			}
+			rest := strings.Fields(part[end+1:])
+			suffix := ""
+			if len(rest) > 0 && strings.HasPrefix(rest[0], "[") {
+				suffix, rest = rest[0], rest[1:]
+			}
+			p = abi.ArgumentMarshaling{Type: "tuple" + suffix, Components: components}
+			if err := modifiers(&p, rest); err != nil {
+				return nil, err
+			}
+		} else {
+			fields := strings.Fields(part)
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("empty parameter")
+			}
+			p = abi.ArgumentMarshaling{Type: canonical(fields[0])}
+			if err := modifiers(&p, fields[1:]); err != nil {
+				return nil, err
+			}
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func modifiers(p *abi.ArgumentMarshaling, fields []string) error {
+	for _, f := range fields {
+		switch f {
+		case "indexed":
+			p.Indexed = true
+		case "memory", "calldata", "storage", "payable":
+		default:
+			if p.Name != "" {
+				return fmt.Errorf("unexpected %q after %s", f, p.Name)
+			}
+			p.Name = f
+		}
+	}
+	return nil
+}
+
+// canonical 把 uint、int 这类别名换成规范写法，选择器按规范写法计算
+func canonical(t string) string {
+	base, suffix := t, ""
+	if i := strings.Index(t, "["); i >= 0 {
+		base, suffix = t[:i], t[i:]
+	}
+	switch base {
+	case "uint":
+		base = "uint256"
+	case "int":
+		base = "int256"
+	case "byte":
+		base = "bytes1"
+	}
+	return base + suffix
+}
+
+// split 按最外层的逗号切分
+func split(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// matching 返回和 s[open] 处的左括号配对的右括号位置
+func matching(s string, open int) int {
+	if open < 0 || open >= len(s) || s[open] != '(' {
+		return -1
+	}
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
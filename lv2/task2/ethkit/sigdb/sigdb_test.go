@@ -0,0 +1,375 @@
+package sigdb
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		sig   string
+		kind  string
+		name  string
+		human string // 带参数名和 indexed 的签名，见 human
+		id    string
+		err   string
+	}{
+		{sig: "transfer(address,uint256)", kind: "function", name: "transfer", human: "transfer(address,uint256)", id: "0xa9059cbb"},
+		{sig: "function transfer(address to, uint amount) external;", kind: "function", name: "transfer",
+			human: "transfer(address to,uint256 amount)", id: "0xa9059cbb"},
+		{sig: "setData(bytes memory data, string calldata note)", kind: "function", name: "setData",
+			human: "setData(bytes data,string note)", id: hexID("setData(bytes,string)")},
+		{sig: "event Transfer(address indexed from, address indexed to, uint256 value)", kind: "event", name: "Transfer",
+			human: "Transfer(address indexed from,address indexed to,uint256 value)",
+			id:    "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+		{sig: "error ERC20InsufficientBalance(address sender, uint256 balance, uint256 needed)", kind: "error",
+			name: "ERC20InsufficientBalance", human: "ERC20InsufficientBalance(address sender,uint256 balance,uint256 needed)", id: "0xe450d38c"},
+		{sig: "f(uint[],int,byte)", kind: "function", name: "f", human: "f(uint256[],int256,bytes1)", id: hexID("f(uint256[],int256,bytes1)")},
+		{sig: "aggregate3((address,bool,bytes)[] calls)", kind: "function", name: "aggregate3",
+			human: "aggregate3((address,bool,bytes)[] calls)", id: "0x82ad56cb"},
+		{sig: "g((uint8,(address,bytes32)) p)", kind: "function", name: "g",
+			human: "g((uint8,(address,bytes32)) p)", id: hexID("g((uint8,(address,bytes32)))")},
+		{sig: "noArgs()", kind: "function", name: "noArgs", human: "noArgs()", id: hexID("noArgs()")},
+
+		{sig: "transfer", err: "invalid signature"},
+		{sig: "transfer(address", err: "invalid signature"},
+		{sig: "(address)", err: "invalid signature"},
+		{sig: "f(address,foo)", err: "parameter 1"},
+		{sig: "f(address to from)", err: `unexpected "from" after to`},
+		{sig: "f(address,)", err: "empty parameter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sig, func(t *testing.T) {
+			kind, name, inputs, err := Parse(tt.sig)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if kind != tt.kind || name != tt.name || human(name, inputs) != tt.human {
+				t.Fatalf("got %s %s, want %s %s", kind, human(name, inputs), tt.kind, tt.human)
+			}
+			if id, err := ID(tt.sig); err != nil || id != tt.id {
+				t.Fatalf("ID = %s, %v; want %s", id, err, tt.id)
+			}
+		})
+	}
+}
+
+func hexID(sig string) string {
+	return "0x" + common.Bytes2Hex(crypto.Keccak256([]byte(sig))[:4])
+}
+
+func mustAdd(t *testing.T, db *DB, sigs ...string) {
+	t.Helper()
+	for _, sig := range sigs {
+		if err := db.AddSignature("test", sig); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func pack(t *testing.T, sig string, args ...any) []byte {
+	t.Helper()
+	_, name, inputs, err := Parse(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := inputs.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(abi.NewMethod(name, name, abi.Function, "", false, false, inputs, nil).ID, data...)
+}
+
+// transfer(address,uint256) 和 many_msg_babbage(bytes1) 的选择器都是 0xa9059cbb，
+// burn(uint256) 和 collate_propagate_storage(bytes16) 都是 0x42966c68
+func TestDecodeCalldataCollision(t *testing.T) {
+	db := New()
+	// 先加碰撞的签名，确认不是按加入顺序选的
+	mustAdd(t, db, "many_msg_babbage(bytes1)", "function transfer(address to,uint256 amount)", "transfer(address,uint256)",
+		"collate_propagate_storage(bytes16)", "burn(uint256)")
+	if n := len(db.Methods([4]byte(common.FromHex("0xa9059cbb")))); n != 3 {
+		t.Fatalf("%d methods for 0xa9059cbb, want 3 (参数名不同的同一签名也保留)", n)
+	}
+	to := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	var b16 [16]byte
+	b16[0] = 0xff
+
+	tests := []struct {
+		name   string
+		data   []byte
+		sig    string
+		args   string
+		others []string
+		err    error
+	}{
+		{name: "transfer wins over bytes1", data: pack(t, "transfer(address,uint256)", to, big.NewInt(5)),
+			sig: "transfer(address,uint256)", args: "to=" + to.Hex() + " amount=5", others: []string{"many_msg_babbage(bytes1)"}},
+		{name: "bytes1 when transfer cannot decode", data: pack(t, "many_msg_babbage(bytes1)", [1]byte{7}),
+			sig: "many_msg_babbage(bytes1)", args: "[0]=0x07"},
+		{name: "uint256 and bytes16 both decode", data: pack(t, "collate_propagate_storage(bytes16)", b16),
+			sig: "collate_propagate_storage(bytes16)", args: "[0]=0xff000000000000000000000000000000", others: []string{"burn(uint256)"}},
+		{name: "burn", data: pack(t, "burn(uint256)", big.NewInt(1)),
+			sig: "burn(uint256)", args: "[0]=1", others: []string{"collate_propagate_storage(bytes16)"}},
+		{name: "unknown selector", data: common.FromHex("0x12345678"), err: ErrUnknown},
+		{name: "short", data: []byte{0xa9, 0x05}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			call, err := db.DecodeCalldata(tt.data)
+			if tt.sig == "" {
+				if err == nil || (tt.err != nil && !errors.Is(err, tt.err)) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var args []string
+			for _, a := range call.Args {
+				args = append(args, a.Name+"="+a.String())
+			}
+			if call.Signature != tt.sig || strings.Join(args, " ") != tt.args || strings.Join(call.Others, ",") != strings.Join(tt.others, ",") {
+				t.Fatalf("got %s %v others %v; want %s %s others %v", call.Signature, args, call.Others, tt.sig, tt.args, tt.others)
+			}
+		})
+	}
+}
+
+func TestDecodeLog(t *testing.T) {
+	db := New()
+	mustAdd(t, db,
+		"event Transfer(address indexed from,address indexed to,uint256 value)",
+		"event Transfer(address indexed from,address indexed to,uint256 indexed tokenId)",
+		"event Deposit(address,uint256)", // 没有 indexed 信息，按 topic 数量推断
+		"event Named(string indexed label,uint256 value)",
+	)
+	from, to := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	transfer := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	deposit := crypto.Keccak256Hash([]byte("Deposit(address,uint256)"))
+	word := func(v int64) []byte { return common.LeftPadBytes(big.NewInt(v).Bytes(), 32) }
+	label := crypto.Keccak256Hash([]byte("hello"))
+
+	tests := []struct {
+		name    string
+		log     types.Log
+		human   string
+		args    string
+		guessed bool
+		err     error
+	}{
+		{name: "erc20 transfer", log: types.Log{Topics: []common.Hash{transfer, addrTopic(from), addrTopic(to)}, Data: word(100)},
+			human: "Transfer(address indexed from,address indexed to,uint256 value)", args: "from=" + from.Hex() + " to=" + to.Hex() + " value=100"},
+		{name: "erc721 transfer", log: types.Log{Topics: []common.Hash{transfer, addrTopic(from), addrTopic(to), common.BigToHash(big.NewInt(7))}},
+			human: "Transfer(address indexed from,address indexed to,uint256 indexed tokenId)", args: "from=" + from.Hex() + " to=" + to.Hex() + " tokenId=7"},
+		{name: "guessed indexed", log: types.Log{Topics: []common.Hash{deposit, addrTopic(from)}, Data: word(3)},
+			human: "Deposit(address,uint256)", args: "arg0=" + from.Hex() + " arg1=3", guessed: true},
+		{name: "guessed none indexed", log: types.Log{Topics: []common.Hash{deposit}, Data: append(word(1), word(3)...)},
+			human: "Deposit(address,uint256)", args: "arg0=" + common.BytesToAddress(word(1)).Hex() + " arg1=3", guessed: true},
+		{name: "indexed string is a hash", log: types.Log{Topics: []common.Hash{crypto.Keccak256Hash([]byte("Named(string,uint256)")), label}, Data: word(1)},
+			human: "Named(string indexed label,uint256 value)", args: "label=" + label.Hex() + " value=1"},
+		{name: "wrong topic count", log: types.Log{Topics: []common.Hash{transfer, addrTopic(from)}, Data: word(1)}, err: ErrUnknown},
+		{name: "unknown topic", log: types.Log{Topics: []common.Hash{common.HexToHash("0x01")}}, err: ErrUnknown},
+		{name: "anonymous", log: types.Log{}, err: ErrUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := db.DecodeLog(tt.log)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var args []string
+			for _, a := range l.Args {
+				args = append(args, a.Name+"="+a.String())
+			}
+			if got := human(l.Name, db.Events(tt.log.Topics[0])[0].Inputs); l.Guessed != tt.guessed || strings.Join(args, " ") != tt.args {
+				t.Fatalf("got %s %v guessed %v; want %s %s", got, args, l.Guessed, tt.human, tt.args)
+			}
+			if !strings.HasPrefix(tt.human, l.Name+"(") {
+				t.Fatalf("name %s, want %s", l.Name, tt.human)
+			}
+		})
+	}
+}
+
+func addrTopic(a common.Address) common.Hash {
+	return common.BytesToHash(a.Bytes())
+}
+
+func TestDecodeError(t *testing.T) {
+	db := Builtin()
+	sender := common.HexToAddress("0x01")
+	data := pack(t, "ERC20InsufficientBalance(address,uint256,uint256)", sender, big.NewInt(1), big.NewInt(2))
+	call, err := db.DecodeError(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if call.Name != "ERC20InsufficientBalance" || call.Selector != "0xe450d38c" || len(call.Args) != 3 || call.Args[2].String() != "2" {
+		t.Fatalf("decoded %+v", call)
+	}
+	if _, err := db.DecodeError(common.FromHex("0x12345678")); !errors.Is(err, ErrUnknown) {
+		t.Fatalf("unknown error: %v", err)
+	}
+	if _, err := db.DecodeError(data[:4]); !errors.Is(err, ErrUnknown) {
+		t.Fatalf("truncated args: %v", err)
+	}
+	if _, err := db.DecodeError([]byte{1}); err == nil || errors.Is(err, ErrUnknown) {
+		t.Fatalf("short data: %v", err)
+	}
+}
+
+func TestImport(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		n       int
+		err     string
+	}{
+		{name: "text", file: "sigs.txt", n: 3, content: `# 4byte.directory 导出
+transfer(address,uint256)
+
+event Deposit(address indexed dst, uint256 wad)
+error Unauthorized(address caller)
+`},
+		{name: "text with a bad line", file: "bad.txt", n: 1, content: "approve(address,uint256)\nnot a signature\n", err: "bad.txt:2: sigdb: invalid signature"},
+		{name: "json", file: "sigs.json", n: 3, content: `{
+  "0xa9059cbb": ["transfer(address,uint256)", "many_msg_babbage(bytes1)"],
+  "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef": "event Transfer(address indexed from,address indexed to,uint256 value)"
+}`},
+		{name: "json key mismatch", file: "mismatch.json", content: `{"0x12345678": ["transfer(address,uint256)"]}`,
+			err: "transfer(address,uint256) hashes to 0xa9059cbb, not 0x12345678"},
+		{name: "json bad value", file: "value.json", content: `{"0xa9059cbb": 1}`, err: "want a signature or a list of signatures"},
+		{name: "json syntax", file: "syntax.json", content: `{`, err: "syntax.json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := New().Import(write(tt.file, tt.content))
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %q", err, tt.err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if n != tt.n {
+				t.Fatalf("imported %d, want %d", n, tt.n)
+			}
+		})
+	}
+	if _, err := New().Import(filepath.Join(dir, "missing.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("missing file: %v", err)
+	}
+}
+
+// Export 的输出用 Import 读回，签名、参数名和 indexed 都不变；推断的 indexed 不写出去
+func TestExportImport(t *testing.T) {
+	db := Builtin()
+	mustAdd(t, db, "event Ping(address,uint256)")
+	var buf bytes.Buffer
+	if err := db.Export(&buf); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "export.json")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	back := New()
+	if _, err := back.Import(path); err != nil {
+		t.Fatal(err)
+	}
+	m1, e1, r1 := db.Len()
+	m2, e2, r2 := back.Len()
+	if m1 != m2 || e1 != e2 || r1 != r2 {
+		t.Fatalf("len %d/%d/%d, after round trip %d/%d/%d", m1, e1, r1, m2, e2, r2)
+	}
+	var again bytes.Buffer
+	if err := back.Export(&again); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != buf.String() {
+		t.Fatalf("export differs after round trip:\n%s\n---\n%s", buf.String(), again.String())
+	}
+	ping := back.Events(crypto.Keccak256Hash([]byte("Ping(address,uint256)")))
+	if len(ping) != 1 || !ping[0].Guessed {
+		t.Fatalf("guessed event after round trip: %+v", ping)
+	}
+	// 带参数名、没有 indexed 参数的事件不是推断的
+	paused := back.Events(crypto.Keccak256Hash([]byte("Paused(address)")))
+	if len(paused) != 1 || paused[0].Guessed || paused[0].Inputs[0].Name != "account" {
+		t.Fatalf("Paused after round trip: %+v", paused)
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"Store.abi": `[{"type":"function","name":"setItem","inputs":[{"name":"key","type":"bytes32"},{"name":"value","type":"bytes32"}],"outputs":[],"stateMutability":"nonpayable"}]`,
+		"artifacts/Token.sol/Token.json": `{"contractName":"Token","abi":[{"type":"event","name":"Minted","anonymous":false,
+			"inputs":[{"name":"to","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false}]}],"bytecode":"0x"}`,
+		"package.json":                       `{"name":"demo"}`,
+		"node_modules/dep/Dep.abi":           `not json`,
+		"artifacts/build-info/x.json":        `{"abi": 1}`,
+		"artifacts/Token.sol/Token.dbg.json": `{"buildInfo":"../build-info/x.json"}`,
+		"contracts/README.md":                "# not an artifact",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db := New()
+	n, err := db.LoadDir(root)
+	if err != nil || n != 2 {
+		t.Fatalf("loaded %d, %v", n, err)
+	}
+	if ms := db.Methods([4]byte(common.FromHex("0xf56256c7"))); len(ms) != 1 || ms[0].Source != "Store.abi" {
+		t.Fatalf("setItem: %+v", ms)
+	}
+	if es := db.Events(crypto.Keccak256Hash([]byte("Minted(address,uint256)"))); len(es) != 1 || es[0].Source != "artifacts/Token.sol/Token.json" {
+		t.Fatalf("Minted: %+v", es)
+	}
+
+	// 坏掉的 .abi 是错误，坏掉的 .json 只是跳过
+	if err := os.WriteFile(filepath.Join(root, "Broken.abi"), []byte("not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New().LoadDir(root); err == nil || !strings.Contains(err.Error(), "Broken.abi") {
+		t.Fatalf("broken abi: %v", err)
+	}
+}
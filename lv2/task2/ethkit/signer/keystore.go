@@ -0,0 +1,55 @@
+package signer
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Keystore 用 keystore 目录里的加密私钥签名交易，私钥只在解锁后留在内存里，不写进代码和命令行参数
+type Keystore struct {
+	ks      *keystore.KeyStore
+	account accounts.Account
+}
+
+// Open 打开 dir 里 address 对应的账户并用 passphrase 解锁
+func Open(dir string, address common.Address, passphrase string) (*Keystore, error) {
+	ks := keystore.NewKeyStore(dir, keystore.StandardScryptN, keystore.StandardScryptP)
+	account, err := ks.Find(accounts.Account{Address: address})
+	if err != nil {
+		return nil, fmt.Errorf("keystore %s: %s: %w", dir, address.Hex(), err)
+	}
+	if err := ks.Unlock(account, passphrase); err != nil {
+		return nil, fmt.Errorf("keystore %s: unlock %s: %w", dir, address.Hex(), err)
+	}
+	return &Keystore{ks: ks, account: account}, nil
+}
+
+// ReadPassphrase 从文件读取口令，去掉末尾换行
+func ReadPassphrase(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func (k *Keystore) Address() common.Address {
+	return k.account.Address
+}
+
+// Transactor 返回给 abigen 绑定使用的签名器
+func (k *Keystore) Transactor(chainID *big.Int) (*bind.TransactOpts, error) {
+	return bind.NewKeyStoreTransactorWithChainID(k.ks, k.account, chainID)
+}
+
+// Close 重新锁定账户
+func (k *Keystore) Close() error {
+	return k.ks.Lock(k.account.Address)
+}
@@ -0,0 +1,66 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ContextSigner 是签名前还要访问节点的签名器，比如 preflight.Guard 的检查。
+// bind.SignerFn 拿不到 ctx，用 WithSigner 装进 TransactOpts 后由 WithContext 按新的 ctx 重新绑定
+type ContextSigner func(ctx context.Context, from common.Address, tx *types.Transaction) (*types.Transaction, error)
+
+type contextSignerKey struct{}
+
+// binding 是存在 TransactOpts.Context 里的签名器和它的标记
+type binding struct {
+	sign ContextSigner
+	tag  any
+}
+
+// WithSigner 复制一份签名器，Signer 换成 sign，签名时用的是 TransactOpts 上的 Context
+func WithSigner(auth *bind.TransactOpts, sign ContextSigner) *bind.TransactOpts {
+	return WithTaggedSigner(auth, sign, nil)
+}
+
+// WithTaggedSigner 和 WithSigner 一样，另外给签名器带上 tag，WithContext 换 ctx 之后仍然能用 Tag 取回
+func WithTaggedSigner(auth *bind.TransactOpts, sign ContextSigner, tag any) *bind.TransactOpts {
+	ctx := auth.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return bindSigner(ctx, auth, binding{sign: sign, tag: tag})
+}
+
+// Tag 返回 auth 上的签名器由 WithTaggedSigner 装上时带的 tag，没有时返回 nil
+func Tag(auth *bind.TransactOpts) any {
+	if auth.Context == nil {
+		return nil
+	}
+	b, _ := auth.Context.Value(contextSignerKey{}).(binding)
+	return b.tag
+}
+
+// WithContext 复制一份签名器并换上 ctx，不修改调用方的 TransactOpts。
+// 用 WithSigner 装上的签名器也改用 ctx，取消和超时能传到签名前的请求
+func WithContext(ctx context.Context, auth *bind.TransactOpts) *bind.TransactOpts {
+	if auth.Context != nil {
+		if b, ok := auth.Context.Value(contextSignerKey{}).(binding); ok {
+			return bindSigner(ctx, auth, b)
+		}
+	}
+	opts := *auth
+	opts.Context = ctx
+	return &opts
+}
+
+func bindSigner(ctx context.Context, auth *bind.TransactOpts, b binding) *bind.TransactOpts {
+	opts := *auth
+	opts.Context = context.WithValue(ctx, contextSignerKey{}, b)
+	opts.Signer = func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		return b.sign(ctx, from, tx)
+	}
+	return &opts
+}
@@ -0,0 +1,198 @@
+package simchain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"ethkit/abicall"
+	"ethkit/contracts/multicall3"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// DefaultBalance 每个预置账户在创世块里的余额：1000 ETH
+var DefaultBalance = new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))
+
+// Account 是一个确定性生成的测试账户，每次启动私钥都相同，方便写断言
+type Account struct {
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+}
+
+// Chain 是基于 go-ethereum simulated backend 的本地链，只有调用 Commit 才会出块
+type Chain struct {
+	Backend  *simulated.Backend
+	Client   simulated.Client
+	ChainID  *big.Int
+	Accounts []Account
+}
+
+// Option 透传给 simulated.NewBackend，可以调整节点和链的配置
+type Option = func(nodeConf *node.Config, ethConf *ethconfig.Config)
+
+// New 启动一条带 n 个预置账户的链，账户私钥由 DeterministicKey(i) 生成
+func New(n int, opts ...Option) (*Chain, error) {
+	if n <= 0 {
+		return nil, errors.New("simchain: need at least one account")
+	}
+	keys := make([]*ecdsa.PrivateKey, n)
+	for i := range keys {
+		keys[i] = DeterministicKey(i)
+	}
+	return NewWithKeys(keys, opts...)
+}
+
+// NewWithKeys 用给定的私钥作为预置账户启动链，比如由助记词派生的账户
+func NewWithKeys(keys []*ecdsa.PrivateKey, opts ...Option) (*Chain, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("simchain: need at least one account")
+	}
+	accounts := make([]Account, len(keys))
+	alloc := types.GenesisAlloc{}
+	for i, key := range keys {
+		accounts[i] = Account{Key: key, Address: crypto.PubkeyToAddress(key.PublicKey)}
+		alloc[accounts[i].Address] = types.Account{Balance: new(big.Int).Set(DefaultBalance)}
+	}
+	// 和主网一样在固定地址上预置 Multicall3
+	alloc[multicall3.Address] = types.Account{Code: multicall3.Runtime()}
+
+	backend := simulated.NewBackend(alloc, opts...)
+	// 创世块没有 prevrandao，在它上面执行时 EVM 不认为已经合并，Shanghai 的 PUSH0 会报 invalid opcode，
+	// 先出一个空块让后续的 eth_call/eth_estimateGas 都跑在合并后的区块上
+	backend.Commit()
+	client := backend.Client()
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		backend.Close()
+		return nil, err
+	}
+	return &Chain{
+		Backend:  backend,
+		Client:   client,
+		ChainID:  chainID,
+		Accounts: accounts,
+	}, nil
+}
+
+// DeterministicKey 由下标推导私钥：keccak256("simchain account <i>")
+func DeterministicKey(i int) *ecdsa.PrivateKey {
+	seed := crypto.Keccak256([]byte(fmt.Sprintf("simchain account %d", i)))
+	key, err := crypto.ToECDSA(seed)
+	if err != nil {
+		panic(err) // keccak 输出落在曲线阶之外的概率可以忽略
+	}
+	return key
+}
+
+func (c *Chain) Close() error {
+	return c.Backend.Close()
+}
+
+// Commit 把交易池里的交易打包成一个新区块
+func (c *Chain) Commit() common.Hash {
+	return c.Backend.Commit()
+}
+
+// Transactor 返回账户 i 的签名器
+func (c *Chain) Transactor(i int) *bind.TransactOpts {
+	auth, err := bind.NewKeyedTransactorWithChainID(c.Accounts[i].Key, c.ChainID)
+	if err != nil {
+		panic(err) // 只有 chainID 为 nil 时才会出错
+	}
+	return auth
+}
+
+// Mine 出块并返回 tx 的收据，交易执行失败（status=0）时重放交易，返回 *abicall.RevertError
+func (c *Chain) Mine(tx *types.Transaction) (*types.Receipt, error) {
+	c.Commit()
+	receipt, err := c.Client.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, abicall.ReplayRevert(context.Background(), c.Client, tx, receipt)
+	}
+	return receipt, nil
+}
+
+// Fund 从账户 0 向 to 转 wei 并出块
+func (c *Chain) Fund(to common.Address, wei *big.Int) (*types.Receipt, error) {
+	return c.Transfer(0, to, wei)
+}
+
+// Transfer 从账户 from 向 to 转 wei 并出块
+func (c *Chain) Transfer(from int, to common.Address, wei *big.Int) (*types.Receipt, error) {
+	ctx := context.Background()
+	sender := c.Accounts[from]
+	nonce, err := c.Client.PendingNonceAt(ctx, sender.Address)
+	if err != nil {
+		return nil, err
+	}
+	tip, err := c.Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	head, err := c.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	// 收款方可能是合约，gas 不能写死 21000；余额不够或 receive 里 revert 时估算就失败，不发送
+	gas, err := c.Client.EstimateGas(ctx, callMsg(sender.Address, to, wei))
+	if err != nil {
+		return nil, abicall.WrapRevert(err)
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   c.ChainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        &to,
+		Value:     wei,
+	})
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(c.ChainID), sender.Key)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Client.SendTransaction(ctx, signed); err != nil {
+		return nil, err
+	}
+	return c.Mine(signed)
+}
+
+// Deploy 用 ABI 和字节码部署任意合约，构造参数按 ABI 编码，部署后自动出块
+func (c *Chain) Deploy(deployer int, abiJSON, bin string, args ...any) (common.Address, *types.Receipt, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	address, tx, _, err := bind.DeployContract(c.Transactor(deployer), parsed, common.FromHex(bin), c.Client, args...)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	receipt, err := c.Mine(tx)
+	return address, receipt, err
+}
+
+// BalanceAt 查询最新区块上的 ETH 余额
+func (c *Chain) BalanceAt(addr common.Address) (*big.Int, error) {
+	return c.Client.BalanceAt(context.Background(), addr, nil)
+}
+
+func callMsg(from, to common.Address, value *big.Int) ethereum.CallMsg {
+	return ethereum.CallMsg{From: from, To: &to, Value: value}
+}
@@ -0,0 +1,41 @@
+package simchain
+
+import (
+	"ethkit/contracts/multicall3"
+	"ethkit/contracts/token"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	store "store/contracts"
+)
+
+// DeployStore 用 abigen 生成的 DeployStore 部署 Store 合约（17/store），并出块
+func (c *Chain) DeployStore(deployer int, version string) (common.Address, *store.Store, *types.Receipt, error) {
+	address, tx, instance, err := store.DeployStore(c.Transactor(deployer), c.Client, version)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	receipt, err := c.Mine(tx)
+	return address, instance, receipt, err
+}
+
+// DeployToken 部署 ERC-20 代币（RccToken），全部初始供应量归部署者
+func (c *Chain) DeployToken(deployer int) (common.Address, *token.Token, *types.Receipt, error) {
+	address, tx, instance, err := token.DeployToken(c.Transactor(deployer), c.Client)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	receipt, err := c.Mine(tx)
+	return address, instance, receipt, err
+}
+
+// DeployMulticall3 另外部署一份 Multicall3。创世块里已经有固定地址上的那份，
+// 这个用来测试指定区块上 Multicall3 还没部署的情况
+func (c *Chain) DeployMulticall3(deployer int) (common.Address, *types.Receipt, error) {
+	address, tx, _, err := multicall3.DeployMulticall3(c.Transactor(deployer), c.Client)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	receipt, err := c.Mine(tx)
+	return address, receipt, err
+}
@@ -0,0 +1,44 @@
+package simchain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Events 解码收据里由 contract 发出的 name 事件，indexed 和非 indexed 参数都放进同一个 map
+func Events(receipt *types.Receipt, contract common.Address, abiJSON, name string) ([]map[string]any, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+	event, ok := parsed.Events[name]
+	if !ok {
+		return nil, fmt.Errorf("simchain: event %s not in abi", name)
+	}
+
+	var out []map[string]any
+	for _, log := range receipt.Logs {
+		if log.Address != contract || len(log.Topics) == 0 || log.Topics[0] != event.ID {
+			continue
+		}
+		fields := map[string]any{}
+		if err := parsed.UnpackIntoMap(fields, name, log.Data); err != nil {
+			return nil, err
+		}
+		var indexed abi.Arguments
+		for _, arg := range event.Inputs {
+			if arg.Indexed {
+				indexed = append(indexed, arg)
+			}
+		}
+		if err := abi.ParseTopicsIntoMap(fields, indexed, log.Topics[1:]); err != nil {
+			return nil, err
+		}
+		out = append(out, fields)
+	}
+	return out, nil
+}
@@ -0,0 +1,77 @@
+// Package simchaintest 是基于 simchain 写测试时用的断言，只应该在 _test.go 里导入
+package simchaintest
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// RequireEvent 断言收据里至少有一个 name 事件的字段与 want 一致，want 里没有列出的字段不比较
+func RequireEvent(t testing.TB, receipt *types.Receipt, contract common.Address, abiJSON, name string, want map[string]any) {
+	t.Helper()
+	events, err := simchain.Events(receipt, contract, abiJSON, name)
+	if err != nil {
+		t.Fatalf("decode %s events: %v", name, err)
+	}
+	for _, got := range events {
+		if matches(got, want) {
+			return
+		}
+	}
+	t.Fatalf("no %s event matching %v, got %v", name, want, events)
+}
+
+// RequireBalance 断言 addr 在最新区块上的 ETH 余额
+func RequireBalance(t testing.TB, chain *simchain.Chain, addr common.Address, want *big.Int) {
+	t.Helper()
+	got, err := chain.BalanceAt(addr)
+	if err != nil {
+		t.Fatalf("balance of %s: %v", addr.Hex(), err)
+	}
+	if got.Cmp(want) != 0 {
+		t.Fatalf("balance of %s = %s, want %s", addr.Hex(), got, want)
+	}
+}
+
+// BalanceOfCaller 是 ERC-20 绑定里 BalanceOf 方法的抽象，Token 和 WETH 之类的绑定都满足
+type BalanceOfCaller interface {
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+}
+
+// RequireTokenBalance 断言 addr 的代币余额
+func RequireTokenBalance(t testing.TB, token BalanceOfCaller, addr common.Address, want *big.Int) {
+	t.Helper()
+	got, err := token.BalanceOf(&bind.CallOpts{}, addr)
+	if err != nil {
+		t.Fatalf("token balance of %s: %v", addr.Hex(), err)
+	}
+	if got.Cmp(want) != 0 {
+		t.Fatalf("token balance of %s = %s, want %s", addr.Hex(), got, want)
+	}
+}
+
+func matches(got, want map[string]any) bool {
+	for k, w := range want {
+		g, ok := got[k]
+		if !ok {
+			return false
+		}
+		if gb, ok := g.(*big.Int); ok {
+			if wb, ok := w.(*big.Int); ok && gb.Cmp(wb) == 0 {
+				continue
+			}
+			return false
+		}
+		if !reflect.DeepEqual(g, w) {
+			return false
+		}
+	}
+	return true
+}
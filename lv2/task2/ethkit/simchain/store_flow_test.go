@@ -0,0 +1,264 @@
+package simchain_test
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"ethkit/abicall"
+	"ethkit/contracts/token"
+	"ethkit/simchain"
+	"ethkit/simchain/simchaintest"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	erc20 "readerc/contracts_erc20"
+	store "store/contracts"
+)
+
+func word(s string) [32]byte {
+	var b [32]byte
+	copy(b[:], s)
+	return b
+}
+
+// 18~21 的流程：部署、按地址加载、读 version、写 items 并读回
+func TestStoreFlow(t *testing.T) {
+	chain, err := simchain.New(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+
+	address, _, receipt, err := chain.DeployStore(0, "1.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.ContractAddress != address {
+		t.Fatalf("receipt address = %s, want %s", receipt.ContractAddress.Hex(), address.Hex())
+	}
+	instance, err := store.NewStore(address, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	version, err := instance.Version(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != "1.0" {
+		t.Fatalf("version = %q", version)
+	}
+
+	tests := []struct {
+		name       string
+		from       int
+		key, value string
+	}{
+		{"deployer writes", 0, "foo", "bar"},
+		{"other account writes", 1, "baz", "qux"},
+		{"overwrite", 2, "foo", "updated"},
+		{"empty value", 1, "baz", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, value := word(tt.key), word(tt.value)
+			tx, err := instance.SetItem(chain.Transactor(tt.from), key, value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			receipt, err := chain.Mine(tx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			simchaintest.RequireEvent(t, receipt, address, store.StoreMetaData.ABI, "ItemSet", map[string]any{
+				"key":   key,
+				"value": value,
+			})
+			got, err := instance.Items(&bind.CallOpts{}, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got[:], value[:]) {
+				t.Fatalf("items[%s] = %x, want %x", tt.key, got, value)
+			}
+		})
+	}
+
+	// 其他合约地址上的日志不算
+	events, err := simchain.Events(receipt, chain.Accounts[0].Address, store.StoreMetaData.ABI, "ItemSet")
+	if err != nil || len(events) != 0 {
+		t.Fatalf("events from other address = %v, %v", events, err)
+	}
+	if _, err := simchain.Events(receipt, address, store.StoreMetaData.ABI, "Missing"); err == nil {
+		t.Fatal("decoding unknown event succeeded")
+	}
+}
+
+func TestFund(t *testing.T) {
+	chain, err := simchain.New(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+
+	to := crypto.PubkeyToAddress(simchain.DeterministicKey(99).PublicKey)
+	oneEth := big.NewInt(params.Ether)
+	if _, err := chain.Fund(to, oneEth); err != nil {
+		t.Fatal(err)
+	}
+	simchaintest.RequireBalance(t, chain, to, oneEth)
+
+	// 余额不够时估算 gas 就失败，不会发出交易
+	tooMuch := new(big.Int).Mul(simchain.DefaultBalance, big.NewInt(2))
+	if _, err := chain.Fund(to, tooMuch); err == nil {
+		t.Fatal("funding more than the balance succeeded")
+	}
+	simchaintest.RequireBalance(t, chain, to, oneEth)
+}
+
+// 04、05 课的流程：04、05 的 ERC20 绑定只有 ABI，没有字节码，所以部署 RccToken，
+// 再像 05 读已有代币那样按地址加载，转账、授权、代扣并检查事件
+func TestTokenFlow(t *testing.T) {
+	chain, err := simchain.New(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Close()
+
+	address, rcc, _, err := chain.DeployToken(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance, err := erc20.NewToken(address, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rccABI, err := abi.JSON(strings.NewReader(token.TokenMetaData.ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &bind.CallOpts{}
+	name, err := instance.Name(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := rcc.Name(opts); name != want {
+		t.Fatalf("name = %q, want %q", name, want)
+	}
+	symbol, _ := instance.Symbol(opts)
+	decimals, _ := instance.Decimals(opts)
+	supply, err := instance.TotalSupply(opts)
+	if err != nil || symbol != "RCC" || decimals != 18 || supply.Sign() <= 0 {
+		t.Fatalf("symbol %q, decimals %d, supply %v, %v", symbol, decimals, supply, err)
+	}
+	simchaintest.RequireTokenBalance(t, instance, chain.Accounts[0].Address, supply)
+
+	acct := func(i int) common.Address { return chain.Accounts[i].Address }
+	balances := []*big.Int{new(big.Int).Set(supply), big.NewInt(0), big.NewInt(0)}
+	// 按顺序执行，后面的用例依赖前面的余额和授权
+	tests := []struct {
+		name      string
+		from      int
+		send      func(auth *bind.TransactOpts) (*types.Transaction, error)
+		event     string
+		fields    map[string]any
+		owner     int // 执行后检查 allowance(owner, spender)
+		spender   int
+		allowance int64
+		moved     [3]int64 // 各账户余额变化
+		revert    string
+	}{
+		{name: "transfer", from: 0,
+			send: func(auth *bind.TransactOpts) (*types.Transaction, error) {
+				return instance.Transfer(auth, acct(1), big.NewInt(100))
+			},
+			event: "Transfer", fields: map[string]any{"from": acct(0), "to": acct(1), "tokens": big.NewInt(100)},
+			owner: 1, spender: 2, moved: [3]int64{-100, 100, 0}},
+		{name: "transfer more than balance", from: 1,
+			send: func(auth *bind.TransactOpts) (*types.Transaction, error) {
+				return instance.Transfer(auth, acct(2), big.NewInt(101))
+			},
+			revert: "ERC20InsufficientBalance"},
+		{name: "approve", from: 1,
+			send: func(auth *bind.TransactOpts) (*types.Transaction, error) {
+				return instance.Approve(auth, acct(2), big.NewInt(60))
+			},
+			event: "Approval", fields: map[string]any{"tokenOwner": acct(1), "spender": acct(2), "tokens": big.NewInt(60)},
+			owner: 1, spender: 2, allowance: 60},
+		{name: "transferFrom", from: 2,
+			send: func(auth *bind.TransactOpts) (*types.Transaction, error) {
+				return instance.TransferFrom(auth, acct(1), acct(0), big.NewInt(50))
+			},
+			event: "Transfer", fields: map[string]any{"from": acct(1), "to": acct(0), "tokens": big.NewInt(50)},
+			owner: 1, spender: 2, allowance: 10, moved: [3]int64{50, -50, 0}},
+		{name: "transferFrom more than allowance", from: 2,
+			send: func(auth *bind.TransactOpts) (*types.Transaction, error) {
+				return instance.TransferFrom(auth, acct(1), acct(2), big.NewInt(11))
+			},
+			revert: "ERC20InsufficientAllowance"},
+		{name: "transferFrom without allowance", from: 0,
+			send: func(auth *bind.TransactOpts) (*types.Transaction, error) {
+				return instance.TransferFrom(auth, acct(1), acct(0), big.NewInt(1))
+			},
+			revert: "ERC20InsufficientAllowance"},
+		{name: "approve overwrites", from: 1,
+			send: func(auth *bind.TransactOpts) (*types.Transaction, error) {
+				return instance.Approve(auth, acct(2), big.NewInt(0))
+			},
+			event: "Approval", fields: map[string]any{"tokenOwner": acct(1), "spender": acct(2), "tokens": big.NewInt(0)},
+			owner: 1, spender: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := tt.send(chain.Transactor(tt.from))
+			if tt.revert != "" {
+				// 估算 gas 时就 revert；04 的 ABI 里没有自定义错误，用 RccToken 的 ABI 解码
+				var r *abicall.RevertError
+				if !errors.As(abicall.WrapRevert(err, rccABI), &r) || r.Name != tt.revert {
+					t.Fatalf("err = %v, want %s", err, tt.revert)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				receipt, err := chain.Mine(tx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				// 用 04 的 ABI 解码 RccToken 发出的事件，字段名按 04 的 tokens、tokenOwner
+				simchaintest.RequireEvent(t, receipt, address, erc20.TokenMetaData.ABI, tt.event, tt.fields)
+			}
+			for i, d := range tt.moved {
+				balances[i].Add(balances[i], big.NewInt(d))
+			}
+			for i, want := range balances {
+				simchaintest.RequireTokenBalance(t, instance, acct(i), want)
+			}
+			got, err := instance.Allowance(opts, acct(tt.owner), acct(tt.spender))
+			if err != nil || got.Cmp(big.NewInt(tt.allowance)) != 0 {
+				t.Fatalf("allowance = %v, want %d: %v", got, tt.allowance, err)
+			}
+		})
+	}
+
+	// 04 的过滤器按 indexed 的 from 筛选 Transfer 事件
+	it, err := instance.FilterTransfer(&bind.FilterOpts{Start: 0}, []common.Address{acct(1)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+	var moved []*big.Int
+	for it.Next() {
+		moved = append(moved, it.Event.Tokens)
+	}
+	if it.Error() != nil || len(moved) != 1 || moved[0].Int64() != 50 || it.Event.To != acct(0) {
+		t.Fatalf("transfers from account 1 = %v, %v", moved, it.Error())
+	}
+}
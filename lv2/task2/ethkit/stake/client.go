@@ -0,0 +1,190 @@
+package stake
+
+import (
+	"context"
+	"math/big"
+
+	"ethkit/abicall"
+	"ethkit/contracts/rccstake"
+	"ethkit/contracts/token"
+	"ethkit/signer"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ETHPool 是合约里固定的 ETH 质押池 ID
+const ETHPool = 0
+
+// Backend 在 abicall.Backend 之外还要读最新区块号
+type Backend interface {
+	abicall.Backend
+	ethereum.BlockNumberReader
+}
+
+// Client 是 RCCStake 合约的类型化客户端
+type Client struct {
+	address  common.Address
+	backend  Backend
+	contract *rccstake.RCCStake
+}
+
+func New(address common.Address, backend Backend) (*Client, error) {
+	contract, err := rccstake.NewRCCStake(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{address: address, backend: backend, contract: contract}, nil
+}
+
+func (c *Client) Address() common.Address {
+	return c.address
+}
+
+// Contract 返回底层的 abigen 绑定，用于客户端没有封装的管理接口
+func (c *Client) Contract() *rccstake.RCCStake {
+	return c.contract
+}
+
+type Pool struct {
+	ID                  uint64
+	StToken             common.Address // 零地址表示 ETH
+	Weight              *big.Int
+	LastRewardBlock     uint64
+	AccRCCPerST         *big.Int
+	Staked              *big.Int
+	MinDeposit          *big.Int
+	UnstakeLockedBlocks uint64
+}
+
+func (p Pool) IsETH() bool {
+	return p.StToken == (common.Address{})
+}
+
+func (c *Client) Pools(ctx context.Context) ([]Pool, error) {
+	n, err := c.contract.PoolLength(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+	pools := make([]Pool, 0, n.Uint64())
+	for pid := uint64(0); pid < n.Uint64(); pid++ {
+		p, err := c.Pool(ctx, pid)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, p)
+	}
+	return pools, nil
+}
+
+func (c *Client) Pool(ctx context.Context, pid uint64) (Pool, error) {
+	p, err := c.contract.Pool(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(pid))
+	if err != nil {
+		return Pool{}, err
+	}
+	return Pool{
+		ID:                  pid,
+		StToken:             p.StTokenAddress,
+		Weight:              p.PoolWeight,
+		LastRewardBlock:     p.LastRewardBlock.Uint64(),
+		AccRCCPerST:         p.AccRCCPerST,
+		Staked:              p.StTokenAmount,
+		MinDeposit:          p.MinDepositAmount,
+		UnstakeLockedBlocks: p.UnstakeLockedBlocks.Uint64(),
+	}, nil
+}
+
+// Position 是用户在一个池里的状态
+type Position struct {
+	Pool         uint64
+	User         common.Address
+	Staked       *big.Int
+	PendingRCC   *big.Int
+	Requested    *big.Int // 已申请解押、还没提取的总量
+	Withdrawable *big.Int // 其中已经解锁、可以 withdraw 的部分
+	Unlocks      []Unlock
+}
+
+// Position 查询质押余额、待领取奖励和解押计划
+func (c *Client) Position(ctx context.Context, pid uint64, user common.Address) (Position, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	id := new(big.Int).SetUint64(pid)
+	staked, err := c.contract.StakingBalance(opts, id, user)
+	if err != nil {
+		return Position{}, err
+	}
+	pending, err := c.contract.PendingRCC(opts, id, user)
+	if err != nil {
+		return Position{}, err
+	}
+	amounts, err := c.contract.WithdrawAmount(opts, id, user)
+	if err != nil {
+		return Position{}, err
+	}
+	unlocks, err := c.UnlockSchedule(ctx, pid, user)
+	if err != nil {
+		return Position{}, err
+	}
+	return Position{
+		Pool:         pid,
+		User:         user,
+		Staked:       staked,
+		PendingRCC:   pending,
+		Requested:    amounts.RequestAmount,
+		Withdrawable: amounts.PendingWithdrawAmount,
+		Unlocks:      unlocks,
+	}, nil
+}
+
+// EnsureAllowance 在 ERC-20 池授权不足时发出 approve，返回 nil 表示不需要授权。
+// approve 上链后才能 Deposit，否则 gas 估算会因为 transferFrom 失败而报错
+func (c *Client) EnsureAllowance(ctx context.Context, auth *bind.TransactOpts, pid uint64, amount *big.Int) (*types.Transaction, error) {
+	pool, err := c.Pool(ctx, pid)
+	if err != nil || pool.IsETH() {
+		return nil, err
+	}
+	erc20, err := token.NewToken(pool.StToken, c.backend)
+	if err != nil {
+		return nil, err
+	}
+	allowance, err := erc20.Allowance(&bind.CallOpts{Context: ctx}, auth.From, c.address)
+	if err != nil {
+		return nil, err
+	}
+	if allowance.Cmp(amount) >= 0 {
+		return nil, nil
+	}
+	return erc20.Approve(signer.WithContext(ctx, auth), c.address, amount)
+}
+
+// Deposit 质押 amount，ETH 池走 depositETH 并把 amount 作为 value 发送
+func (c *Client) Deposit(ctx context.Context, auth *bind.TransactOpts, pid uint64, amount *big.Int) (*types.Transaction, error) {
+	opts := signer.WithContext(ctx, auth)
+	if pid == ETHPool {
+		opts.Value = amount
+		return c.contract.DepositETH(opts)
+	}
+	return c.contract.Deposit(opts, new(big.Int).SetUint64(pid), amount)
+}
+
+// Unstake 申请解押，资金要等 unstakeLockedBlocks 个区块后才能 Withdraw
+func (c *Client) Unstake(ctx context.Context, auth *bind.TransactOpts, pid uint64, amount *big.Int) (*types.Transaction, error) {
+	return c.contract.Unstake(signer.WithContext(ctx, auth), new(big.Int).SetUint64(pid), amount)
+}
+
+// Withdraw 提取所有已经解锁的解押请求
+func (c *Client) Withdraw(ctx context.Context, auth *bind.TransactOpts, pid uint64) (*types.Transaction, error) {
+	return c.contract.Withdraw(signer.WithContext(ctx, auth), new(big.Int).SetUint64(pid))
+}
+
+// Claim 领取 RCC 奖励
+func (c *Client) Claim(ctx context.Context, auth *bind.TransactOpts, pid uint64) (*types.Transaction, error) {
+	return c.contract.Claim(signer.WithContext(ctx, auth), new(big.Int).SetUint64(pid))
+}
+
+// Wait 等待交易上链
+func (c *Client) Wait(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	return bind.WaitMined(ctx, c.backend, tx)
+}
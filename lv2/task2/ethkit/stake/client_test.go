@@ -0,0 +1,317 @@
+package stake
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"ethkit/abicall"
+	"ethkit/contracts/rccstake"
+	"ethkit/contracts/token"
+	"ethkit/simchain"
+	"ethkit/simchain/simchaintest"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	ether       = big.NewInt(params.Ether)
+	rccPerBlock = big.NewInt(params.Ether)
+)
+
+const (
+	ethWeight   = 100
+	tokenWeight = 50
+	ethLock     = 3
+	tokenLock   = 2
+)
+
+type fixture struct {
+	t       *testing.T
+	chain   *simchain.Chain
+	client  *Client
+	rcc     *token.Token
+	stToken common.Address
+	abi     abi.ABI
+}
+
+// setup 在 ERC1967 代理后面部署 RCCStake 并初始化，和真实的 UUPS 部署方式一样；
+// 账户 0 是管理员，池 0 质押 ETH，池 1 质押另一个 ERC-20，账户 1 有 100 个质押代币
+func setup(t *testing.T) *fixture {
+	t.Helper()
+	chain, err := simchain.New(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	mine := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := chain.Mine(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rccAddr, rcc, _, err := chain.DeployToken(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stAddr, st, _, err := chain.DeployToken(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	impl, tx, _, err := rccstake.DeployRCCStake(chain.Transactor(0), chain.Client)
+	mine(tx, err)
+	parsed, err := rccstake.RCCStakeMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := chain.Client.BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	init, err := parsed.Pack("initialize", rccAddr, new(big.Int).SetUint64(head), new(big.Int).SetUint64(head+1000), rccPerBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxyABI, err := os.ReadFile("../contracts/rccstake/ERC1967Proxy.abi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxyBin, err := os.ReadFile("../contracts/rccstake/ERC1967Proxy.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxy, _, err := chain.Deploy(0, string(proxyABI), string(proxyBin), impl, init)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := New(proxy, chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin := client.Contract()
+	mine(admin.AddPool(chain.Transactor(0), common.Address{}, big.NewInt(ethWeight), new(big.Int).Div(ether, big.NewInt(10)), big.NewInt(ethLock), false))
+	mine(admin.AddPool(chain.Transactor(0), stAddr, big.NewInt(tokenWeight), big.NewInt(0), big.NewInt(tokenLock), false))
+	// 奖励从合约自己的 RCC 余额里发
+	mine(rcc.Transfer(chain.Transactor(0), proxy, new(big.Int).Mul(big.NewInt(1_000_000), ether)))
+	mine(st.Transfer(chain.Transactor(0), chain.Accounts[1].Address, new(big.Int).Mul(big.NewInt(100), ether)))
+	return &fixture{t: t, chain: chain, client: client, rcc: rcc, stToken: stAddr, abi: *parsed}
+}
+
+// send 发交易并出块，revert 时返回解码后的 *abicall.RevertError
+func (f *fixture) send(tx *types.Transaction, err error) (*types.Receipt, error) {
+	if err != nil {
+		return nil, abicall.WrapRevert(err, f.abi)
+	}
+	receipt, err := f.chain.Mine(tx)
+	if err != nil {
+		var revert *abicall.RevertError
+		if errors.As(err, &revert) {
+			revert.Redecode(f.abi)
+		}
+	}
+	return receipt, err
+}
+
+// must 和 send 一样，但失败时直接结束测试
+func (f *fixture) must(tx *types.Transaction, err error) *types.Receipt {
+	f.t.Helper()
+	receipt, err := f.send(tx, err)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return receipt
+}
+
+func (f *fixture) head(t *testing.T) uint64 {
+	t.Helper()
+	n, err := f.chain.Client.BlockNumber(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// reward 按合约的算法算单个质押者在 blocks 个区块里从池子分到的 RCC
+func reward(blocks uint64, weight int64, staked *big.Int) *big.Int {
+	total := new(big.Int).Mul(new(big.Int).SetUint64(blocks), rccPerBlock)
+	total.Mul(total, big.NewInt(weight)).Div(total, big.NewInt(ethWeight+tokenWeight))
+	acc := new(big.Int).Mul(total, ether)
+	acc.Div(acc, staked)
+	return acc.Mul(acc, staked).Div(acc, ether)
+}
+
+func TestLifecycle(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+	user := f.chain.Accounts[1].Address
+	auth := f.chain.Transactor(1)
+
+	pools, err := f.client.Pools(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pools) != 2 || !pools[0].IsETH() || pools[1].StToken != f.stToken || pools[1].UnstakeLockedBlocks != tokenLock {
+		t.Fatalf("pools = %+v", pools)
+	}
+
+	// ETH 池：deposit 把 amount 作为 value 发给 depositETH
+	staked := new(big.Int).Mul(big.NewInt(2), ether)
+	receipt := f.must(f.client.Deposit(ctx, auth, ETHPool, staked))
+	depositBlock := receipt.BlockNumber.Uint64()
+	simchaintest.RequireEvent(t, receipt, f.client.Address(), rccstake.RCCStakeMetaData.ABI, "Deposit", map[string]any{
+		"user": user, "poolId": big.NewInt(ETHPool), "amount": staked,
+	})
+	simchaintest.RequireBalance(t, f.chain, f.client.Address(), staked)
+
+	// ERC-20 池要先授权，授权够了以后不再发 approve
+	tokens := new(big.Int).Mul(big.NewInt(40), ether)
+	f.must(f.client.EnsureAllowance(ctx, auth, 1, tokens))
+	if tx, err := f.client.EnsureAllowance(ctx, auth, 1, tokens); tx != nil || err != nil {
+		t.Fatalf("second EnsureAllowance = %v, %v", tx, err)
+	}
+	f.must(f.client.Deposit(ctx, auth, 1, tokens))
+	st, err := token.NewToken(f.stToken, f.chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	simchaintest.RequireTokenBalance(t, st, f.client.Address(), tokens)
+	simchaintest.RequireTokenBalance(t, st, user, new(big.Int).Mul(big.NewInt(60), ether))
+
+	f.chain.Commit()
+	f.chain.Commit()
+	pos, err := f.client.Position(ctx, ETHPool, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := reward(f.head(t)-depositBlock, ethWeight, staked); pos.Staked.Cmp(staked) != 0 || pos.PendingRCC.Cmp(want) != 0 {
+		t.Fatalf("position = staked %s pending %s, want %s pending %s", pos.Staked, pos.PendingRCC, staked, want)
+	}
+
+	// 解押：余额马上减少，资金锁 ethLock 个区块
+	part := new(big.Int).Div(staked, big.NewInt(4))
+	receipt = f.must(f.client.Unstake(ctx, auth, ETHPool, part))
+	unlockAt := receipt.BlockNumber.Uint64() + ethLock
+	pos, err = f.client.Position(ctx, ETHPool, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos.Staked.Cmp(new(big.Int).Sub(staked, part)) != 0 || pos.Requested.Cmp(part) != 0 || pos.Withdrawable.Sign() != 0 {
+		t.Fatalf("after unstake: %+v", pos)
+	}
+	if len(pos.Unlocks) != 1 || pos.Unlocks[0].Block != unlockAt || pos.Unlocks[0].Unlocked || pos.Unlocks[0].Amount.Cmp(part) != 0 {
+		t.Fatalf("unlocks = %+v, want %s at %d", pos.Unlocks, part, unlockAt)
+	}
+
+	// 没解锁时 withdraw 不转账
+	receipt = f.must(f.client.Withdraw(ctx, auth, ETHPool))
+	simchaintest.RequireEvent(t, receipt, f.client.Address(), rccstake.RCCStakeMetaData.ABI, "Withdraw", map[string]any{"amount": big.NewInt(0)})
+	for f.head(t) < unlockAt {
+		f.chain.Commit()
+	}
+	pos, err = f.client.Position(ctx, ETHPool, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pos.Withdrawable.Cmp(part) != 0 || !pos.Unlocks[0].Unlocked {
+		t.Fatalf("after unlock: %+v", pos)
+	}
+	receipt = f.must(f.client.Withdraw(ctx, auth, ETHPool))
+	simchaintest.RequireEvent(t, receipt, f.client.Address(), rccstake.RCCStakeMetaData.ABI, "Withdraw", map[string]any{"amount": part})
+	simchaintest.RequireBalance(t, f.chain, f.client.Address(), new(big.Int).Sub(staked, part))
+	if pos, err = f.client.Position(ctx, ETHPool, user); err != nil || len(pos.Unlocks) != 0 || pos.Requested.Sign() != 0 {
+		t.Fatalf("after withdraw: %+v, %v", pos, err)
+	}
+
+	// claim 领走 pendingRCC 的全部
+	pending, err := f.client.Contract().PendingRCC(&bind.CallOpts{Pending: true}, big.NewInt(ETHPool), user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt = f.must(f.client.Claim(ctx, auth, ETHPool))
+	var claimed *big.Int
+	events, err := simchain.Events(receipt, f.client.Address(), rccstake.RCCStakeMetaData.ABI, "Claim")
+	if err != nil || len(events) != 1 {
+		t.Fatalf("claim events = %v, %v", events, err)
+	}
+	claimed = events[0]["rccReward"].(*big.Int)
+	// claim 所在区块的奖励也算进去，所以不少于出块前看到的 pending
+	if claimed.Cmp(pending) < 0 {
+		t.Fatalf("claimed %s < pending %s", claimed, pending)
+	}
+	simchaintest.RequireTokenBalance(t, f.rcc, user, claimed)
+	if pos, err = f.client.Position(ctx, ETHPool, user); err != nil || pos.PendingRCC.Sign() != 0 {
+		t.Fatalf("pending after claim = %v, %v", pos.PendingRCC, err)
+	}
+}
+
+func TestReverts(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+	admin, auth := f.client.Contract(), f.chain.Transactor(1)
+	f.must(f.client.Deposit(ctx, auth, ETHPool, ether))
+
+	tests := []struct {
+		name string
+		send func() (*types.Transaction, error)
+		want string
+	}{
+		{"deposit below minimum", func() (*types.Transaction, error) {
+			return f.client.Deposit(ctx, auth, ETHPool, big.NewInt(1))
+		}, "deposit amount is too small"},
+		{"unstake more than staked", func() (*types.Transaction, error) {
+			return f.client.Unstake(ctx, auth, ETHPool, new(big.Int).Mul(big.NewInt(2), ether))
+		}, "Not enough staking token balance"},
+		{"invalid pool", func() (*types.Transaction, error) {
+			return f.client.Claim(ctx, auth, 7)
+		}, "Invalid pid"},
+		{"non-admin add pool", func() (*types.Transaction, error) {
+			return admin.AddPool(auth, f.stToken, big.NewInt(1), big.NewInt(0), big.NewInt(1), false)
+		}, "AccessControlUnauthorizedAccount"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := f.send(tt.send())
+			var revert *abicall.RevertError
+			if !errors.As(err, &revert) {
+				t.Fatalf("err = %v, want revert", err)
+			}
+			if got := revert.Message + revert.Name; !strings.Contains(got, tt.want) {
+				t.Fatalf("revert = %v, want %s", revert, tt.want)
+			}
+		})
+	}
+
+	// 暂停提现只挡 unstake/withdraw，暂停领取只挡 claim
+	f.must(admin.PauseWithdraw(f.chain.Transactor(0)))
+	if _, err := f.send(f.client.Unstake(ctx, auth, ETHPool, big.NewInt(1))); err == nil || !strings.Contains(err.Error(), "withdraw is paused") {
+		t.Fatalf("unstake while withdraw paused: %v", err)
+	}
+	f.must(f.client.Claim(ctx, auth, ETHPool))
+	f.must(admin.PauseClaim(f.chain.Transactor(0)))
+	if _, err := f.send(f.client.Claim(ctx, auth, ETHPool)); err == nil || !strings.Contains(err.Error(), "Claim paused") {
+		t.Fatalf("claim while paused: %v", err)
+	}
+
+	// 改权重时总权重减去旧值、加上新值
+	f.must(admin.SetPoolWeight(f.chain.Transactor(0), big.NewInt(1), big.NewInt(150), true))
+	total, err := admin.TotalPoolWeight(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.Int64() != ethWeight+150 {
+		t.Fatalf("totalPoolWeight = %s, want %d", total, ethWeight+150)
+	}
+}
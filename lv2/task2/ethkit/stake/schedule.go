@@ -0,0 +1,147 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Unlock 是一笔解押请求
+type Unlock struct {
+	Amount   *big.Int
+	Block    uint64 // 达到这个区块后可以 withdraw
+	Unlocked bool
+}
+
+// UnlockSchedule 列出用户还没提取的解押请求。合约的 user() getter 不返回 requests 数组，
+// 这里回放 RequestUnstake / Withdraw 事件重建：每笔请求的解锁区块 = 请求区块 + 当时池子的 unstakeLockedBlocks，
+// withdraw 按先进先出取走所有已解锁的请求，和合约里的逻辑一致
+func (c *Client) UnlockSchedule(ctx context.Context, pid uint64, user common.Address) ([]Unlock, error) {
+	opts := &bind.FilterOpts{Context: ctx}
+	id := new(big.Int).SetUint64(pid)
+
+	locks, err := c.lockHistory(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	type entry struct {
+		log    types.Log
+		amount *big.Int // nil 表示 withdraw
+	}
+	var entries []entry
+	requests, err := c.contract.FilterRequestUnstake(opts, []common.Address{user}, []*big.Int{id})
+	if err != nil {
+		return nil, err
+	}
+	for requests.Next() {
+		entries = append(entries, entry{log: requests.Event.Raw, amount: requests.Event.Amount})
+	}
+	if err := requests.Error(); err != nil {
+		return nil, err
+	}
+	withdraws, err := c.contract.FilterWithdraw(opts, []common.Address{user}, []*big.Int{id}, nil)
+	if err != nil {
+		return nil, err
+	}
+	for withdraws.Next() {
+		entries = append(entries, entry{log: withdraws.Event.Raw})
+	}
+	if err := withdraws.Error(); err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return before(entries[i].log, entries[j].log)
+	})
+
+	var queue []Unlock
+	for _, e := range entries {
+		if e.amount == nil {
+			n := 0
+			for n < len(queue) && queue[n].Block <= e.log.BlockNumber {
+				n++
+			}
+			queue = queue[n:]
+			continue
+		}
+		if e.amount.Sign() > 0 {
+			queue = append(queue, Unlock{Amount: e.amount, Block: e.log.BlockNumber + locks.at(e.log)})
+		}
+	}
+
+	head, err := c.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range queue {
+		queue[i].Unlocked = queue[i].Block <= head
+	}
+	return queue, nil
+}
+
+type lockChange struct {
+	log    types.Log
+	blocks uint64
+}
+
+type lockHistory []lockChange
+
+// at 返回 log 发生时生效的 unstakeLockedBlocks
+func (h lockHistory) at(l types.Log) uint64 {
+	var blocks uint64
+	for _, c := range h {
+		if before(l, c.log) {
+			break
+		}
+		blocks = c.blocks
+	}
+	return blocks
+}
+
+// lockHistory 收集池子 unstakeLockedBlocks 的变化：AddPool 事件没有池 ID，第 pid 个 AddPool 就是 pid 号池
+func (c *Client) lockHistory(ctx context.Context, pid uint64) (lockHistory, error) {
+	opts := &bind.FilterOpts{Context: ctx}
+	added, err := c.contract.FilterAddPool(opts, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var history lockHistory
+	for n := uint64(0); added.Next(); n++ {
+		if n == pid {
+			history = append(history, lockChange{log: added.Event.Raw, blocks: added.Event.UnstakeLockedBlocks.Uint64()})
+		}
+	}
+	if err := added.Error(); err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		return nil, fmt.Errorf("stake: no AddPool event for pool %d", pid)
+	}
+
+	updates, err := c.contract.FilterUpdatePoolInfo(opts, []*big.Int{new(big.Int).SetUint64(pid)}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for updates.Next() {
+		history = append(history, lockChange{log: updates.Event.Raw, blocks: updates.Event.UnstakeLockedBlocks.Uint64()})
+	}
+	if err := updates.Error(); err != nil {
+		return nil, err
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return before(history[i].log, history[j].log)
+	})
+	return history, nil
+}
+
+func before(a, b types.Log) bool {
+	if a.BlockNumber != b.BlockNumber {
+		return a.BlockNumber < b.BlockNumber
+	}
+	return a.Index < b.Index
+}
@@ -0,0 +1,371 @@
+package todo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"ethkit/abicall"
+	"ethkit/contracts/todolist"
+	"ethkit/signer"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var ErrNotFound = errors.New("todo: no such todo")
+
+// Todo 是合约 list 数组里的一项，ID 就是数组下标
+type Todo struct {
+	ID        uint64 `json:"id"`
+	Name      string `json:"name"`
+	Completed bool   `json:"completed"`
+}
+
+// Backend 在 abicall.Backend 之外还要读区块，用来确定同一区块里新建任务的 id
+type Backend interface {
+	abicall.Backend
+	ethereum.ChainReader
+	ethereum.BlockNumberReader
+}
+
+// Batcher 发送 JSON-RPC 批量请求，ethclient.Client.Client() 返回的 *rpc.Client 满足
+type Batcher interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// snapshot 是某个高度上完整的列表，也是本地缓存文件的内容
+type snapshot struct {
+	Block uint64 `json:"block"`
+	Todos []Todo `json:"todos"`
+}
+
+// Client 读写 lv1/task5/TodoList.sol。合约没有事件也没有长度方法，
+// 列表靠探测长度后按下标批量 eth_call 重建，缓存在内存和可选的 JSON 文件里
+type Client struct {
+	BatchSize int // 每个批量请求里的 eth_call 数量
+
+	address  common.Address
+	backend  Backend
+	rpc      Batcher
+	abi      *abi.ABI
+	contract *todolist.TodoList
+	path     string
+
+	send  sync.Mutex // 同一个签名账户并发写时串行发送，避免 nonce 冲突
+	mu    sync.RWMutex
+	cache snapshot
+}
+
+// New 创建客户端，path 非空时从文件恢复缓存并在每次同步后写回
+func New(address common.Address, backend Backend, batcher Batcher, path string) (*Client, error) {
+	parsed, err := todolist.TodoListMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	contract, err := todolist.NewTodoList(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		BatchSize: 100,
+		address:   address,
+		backend:   backend,
+		rpc:       batcher,
+		abi:       parsed,
+		contract:  contract,
+		path:      path,
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(data, &c.cache); err != nil {
+				return nil, fmt.Errorf("todo cache %s: %w", path, err)
+			}
+		}
+	}
+	return c, nil
+}
+
+func (c *Client) Address() common.Address {
+	return c.address
+}
+
+// List 返回缓存的列表和它对应的区块
+func (c *Client) List() ([]Todo, uint64) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]Todo(nil), c.cache.Todos...), c.cache.Block
+}
+
+// Get 从缓存读取一项
+func (c *Client) Get(id uint64) (Todo, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if id >= uint64(len(c.cache.Todos)) {
+		return Todo{}, ErrNotFound
+	}
+	return c.cache.Todos[id], nil
+}
+
+// Run 每隔 interval 同步一次，直到 ctx 取消
+func (c *Client) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := c.Sync(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("todo: sync: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync 按最新区块重建缓存，返回同步到的区块
+func (c *Client) Sync(ctx context.Context) (uint64, error) {
+	head, err := c.backend.BlockNumber(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return head, c.syncAt(ctx, head)
+}
+
+// syncAt 读取 block 高度上的完整列表。所有调用固定在同一个区块，避免读到一半有新交易上链
+func (c *Client) syncAt(ctx context.Context, block uint64) error {
+	n, err := c.Len(ctx, block)
+	if err != nil {
+		return err
+	}
+	todos := make([]Todo, 0, n)
+	for start := uint64(0); start < n; start += uint64(c.BatchSize) {
+		end := min(start+uint64(c.BatchSize), n)
+		batch, err := c.read(ctx, block, start, end)
+		if err != nil {
+			return err
+		}
+		todos = append(todos, batch...)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if block < c.cache.Block {
+		return nil // 并发的同步已经写入了更新的结果
+	}
+	c.cache = snapshot{Block: block, Todos: todos}
+	if c.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(c.cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.path)
+}
+
+// read 用一个批量请求读取 [start, end) 的各项
+func (c *Client) read(ctx context.Context, block, start, end uint64) ([]Todo, error) {
+	elems := make([]rpc.BatchElem, end-start)
+	results := make([]hexutil.Bytes, end-start)
+	for i := range elems {
+		data, err := c.abi.Pack("get2", new(big.Int).SetUint64(start+uint64(i)))
+		if err != nil {
+			return nil, err
+		}
+		elems[i] = c.call(data, block, &results[i])
+	}
+	if err := c.rpc.BatchCallContext(ctx, elems); err != nil {
+		return nil, err
+	}
+	todos := make([]Todo, len(elems))
+	for i, elem := range elems {
+		if elem.Error != nil {
+			return nil, fmt.Errorf("todo: get2(%d): %w", start+uint64(i), elem.Error)
+		}
+		out, err := c.abi.Unpack("get2", results[i])
+		if err != nil {
+			return nil, fmt.Errorf("todo: get2(%d): %w", start+uint64(i), err)
+		}
+		todos[i] = Todo{ID: start + uint64(i), Name: out[0].(string), Completed: out[1].(bool)}
+	}
+	return todos, nil
+}
+
+func (c *Client) call(data []byte, block uint64, result *hexutil.Bytes) rpc.BatchElem {
+	return rpc.BatchElem{
+		Method: "eth_call",
+		Args: []any{
+			map[string]any{"to": c.address, "data": hexutil.Bytes(data)},
+			hexutil.EncodeUint64(block),
+		},
+		Result: result,
+	}
+}
+
+// Len 返回 block 高度上的列表长度。合约没有长度方法，先按 1、2、4… 探测到第一个越界的下标，再二分
+func (c *Client) Len(ctx context.Context, block uint64) (uint64, error) {
+	exists := func(i uint64) (bool, error) {
+		_, err := c.contract.Get2(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}, new(big.Int).SetUint64(i))
+		if err == nil {
+			return true, nil
+		}
+		if abicall.Reverted(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	ok, err := exists(0)
+	if err != nil || !ok {
+		return 0, err
+	}
+	// 不变式：lo 存在，hi 不存在
+	lo, hi := uint64(0), uint64(1)
+	for {
+		ok, err := exists(hi)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			break
+		}
+		lo, hi = hi, hi*2
+	}
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		ok, err := exists(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi, nil
+}
+
+// Create 新建一项，等交易上链后同步缓存并返回新建的那一项
+func (c *Client) Create(ctx context.Context, auth *bind.TransactOpts, name string) (Todo, error) {
+	c.send.Lock()
+	tx, err := c.contract.Create(signer.WithContext(ctx, auth), name)
+	c.send.Unlock()
+	if err != nil {
+		return Todo{}, err
+	}
+	receipt, err := c.Reconcile(ctx, tx)
+	if err != nil {
+		return Todo{}, err
+	}
+	id, err := c.createdID(ctx, receipt)
+	if err != nil {
+		return Todo{}, err
+	}
+	return c.Get(id)
+}
+
+// Rename 修改名称
+func (c *Client) Rename(ctx context.Context, auth *bind.TransactOpts, id uint64, name string) (Todo, error) {
+	return c.write(ctx, auth, id, func(opts *bind.TransactOpts, index *big.Int) (*types.Transaction, error) {
+		return c.contract.ModiName1(opts, index, name)
+	})
+}
+
+// Toggle 切换完成状态
+func (c *Client) Toggle(ctx context.Context, auth *bind.TransactOpts, id uint64) (Todo, error) {
+	return c.write(ctx, auth, id, c.contract.ModiStatus2)
+}
+
+// SetCompleted 把完成状态设为指定值
+func (c *Client) SetCompleted(ctx context.Context, auth *bind.TransactOpts, id uint64, completed bool) (Todo, error) {
+	return c.write(ctx, auth, id, func(opts *bind.TransactOpts, index *big.Int) (*types.Transaction, error) {
+		return c.contract.ModiStatus1(opts, index, completed)
+	})
+}
+
+// write 先确认下标存在（越界在合约里是 panic，估算 gas 时只会得到一个不好懂的错误），发送交易并在上链后同步
+func (c *Client) write(ctx context.Context, auth *bind.TransactOpts, id uint64, send func(*bind.TransactOpts, *big.Int) (*types.Transaction, error)) (Todo, error) {
+	index := new(big.Int).SetUint64(id)
+	if _, err := c.contract.Get2(&bind.CallOpts{Context: ctx}, index); err != nil {
+		if abicall.Reverted(err) {
+			return Todo{}, ErrNotFound
+		}
+		return Todo{}, err
+	}
+	c.send.Lock()
+	tx, err := send(signer.WithContext(ctx, auth), index)
+	c.send.Unlock()
+	if err != nil {
+		return Todo{}, err
+	}
+	if _, err := c.Reconcile(ctx, tx); err != nil {
+		return Todo{}, err
+	}
+	return c.Get(id)
+}
+
+// Reconcile 等交易上链，revert 时返回错误；成功后按不低于收据的区块同步缓存
+func (c *Client) Reconcile(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, c.backend, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("todo: tx %s reverted in block %s", tx.Hash().Hex(), receipt.BlockNumber)
+	}
+	head, err := c.backend.BlockNumber(ctx)
+	if err != nil {
+		return receipt, err
+	}
+	// 负载均衡后面的节点可能还没到收据所在的区块
+	return receipt, c.syncAt(ctx, max(head, receipt.BlockNumber.Uint64()))
+}
+
+// createdID 推算 create 交易新建的下标：上一个区块末尾的长度，加上同一区块里排在它前面的成功的 create 数量。
+// 通过其他合约间接调用的 create 看不到，那种情况下结果可能偏小
+func (c *Client) createdID(ctx context.Context, receipt *types.Receipt) (uint64, error) {
+	number := receipt.BlockNumber.Uint64()
+	id, err := c.Len(ctx, number-1)
+	if err != nil {
+		return 0, err
+	}
+	block, err := c.backend.BlockByNumber(ctx, receipt.BlockNumber)
+	if err != nil {
+		return 0, err
+	}
+	selector := c.abi.Methods["create"].ID
+	for _, tx := range block.Transactions()[:receipt.TransactionIndex] {
+		if tx.To() == nil || *tx.To() != c.address || !bytes.HasPrefix(tx.Data(), selector) {
+			continue
+		}
+		r, err := c.backend.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			return 0, err
+		}
+		if r.Status == types.ReceiptStatusSuccessful {
+			id++
+		}
+	}
+	return id, nil
+}
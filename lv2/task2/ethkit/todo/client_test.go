@@ -0,0 +1,234 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"ethkit/contracts/todolist"
+	"ethkit/simchain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+type fixture struct {
+	t        *testing.T
+	chain    *simchain.Chain
+	address  common.Address
+	contract *todolist.TodoList
+	batcher  Batcher
+}
+
+func setup(t *testing.T) *fixture {
+	t.Helper()
+	chain, err := simchain.New(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	address, tx, contract, err := todolist.DeployTodoList(chain.Transactor(0), chain.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chain.Mine(tx); err != nil {
+		t.Fatal(err)
+	}
+	return &fixture{t: t, chain: chain, address: address, contract: contract, batcher: callBatcher{chain.Client}}
+}
+
+// callBatcher 把批量请求里的 eth_call 逐个发给模拟链，每一项的错误写进 BatchElem.Error，和 *rpc.Client 一样。
+// simulated.Client 不暴露底层的 *rpc.Client
+type callBatcher struct {
+	client ethereum.ContractCaller
+}
+
+func (b callBatcher) BatchCallContext(ctx context.Context, elems []rpc.BatchElem) error {
+	for i := range elems {
+		e := &elems[i]
+		if e.Method != "eth_call" {
+			return fmt.Errorf("unexpected method %s", e.Method)
+		}
+		msg := e.Args[0].(map[string]any)
+		to := msg["to"].(common.Address)
+		block, err := hexutil.DecodeBig(e.Args[1].(string))
+		if err != nil {
+			return err
+		}
+		out, err := b.client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: msg["data"].(hexutil.Bytes)}, block)
+		if err != nil {
+			e.Error = err
+			continue
+		}
+		*e.Result.(*hexutil.Bytes) = out
+	}
+	return nil
+}
+
+func (f *fixture) client(path string) *Client {
+	f.t.Helper()
+	c, err := New(f.address, f.chain.Client, f.batcher, path)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return c
+}
+
+// committing 在后台持续出块直到 fn 返回，Create / Rename 这些会等交易上链
+func committing[T any](chain *simchain.Chain, fn func() (T, error)) (T, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ctx.Err() == nil {
+			chain.Commit()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	v, err := fn()
+	cancel()
+	<-done
+	return v, err
+}
+
+func names(todos []Todo) string {
+	var out []string
+	for _, td := range todos {
+		out = append(out, fmt.Sprintf("%d:%s:%v", td.ID, td.Name, td.Completed))
+	}
+	return fmt.Sprint(out)
+}
+
+func TestCreateRenameToggle(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "todo.json")
+	c := f.client(path)
+	c.BatchSize = 2 // 5 项分 3 个批量请求
+	auth := f.chain.Transactor(1)
+
+	if _, err := c.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if todos, _ := c.List(); len(todos) != 0 {
+		t.Fatalf("new contract has %d todos", len(todos))
+	}
+	for i := 0; i < 5; i++ {
+		td, err := committing(f.chain, func() (Todo, error) { return c.Create(ctx, auth, fmt.Sprintf("task %d", i)) })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if td.ID != uint64(i) || td.Name != fmt.Sprintf("task %d", i) || td.Completed {
+			t.Fatalf("created = %+v", td)
+		}
+	}
+
+	td, err := committing(f.chain, func() (Todo, error) { return c.Rename(ctx, auth, 3, "renamed") })
+	if err != nil || td.Name != "renamed" {
+		t.Fatalf("rename = %+v, %v", td, err)
+	}
+	if td, err = committing(f.chain, func() (Todo, error) { return c.Toggle(ctx, auth, 1) }); err != nil || !td.Completed {
+		t.Fatalf("toggle = %+v, %v", td, err)
+	}
+	if td, err = committing(f.chain, func() (Todo, error) { return c.Toggle(ctx, auth, 1) }); err != nil || td.Completed {
+		t.Fatalf("toggle back = %+v, %v", td, err)
+	}
+	if td, err = committing(f.chain, func() (Todo, error) { return c.SetCompleted(ctx, auth, 4, true) }); err != nil || !td.Completed {
+		t.Fatalf("set completed = %+v, %v", td, err)
+	}
+	// 越界的下标在发交易之前就报 ErrNotFound
+	if _, err := c.Rename(ctx, auth, 5, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("rename missing: %v", err)
+	}
+	if _, err := c.Get(5); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("get missing: %v", err)
+	}
+
+	want := "[0:task 0:false 1:task 1:false 2:task 2:false 3:renamed:false 4:task 4:true]"
+	todos, block := c.List()
+	if names(todos) != want {
+		t.Fatalf("list = %s", names(todos))
+	}
+	// 重启后从缓存文件恢复，再次同步结果一致
+	c = f.client(path)
+	if cached, cachedBlock := c.List(); names(cached) != want || cachedBlock != block {
+		t.Fatalf("cached list at %d = %s", cachedBlock, names(cached))
+	}
+	head, err := c.Sync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if todos, block = c.List(); names(todos) != want || block != head {
+		t.Fatalf("synced list at %d = %s", block, names(todos))
+	}
+}
+
+// 同一区块里的多笔 create：id 按交易顺序排，失败的 create 和其他方法的交易不占下标
+func TestCreatedIDInSameBlock(t *testing.T) {
+	f := setup(t)
+	ctx := context.Background()
+	c := f.client("")
+	if _, err := committing(f.chain, func() (Todo, error) { return c.Create(ctx, f.chain.Transactor(0), "existing") }); err != nil {
+		t.Fatal(err)
+	}
+
+	send := func(tx *types.Transaction, err error) *types.Transaction {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx
+	}
+	first := send(f.contract.Create(f.chain.Transactor(1), "first"))
+	// gas 只够付 calldata，执行时耗尽，交易上链但失败
+	oog := f.chain.Transactor(2)
+	oog.GasLimit = 30000
+	failed := send(f.contract.Create(oog, "failed"))
+	rename := send(f.contract.ModiName1(f.chain.Transactor(0), common.Big0, "renamed"))
+	second := send(f.contract.Create(f.chain.Transactor(0), "second"))
+	f.chain.Commit()
+
+	receipts := map[string]*types.Receipt{}
+	var block uint64
+	for name, tx := range map[string]*types.Transaction{"first": first, "failed": failed, "rename": rename, "second": second} {
+		r, err := f.chain.Client.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if block != 0 && r.BlockNumber.Uint64() != block {
+			t.Fatalf("%s mined in block %d, others in %d", name, r.BlockNumber, block)
+		}
+		block = r.BlockNumber.Uint64()
+		receipts[name] = r
+	}
+	if receipts["failed"].Status != types.ReceiptStatusFailed {
+		t.Fatal("out-of-gas create succeeded")
+	}
+	// 不同账户的交易在块内的顺序由节点决定，按交易下标算期望的 id
+	order := []string{"first", "second"}
+	if receipts["second"].TransactionIndex < receipts["first"].TransactionIndex {
+		order = []string{"second", "first"}
+	}
+	for i, name := range order {
+		id, err := c.createdID(ctx, receipts[name])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != uint64(i+1) {
+			t.Fatalf("%s created id %d, want %d", name, id, i+1)
+		}
+	}
+	if _, err := c.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprintf("[0:renamed:false 1:%s:false 2:%s:false]", order[0], order[1])
+	if todos, _ := c.List(); names(todos) != want {
+		t.Fatalf("list = %s, want %s", names(todos), want)
+	}
+}
@@ -0,0 +1,169 @@
+package todo
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"ethkit/preflight"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gin-gonic/gin"
+)
+
+// Register 注册 TodoList 的 HTTP 接口。读接口返回本地缓存；写接口等交易上链并同步缓存后返回最新的那一项。
+// auth 为 nil 时只注册只读接口
+//
+//	GET  /todos                  列表，?completed=true|false 过滤
+//	GET  /todos/:id
+//	POST /todos                  {"name": "..."}
+//	PUT  /todos/:id              {"name": "..."} 改名
+//	PUT  /todos/:id/status       {"completed": true}
+//	POST /todos/:id/toggle
+func Register(r gin.IRouter, c *Client, auth *bind.TransactOpts) {
+	h := &handler{client: c, auth: auth}
+	g := r.Group("/todos")
+	g.GET("", h.list)
+	g.GET("/:id", h.get)
+	if auth != nil {
+		g.POST("", h.create)
+		g.PUT("/:id", h.rename)
+		g.PUT("/:id/status", h.status)
+		g.POST("/:id/toggle", h.toggle)
+	}
+}
+
+type handler struct {
+	client *Client
+	auth   *bind.TransactOpts
+}
+
+func (h *handler) list(c *gin.Context) {
+	todos, block := h.client.List()
+	if q := c.Query("completed"); q != "" {
+		want, err := strconv.ParseBool(q)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid completed"})
+			return
+		}
+		filtered := todos[:0]
+		for _, t := range todos {
+			if t.Completed == want {
+				filtered = append(filtered, t)
+			}
+		}
+		todos = filtered
+	}
+	if todos == nil {
+		todos = []Todo{}
+	}
+	c.JSON(http.StatusOK, gin.H{"block": block, "todos": todos})
+}
+
+func (h *handler) get(c *gin.Context) {
+	id, ok := todoID(c)
+	if !ok {
+		return
+	}
+	t, err := h.client.Get(id)
+	if err != nil {
+		c.JSON(statusCode(err), gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, t)
+}
+
+type nameRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+func (h *handler) create(c *gin.Context) {
+	var req nameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	t, err := h.client.Create(c.Request.Context(), h.auth, req.Name)
+	if err != nil {
+		sendFailed(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, t)
+}
+
+func (h *handler) rename(c *gin.Context) {
+	id, ok := todoID(c)
+	if !ok {
+		return
+	}
+	var req nameRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	t, err := h.client.Rename(c.Request.Context(), h.auth, id, req.Name)
+	if err != nil {
+		sendFailed(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, t)
+}
+
+func (h *handler) status(c *gin.Context) {
+	id, ok := todoID(c)
+	if !ok {
+		return
+	}
+	var req struct {
+		Completed *bool `json:"completed" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+		return
+	}
+	t, err := h.client.SetCompleted(c.Request.Context(), h.auth, id, *req.Completed)
+	if err != nil {
+		sendFailed(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, t)
+}
+
+func (h *handler) toggle(c *gin.Context) {
+	id, ok := todoID(c)
+	if !ok {
+		return
+	}
+	t, err := h.client.Toggle(c.Request.Context(), h.auth, id)
+	if err != nil {
+		sendFailed(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, t)
+}
+
+func todoID(c *gin.Context) (uint64, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid todo id"})
+		return 0, false
+	}
+	return id, true
+}
+
+// sendFailed 回复发交易失败；dry run 的服务不发交易，回复 200 并带上检查报告
+func sendFailed(c *gin.Context, err error) {
+	var dry *preflight.DryRunError
+	if errors.As(err, &dry) {
+		c.JSON(http.StatusOK, gin.H{"msg": err.Error(), "report": dry.Report.String()})
+		return
+	}
+	c.JSON(statusCode(err), gin.H{"msg": err.Error()})
+}
+
+func statusCode(err error) int {
+	if errors.Is(err, ErrNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusBadGateway
+}
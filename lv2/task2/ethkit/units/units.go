@@ -0,0 +1,29 @@
+// Package units 在最小单位（wei、代币的最小单位）和十进制字符串之间换算
+package units
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Format 按 decimals 把最小单位换成十进制字符串，不经过浮点数，去掉小数末尾的 0
+func Format(v *big.Int, decimals uint8) string {
+	if v == nil {
+		return ""
+	}
+	if decimals == 0 {
+		return v.String()
+	}
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(v), unit, new(big.Int))
+	s := q.String()
+	if r.Sign() != 0 {
+		frac := fmt.Sprintf("%0*s", int(decimals), r.String())
+		s += "." + strings.TrimRight(frac, "0")
+	}
+	if v.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
@@ -0,0 +1,39 @@
+package units
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	big1e30, _ := new(big.Int).SetString("1000000000000000000000000000000", 10)
+	tests := []struct {
+		v        *big.Int
+		decimals uint8
+		want     string
+	}{
+		{nil, 18, ""},
+		{big.NewInt(0), 0, "0"},
+		{big.NewInt(12345), 0, "12345"},
+		{big.NewInt(-12345), 0, "-12345"},
+		{big.NewInt(0), 6, "0"},
+		{big.NewInt(1), 6, "0.000001"},
+		{big.NewInt(1_500_000), 6, "1.5"},
+		{big.NewInt(2_000_000), 6, "2"},
+		{big.NewInt(123_456_789), 6, "123.456789"},
+		{big.NewInt(-1_250_000), 6, "-1.25"},
+		{big.NewInt(-1), 6, "-0.000001"},
+		{big.NewInt(0), 18, "0"},
+		{big.NewInt(1), 18, "0.000000000000000001"},
+		{big.NewInt(1e18), 18, "1"},
+		{big.NewInt(15e17), 18, "1.5"},
+		{big.NewInt(1e17 + 1), 18, "0.100000000000000001"},
+		{big1e30, 18, "1000000000000"},
+		{new(big.Int).Neg(big.NewInt(25e16)), 18, "-0.25"},
+	}
+	for _, tt := range tests {
+		if got := Format(tt.v, tt.decimals); got != tt.want {
+			t.Errorf("Format(%v, %d) = %q, want %q", tt.v, tt.decimals, got, tt.want)
+		}
+	}
+}
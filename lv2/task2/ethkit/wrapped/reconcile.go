@@ -0,0 +1,144 @@
+package wrapped
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Change 是一条影响余额的事件
+type Change struct {
+	Kind   string // wrap / unwrap / in / out
+	Amount *big.Int
+	Peer   common.Address // 转账的对方，存取款为零地址
+	Block  uint64
+	TxHash common.Hash
+}
+
+// Reconciliation 是区间 [From, To] 内余额变化的核对结果
+type Reconciliation struct {
+	Account   common.Address
+	From, To  uint64
+	Start     *big.Int // From-1 高度的余额
+	End       *big.Int // To 高度的余额
+	Wrapped   *big.Int
+	Unwrapped *big.Int
+	In, Out   *big.Int
+	Changes   []Change
+}
+
+// Expected 是按事件算出来的期末余额
+func (r Reconciliation) Expected() *big.Int {
+	n := new(big.Int).Add(r.Start, r.Wrapped)
+	n.Sub(n, r.Unwrapped)
+	n.Add(n, r.In)
+	return n.Sub(n, r.Out)
+}
+
+// Balanced 报告事件能否解释全部余额变化
+func (r Reconciliation) Balanced() bool {
+	return r.Expected().Cmp(r.End) == 0
+}
+
+// Reconcile 回放区间内 account 的存取款和转入转出事件，和区间两端的 balanceOf 对比。
+// 读取 From-1 高度的余额需要节点保留历史状态
+func (t *Token) Reconcile(ctx context.Context, account common.Address, from, to uint64) (Reconciliation, error) {
+	if from == 0 || from > to {
+		return Reconciliation{}, fmt.Errorf("wrapped: invalid range %d-%d", from, to)
+	}
+	r := Reconciliation{
+		Account:   account,
+		From:      from,
+		To:        to,
+		Wrapped:   new(big.Int),
+		Unwrapped: new(big.Int),
+		In:        new(big.Int),
+		Out:       new(big.Int),
+	}
+	var err error
+	if r.Start, err = t.BalanceAt(ctx, account, new(big.Int).SetUint64(from-1)); err != nil {
+		return r, err
+	}
+	if r.End, err = t.BalanceAt(ctx, account, new(big.Int).SetUint64(to)); err != nil {
+		return r, err
+	}
+
+	topic := common.BytesToHash(account.Bytes())
+	transfer := t.abi.Events["Transfer"].ID
+	queries := []struct {
+		kind   string
+		topics [][]common.Hash
+	}{
+		{"wrap", [][]common.Hash{{t.abi.Events[t.variant.DepositEvent].ID}, {topic}}},
+		{"unwrap", [][]common.Hash{{t.abi.Events[t.variant.WithdrawEvent].ID}, {topic}}},
+		{"out", [][]common.Hash{{transfer}, {topic}}},
+		{"in", [][]common.Hash{{transfer}, nil, {topic}}},
+	}
+	var logs []struct {
+		kind string
+		log  types.Log
+	}
+	for _, q := range queries {
+		found, err := t.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{t.address},
+			Topics:    q.topics,
+		})
+		if err != nil {
+			return r, err
+		}
+		for _, l := range found {
+			logs = append(logs, struct {
+				kind string
+				log  types.Log
+			}{q.kind, l})
+		}
+	}
+	sort.SliceStable(logs, func(i, j int) bool {
+		a, b := logs[i].log, logs[j].log
+		if a.BlockNumber != b.BlockNumber {
+			return a.BlockNumber < b.BlockNumber
+		}
+		return a.Index < b.Index
+	})
+
+	// 转给自己余额不变；和零地址之间的 Transfer 是部分实现在存取款时额外发出的铸造/销毁事件，
+	// 已经按 Deposit / Withdraw 计入，两种都跳过
+	for _, e := range logs {
+		if e.log.Removed || len(e.log.Data) < 32 {
+			continue
+		}
+		c := Change{
+			Kind:   e.kind,
+			Amount: new(big.Int).SetBytes(e.log.Data[:32]),
+			Block:  e.log.BlockNumber,
+			TxHash: e.log.TxHash,
+		}
+		switch e.kind {
+		case "wrap":
+			r.Wrapped.Add(r.Wrapped, c.Amount)
+		case "unwrap":
+			r.Unwrapped.Add(r.Unwrapped, c.Amount)
+		case "out":
+			c.Peer = common.BytesToAddress(e.log.Topics[2].Bytes())
+			if c.Peer == account || c.Peer == (common.Address{}) {
+				continue
+			}
+			r.Out.Add(r.Out, c.Amount)
+		case "in":
+			c.Peer = common.BytesToAddress(e.log.Topics[1].Bytes())
+			if c.Peer == account || c.Peer == (common.Address{}) {
+				continue
+			}
+			r.In.Add(r.In, c.Amount)
+		}
+		r.Changes = append(r.Changes, c)
+	}
+	return r, nil
+}
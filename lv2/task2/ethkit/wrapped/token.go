@@ -0,0 +1,161 @@
+package wrapped
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"ethkit/abicall"
+	"ethkit/contracts/weth"
+	"ethkit/signer"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Variant 描述一种 WETH 类合约：ABI 和存取款用的方法名、事件名。approve / allowance / balanceOf / Transfer 按 ERC-20 标准
+type Variant struct {
+	ABI           string
+	Deposit       string // payable，存入 msg.value
+	Withdraw      string // withdraw(uint256)
+	DepositEvent  string // (address indexed account, uint256 amount)
+	WithdrawEvent string // (address indexed account, uint256 amount)
+}
+
+var (
+	// WETH9 是主网上的标准 WETH
+	WETH9 = Variant{ABI: weth.WETH9MetaData.ABI, Deposit: "deposit", Withdraw: "withdraw", DepositEvent: "Deposit", WithdrawEvent: "Withdrawal"}
+	// Task5 是 lv1/task5/WETH.sol，方法和事件名拼写和标准不同
+	Task5 = Variant{ABI: weth.WETHMetaData.ABI, Deposit: "depoist", Withdraw: "withdraw", DepositEvent: "Deposit", WithdrawEvent: "Withdrawa"}
+)
+
+// Backend 是 Token 需要的链接口，只调用合约和等回执
+type Backend = abicall.Backend
+
+// Token 包装一个 WETH 类合约：存取 ETH、安全授权、按事件核对余额变化
+type Token struct {
+	address  common.Address
+	backend  Backend
+	variant  Variant
+	abi      abi.ABI
+	contract *bind.BoundContract
+}
+
+func New(address common.Address, backend Backend, variant Variant) (*Token, error) {
+	parsed, err := abi.JSON(strings.NewReader(variant.ABI))
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range []string{variant.Deposit, variant.Withdraw, "approve", "allowance", "balanceOf"} {
+		if _, ok := parsed.Methods[name]; !ok {
+			return nil, fmt.Errorf("wrapped: ABI has no method %s", name)
+		}
+	}
+	for _, name := range []string{variant.DepositEvent, variant.WithdrawEvent, "Transfer"} {
+		if _, ok := parsed.Events[name]; !ok {
+			return nil, fmt.Errorf("wrapped: ABI has no event %s", name)
+		}
+	}
+	return &Token{
+		address:  address,
+		backend:  backend,
+		variant:  variant,
+		abi:      parsed,
+		contract: bind.NewBoundContract(address, parsed, backend, backend, backend),
+	}, nil
+}
+
+func (t *Token) Address() common.Address {
+	return t.address
+}
+
+func (t *Token) BalanceOf(ctx context.Context, account common.Address) (*big.Int, error) {
+	return t.BalanceAt(ctx, account, nil)
+}
+
+// BalanceAt 查询 block 高度的余额，block 为 nil 表示最新
+func (t *Token) BalanceAt(ctx context.Context, account common.Address, block *big.Int) (*big.Int, error) {
+	return t.callBig(&bind.CallOpts{Context: ctx, BlockNumber: block}, "balanceOf", account)
+}
+
+func (t *Token) Allowance(ctx context.Context, owner, spender common.Address) (*big.Int, error) {
+	return t.callBig(&bind.CallOpts{Context: ctx}, "allowance", owner, spender)
+}
+
+// Wrap 把 amount wei 的 ETH 存进合约换成等量的代币
+func (t *Token) Wrap(ctx context.Context, auth *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	opts := signer.WithContext(ctx, auth)
+	opts.Value = amount
+	return t.contract.Transact(opts, t.variant.Deposit)
+}
+
+// Unwrap 销毁 amount 代币取回 ETH
+func (t *Token) Unwrap(ctx context.Context, auth *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return t.contract.Transact(signer.WithContext(ctx, auth), t.variant.Withdraw, amount)
+}
+
+// ApproveOptions 控制 EnsureAllowance 的授权方式
+type ApproveOptions struct {
+	Infinite   bool // 授权 2^256-1，之后不用再授权；否则只授权需要的数量
+	ResetFirst bool // 已有非零授权时先改成 0 再设新值，兼容 USDT 这类不允许非零直接改非零的代币，也避免 approve 抢跑
+}
+
+// EnsureAllowance 保证 spender 至少能花 amount，已经足够时不发交易。
+// ResetFirst 时 approve(0) 要先上链成功，再发新的授权；返回发出的所有交易
+func (t *Token) EnsureAllowance(ctx context.Context, auth *bind.TransactOpts, spender common.Address, amount *big.Int, opt ApproveOptions) ([]*types.Transaction, error) {
+	current, err := t.Allowance(ctx, auth.From, spender)
+	if err != nil {
+		return nil, err
+	}
+	if current.Cmp(amount) >= 0 {
+		return nil, nil
+	}
+	var sent []*types.Transaction
+	if opt.ResetFirst && current.Sign() > 0 {
+		reset, err := t.Approve(ctx, auth, spender, new(big.Int))
+		if err != nil {
+			return nil, fmt.Errorf("wrapped: reset allowance: %w", err)
+		}
+		sent = append(sent, reset)
+		if _, err := t.Wait(ctx, reset); err != nil {
+			return sent, fmt.Errorf("wrapped: reset allowance: %w", err)
+		}
+	}
+	target := amount
+	if opt.Infinite {
+		target = math.MaxBig256
+	}
+	tx, err := t.Approve(ctx, auth, spender, target)
+	if err != nil {
+		return sent, err
+	}
+	return append(sent, tx), nil
+}
+
+func (t *Token) Approve(ctx context.Context, auth *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return t.contract.Transact(signer.WithContext(ctx, auth), "approve", spender, amount)
+}
+
+// Wait 等待交易上链，revert 时返回错误
+func (t *Token) Wait(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, t.backend, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("wrapped: tx %s reverted in block %s", tx.Hash().Hex(), receipt.BlockNumber)
+	}
+	return receipt, nil
+}
+
+func (t *Token) callBig(opts *bind.CallOpts, method string, args ...any) (*big.Int, error) {
+	var out []any
+	if err := t.contract.Call(opts, &out, method, args...); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
@@ -0,0 +1,195 @@
+package wrapped
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"ethkit/abicall"
+	"ethkit/contracts/weth"
+	"ethkit/simchain"
+	"ethkit/simchain/simchaintest"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func ether(n float64) *big.Int {
+	v, _ := new(big.Float).Mul(big.NewFloat(n), big.NewFloat(params.Ether)).Int(nil)
+	return v
+}
+
+// 两个变体走同一套流程：存取款、转账、授权，最后用事件核对余额
+func TestToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		variant Variant
+		meta    *bind.MetaData
+	}{
+		{"weth9", WETH9, weth.WETH9MetaData},
+		{"task5", Task5, weth.WETHMetaData},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testToken(t, tt.variant, tt.meta)
+		})
+	}
+}
+
+func testToken(t *testing.T, variant Variant, meta *bind.MetaData) {
+	chain, err := simchain.New(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { chain.Close() })
+	ctx := context.Background()
+	addr, _, err := chain.Deploy(0, meta.ABI, meta.Bin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tok, err := New(addr, chain.Client, variant)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine := func(tx *types.Transaction, err error) *types.Receipt {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipt, err := chain.Mine(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return receipt
+	}
+	parsed, err := abi.JSON(strings.NewReader(meta.ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	erc20 := bind.NewBoundContract(addr, parsed, chain.Client, chain.Client, chain.Client)
+	user, peer := chain.Accounts[1].Address, chain.Accounts[2].Address
+	auth := chain.Transactor(1)
+
+	from := mine(tok.Wrap(ctx, auth, ether(3))).BlockNumber.Uint64()
+	requireBalance(t, tok, user, ether(3))
+	simchaintest.RequireBalance(t, chain, addr, ether(3))
+
+	before, err := chain.BalanceAt(user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt := mine(tok.Unwrap(ctx, auth, ether(1)))
+	// 两个变体的事件参数名不同，按位置取金额参数的名字
+	amountArg := parsed.Events[variant.WithdrawEvent].Inputs[1].Name
+	simchaintest.RequireEvent(t, receipt, addr, meta.ABI, variant.WithdrawEvent, map[string]any{amountArg: ether(1)})
+	fee := new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+	simchaintest.RequireBalance(t, chain, user, new(big.Int).Sub(new(big.Int).Add(before, ether(1)), fee))
+	requireBalance(t, tok, user, ether(2))
+
+	// 余额不够时估算 gas 就 revert，不会发出交易
+	if _, err := tok.Unwrap(ctx, auth, ether(5)); !abicall.Reverted(err) {
+		t.Fatalf("unwrap over balance: %v", err)
+	}
+
+	mine(erc20.Transact(auth, "transfer", peer, ether(0.5)))
+	mine(erc20.Transact(chain.Transactor(2), "transfer", user, ether(0.2)))
+	// 转给自己余额不变，核对时跳过
+	mine(erc20.Transact(auth, "transfer", user, ether(1)))
+
+	// 授权：不够才发交易；ResetFirst 先把非零授权改成 0 并等它上链
+	txs := ensure(t, chain, tok, auth, peer, ether(1), ApproveOptions{})
+	if len(txs) != 1 {
+		t.Fatalf("first approve sent %d txs", len(txs))
+	}
+	if txs = ensure(t, chain, tok, auth, peer, ether(1), ApproveOptions{}); len(txs) != 0 {
+		t.Fatalf("sufficient allowance sent %d txs", len(txs))
+	}
+	if txs = ensure(t, chain, tok, auth, peer, ether(2), ApproveOptions{ResetFirst: true}); len(txs) != 2 {
+		t.Fatalf("reset approve sent %d txs", len(txs))
+	}
+	requireAllowance(t, tok, user, peer, ether(2))
+	ensure(t, chain, tok, auth, peer, ether(3), ApproveOptions{Infinite: true})
+	requireAllowance(t, tok, user, peer, math.MaxBig256)
+	// 代扣不影响核对：对 user 来说就是一笔转出
+	to := mine(erc20.Transact(chain.Transactor(2), "transferFrom", user, peer, ether(0.1))).BlockNumber.Uint64()
+
+	r, err := tok.Reconcile(ctx, user, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Balanced() || r.Start.Sign() != 0 || r.End.Cmp(ether(1.6)) != 0 || r.Wrapped.Cmp(ether(3)) != 0 ||
+		r.Unwrapped.Cmp(ether(1)) != 0 || r.Out.Cmp(ether(0.6)) != 0 || r.In.Cmp(ether(0.2)) != 0 {
+		t.Fatalf("reconciliation = %+v", r)
+	}
+	var kinds []string
+	for _, c := range r.Changes {
+		kinds = append(kinds, c.Kind)
+	}
+	if got := fmt.Sprint(kinds); got != "[wrap unwrap out in out]" || r.Changes[2].Peer != peer {
+		t.Fatalf("changes = %s, %+v", got, r.Changes)
+	}
+
+	// 从第二个区块开始核对，期初余额是 wrap 之后的 3
+	if r, err = tok.Reconcile(ctx, user, from+1, to); err != nil || !r.Balanced() || r.Start.Cmp(ether(3)) != 0 || r.Wrapped.Sign() != 0 {
+		t.Fatalf("partial reconciliation = %+v, %v", r, err)
+	}
+	if _, err := tok.Reconcile(ctx, user, 0, to); err == nil {
+		t.Fatal("reconcile from block 0 succeeded")
+	}
+}
+
+// ensure 调用 EnsureAllowance 并在后台出块，ResetFirst 要等 approve(0) 上链才会返回
+func ensure(t *testing.T, chain *simchain.Chain, tok *Token, auth *bind.TransactOpts, spender common.Address, amount *big.Int, opt ApproveOptions) []*types.Transaction {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ctx.Err() == nil {
+			chain.Commit()
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	txs, err := tok.EnsureAllowance(ctx, auth, spender, amount, opt)
+	cancel()
+	<-done
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain.Commit()
+	for _, tx := range txs {
+		if _, err := tok.Wait(context.Background(), tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return txs
+}
+
+func requireBalance(t *testing.T, tok *Token, account common.Address, want *big.Int) {
+	t.Helper()
+	got, err := tok.BalanceOf(context.Background(), account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Cmp(want) != 0 {
+		t.Fatalf("balance of %s = %s, want %s", account.Hex(), got, want)
+	}
+}
+
+func requireAllowance(t *testing.T, tok *Token, owner, spender common.Address, want *big.Int) {
+	t.Helper()
+	got, err := tok.Allowance(context.Background(), owner, spender)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Cmp(want) != 0 {
+		t.Fatalf("allowance = %s, want %s", got, want)
+	}
+}
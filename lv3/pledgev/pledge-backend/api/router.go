@@ -0,0 +1,192 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pledge-backend/indexer"
+	"pledge-backend/pricewatch"
+	"pledge-backend/service"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const maxLimit = 500
+
+type Handler struct {
+	svc     *service.Service
+	indexer *indexer.Indexer
+	prices  *pricewatch.Watcher
+}
+
+// Register 注册 /api/v1 下的路由，prices 为 nil 时不注册价格接口
+func Register(r gin.IRouter, svc *service.Service, ix *indexer.Indexer, prices *pricewatch.Watcher) *Handler {
+	h := &Handler{svc: svc, indexer: ix, prices: prices}
+	v1 := r.Group("/api/v1")
+	v1.GET("/status", h.status)
+	v1.GET("/pools", h.pools)
+	v1.GET("/pools/:pid", h.pool)
+	v1.GET("/pools/:pid/history", h.poolHistory)
+	v1.GET("/users/:address/positions", h.positions)
+	v1.GET("/users/:address/history", h.userHistory)
+	if prices != nil {
+		v1.GET("/prices", h.latestPrices)
+		v1.GET("/prices/:asset/history", h.priceHistory)
+	}
+	return h
+}
+
+func (h *Handler) status(c *gin.Context) {
+	block, ok, err := h.indexer.Cursor()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"indexed_block": block, "started": ok})
+}
+
+func (h *Handler) pools(c *gin.Context) {
+	pools, err := h.svc.Pools()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"pools": pools})
+}
+
+func (h *Handler) pool(c *gin.Context) {
+	pid, ok := poolID(c)
+	if !ok {
+		return
+	}
+	pool, err := h.svc.Pool(pid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{"msg": "pool not found"})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, pool)
+}
+
+func (h *Handler) poolHistory(c *gin.Context) {
+	pid, ok := poolID(c)
+	if !ok {
+		return
+	}
+	limit, offset, ok := page(c)
+	if !ok {
+		return
+	}
+	events, err := h.svc.Events(int64(pid), c.Query("user"), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"events": events})
+}
+
+func (h *Handler) positions(c *gin.Context) {
+	user, ok := address(c)
+	if !ok {
+		return
+	}
+	positions, err := h.svc.Positions(c.Request.Context(), user)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"address": user.Hex(), "positions": positions})
+}
+
+func (h *Handler) userHistory(c *gin.Context) {
+	user, ok := address(c)
+	if !ok {
+		return
+	}
+	limit, offset, ok := page(c)
+	if !ok {
+		return
+	}
+	pid := int64(-1)
+	if s := c.Query("pid"); s != "" {
+		n, err := strconv.ParseUint(s, 10, 63)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid pid"})
+			return
+		}
+		pid = int64(n)
+	}
+	events, err := h.svc.Events(pid, user.Hex(), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"events": events})
+}
+
+func (h *Handler) latestPrices(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"prices": h.prices.Latest()})
+}
+
+// priceHistory 的 since 是 unix 秒，默认最近 24 小时
+func (h *Handler) priceHistory(c *gin.Context) {
+	limit, offset, ok := page(c)
+	if !ok {
+		return
+	}
+	since := time.Now().Add(-24 * time.Hour)
+	if s := c.Query("since"); s != "" {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid since"})
+			return
+		}
+		since = time.Unix(n, 0)
+	}
+	prices, err := h.prices.History(c.Param("asset"), since, limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"msg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"prices": prices})
+}
+
+func poolID(c *gin.Context) (uint64, bool) {
+	pid, err := strconv.ParseUint(c.Param("pid"), 10, 63)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid pid"})
+		return 0, false
+	}
+	return pid, true
+}
+
+func address(c *gin.Context) (common.Address, bool) {
+	s := c.Param("address")
+	if !common.IsHexAddress(s) {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid address"})
+		return common.Address{}, false
+	}
+	return common.HexToAddress(s), true
+}
+
+// page 解析 limit / offset，limit 默认 50，最大 500
+func page(c *gin.Context) (limit, offset int, ok bool) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit <= 0 || limit > maxLimit {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid limit"})
+		return 0, 0, false
+	}
+	offset, err = strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"msg": "invalid offset"})
+		return 0, 0, false
+	}
+	return limit, offset, true
+}
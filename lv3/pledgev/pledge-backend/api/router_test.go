@@ -0,0 +1,77 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"pledge-backend/model"
+	"pledge-backend/pricewatch"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+)
+
+func TestPriceHistory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db, err := model.Open(filepath.Join(t.TempDir(), "pledge.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	busd := pricewatch.Asset{Name: "BUSD", Address: common.HexToAddress("0xe9e7cea3dedca5984780bafc599bd69add087d56")}
+	now := time.Now().Unix()
+	for i := int64(0); i < 5; i++ {
+		p := model.Price{Asset: "0xe9e7cea3dedca5984780bafc599bd69add087d56", Name: "BUSD", Time: now - 60*i, Price: fmt.Sprint(100 + i)}
+		if err := db.Create(&p).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 接口只读数据库，不会调用预言机
+	w, err := pricewatch.New(pricewatch.Config{Assets: []pricewatch.Asset{busd}}, db, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := gin.New()
+	Register(r, nil, nil, w)
+
+	tests := []struct {
+		query  string
+		status int
+		want   []string
+	}{
+		{"", http.StatusOK, []string{"100", "101", "102", "103", "104"}},
+		{"?limit=2", http.StatusOK, []string{"100", "101"}},
+		{"?limit=2&offset=2", http.StatusOK, []string{"102", "103"}},
+		{"?offset=4", http.StatusOK, []string{"104"}},
+		{fmt.Sprintf("?since=%d&offset=1", now-120), http.StatusOK, []string{"101", "102"}},
+		{"?offset=-1", http.StatusBadRequest, nil},
+		{"?limit=501", http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/prices/BUSD/history"+tt.query, nil))
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var body struct{ Prices []model.Price }
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, p := range body.Prices {
+				got = append(got, p.Price)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("prices = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
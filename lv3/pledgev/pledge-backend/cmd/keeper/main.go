@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"math/big"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"pledge-backend/keeper"
+	"pledge-backend/model"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// PledgePool keeper：到期时提交 settle / finish / liquidate，签名用 keystore 里的账户
+// go run ./cmd/keeper -pool 0x... -keystore ./keys -from 0x... -password-file ./pass
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	pool := flag.String("pool", "", "PledgePool contract address")
+	dbPath := flag.String("db", "pledge.db", "SQLite database file, records submitted transactions")
+	keystoreDir := flag.String("keystore", "", "keystore directory")
+	from := flag.String("from", "", "keeper address in the keystore")
+	passwordFile := flag.String("password-file", "", "file containing the keystore passphrase")
+	interval := flag.Duration("interval", 15*time.Second, "polling interval")
+	retryAfter := flag.Duration("retry-after", 5*time.Minute, "wait this long before retrying a reverted action")
+	dryRun := flag.Bool("dry-run", false, "log decisions without sending transactions")
+	flag.Parse()
+	if !common.IsHexAddress(*pool) {
+		log.Fatal("-pool is required")
+	}
+	if !*dryRun && (*keystoreDir == "" || !common.IsHexAddress(*from)) {
+		log.Fatal("-keystore and -from are required unless -dry-run is set")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, err := model.Open(*dbPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth := &bind.TransactOpts{From: common.HexToAddress(*from)}
+	if !*dryRun {
+		chainID, err := client.ChainID(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if auth, err = transactor(*keystoreDir, common.HexToAddress(*from), *passwordFile, chainID); err != nil {
+			log.Fatal(err)
+		}
+	}
+	k, err := keeper.New(keeper.Config{
+		Pool:       common.HexToAddress(*pool),
+		Interval:   *interval,
+		RetryAfter: *retryAfter,
+		DryRun:     *dryRun,
+	}, db, client, auth)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("keeper %s watching %s", auth.From.Hex(), *pool)
+	k.Run(ctx)
+}
+
+// transactor 解锁 keystore 里的账户，口令从文件读取，不出现在命令行参数里
+func transactor(dir string, address common.Address, passwordFile string, chainID *big.Int) (*bind.TransactOpts, error) {
+	passphrase := ""
+	if passwordFile != "" {
+		data, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return nil, err
+		}
+		passphrase = strings.TrimRight(string(data), "\r\n")
+	}
+	ks := keystore.NewKeyStore(dir, keystore.StandardScryptN, keystore.StandardScryptP)
+	account, err := ks.Find(accounts.Account{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	if err := ks.Unlock(account, passphrase); err != nil {
+		return nil, err
+	}
+	return bind.NewKeyStoreTransactorWithChainID(ks, account, chainID)
+}
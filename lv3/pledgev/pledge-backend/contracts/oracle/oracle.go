@@ -0,0 +1,464 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package oracle
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// BscPledgeOracleMetaData contains all meta data concerning the BscPledgeOracle contract.
+var BscPledgeOracleMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"multiSignature\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setDecimals\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newDecimals\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPrices\",\"inputs\":[{\"internalType\":\"uint256[]\",\"name\":\"assets\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"prices\",\"type\":\"uint256[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getPrices\",\"inputs\":[{\"internalType\":\"uint256[]\",\"name\":\"assets\",\"type\":\"uint256[]\"}],\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPrice\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"asset\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getUnderlyingPrice\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"underlying\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setPrice\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"asset\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setUnderlyingPrice\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"underlying\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"price\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setAssetsAggregator\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"asset\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"aggergator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_decimals\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setUnderlyingAggregator\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"underlying\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"aggergator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_decimals\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getAssetsAggregator\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"asset\",\"type\":\"address\"}],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getUnderlyingAggregator\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"underlying\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"}]",
+}
+
+// BscPledgeOracleABI is the input ABI used to generate the binding from.
+// Deprecated: Use BscPledgeOracleMetaData.ABI instead.
+var BscPledgeOracleABI = BscPledgeOracleMetaData.ABI
+
+// BscPledgeOracle is an auto generated Go binding around an Ethereum contract.
+type BscPledgeOracle struct {
+	BscPledgeOracleCaller     // Read-only binding to the contract
+	BscPledgeOracleTransactor // Write-only binding to the contract
+	BscPledgeOracleFilterer   // Log filterer for contract events
+}
+
+// BscPledgeOracleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BscPledgeOracleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BscPledgeOracleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BscPledgeOracleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BscPledgeOracleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BscPledgeOracleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BscPledgeOracleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BscPledgeOracleSession struct {
+	Contract     *BscPledgeOracle  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BscPledgeOracleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BscPledgeOracleCallerSession struct {
+	Contract *BscPledgeOracleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// BscPledgeOracleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BscPledgeOracleTransactorSession struct {
+	Contract     *BscPledgeOracleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// BscPledgeOracleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BscPledgeOracleRaw struct {
+	Contract *BscPledgeOracle // Generic contract binding to access the raw methods on
+}
+
+// BscPledgeOracleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BscPledgeOracleCallerRaw struct {
+	Contract *BscPledgeOracleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BscPledgeOracleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BscPledgeOracleTransactorRaw struct {
+	Contract *BscPledgeOracleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBscPledgeOracle creates a new instance of BscPledgeOracle, bound to a specific deployed contract.
+func NewBscPledgeOracle(address common.Address, backend bind.ContractBackend) (*BscPledgeOracle, error) {
+	contract, err := bindBscPledgeOracle(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BscPledgeOracle{BscPledgeOracleCaller: BscPledgeOracleCaller{contract: contract}, BscPledgeOracleTransactor: BscPledgeOracleTransactor{contract: contract}, BscPledgeOracleFilterer: BscPledgeOracleFilterer{contract: contract}}, nil
+}
+
+// NewBscPledgeOracleCaller creates a new read-only instance of BscPledgeOracle, bound to a specific deployed contract.
+func NewBscPledgeOracleCaller(address common.Address, caller bind.ContractCaller) (*BscPledgeOracleCaller, error) {
+	contract, err := bindBscPledgeOracle(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BscPledgeOracleCaller{contract: contract}, nil
+}
+
+// NewBscPledgeOracleTransactor creates a new write-only instance of BscPledgeOracle, bound to a specific deployed contract.
+func NewBscPledgeOracleTransactor(address common.Address, transactor bind.ContractTransactor) (*BscPledgeOracleTransactor, error) {
+	contract, err := bindBscPledgeOracle(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BscPledgeOracleTransactor{contract: contract}, nil
+}
+
+// NewBscPledgeOracleFilterer creates a new log filterer instance of BscPledgeOracle, bound to a specific deployed contract.
+func NewBscPledgeOracleFilterer(address common.Address, filterer bind.ContractFilterer) (*BscPledgeOracleFilterer, error) {
+	contract, err := bindBscPledgeOracle(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BscPledgeOracleFilterer{contract: contract}, nil
+}
+
+// bindBscPledgeOracle binds a generic wrapper to an already deployed contract.
+func bindBscPledgeOracle(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BscPledgeOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BscPledgeOracle *BscPledgeOracleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BscPledgeOracle.Contract.BscPledgeOracleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BscPledgeOracle *BscPledgeOracleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.BscPledgeOracleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BscPledgeOracle *BscPledgeOracleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.BscPledgeOracleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BscPledgeOracle *BscPledgeOracleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BscPledgeOracle.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BscPledgeOracle *BscPledgeOracleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BscPledgeOracle *BscPledgeOracleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetAssetsAggregator is a free data retrieval call binding the contract method 0xb889a989.
+//
+// Solidity: function getAssetsAggregator(address asset) view returns(address, uint256)
+func (_BscPledgeOracle *BscPledgeOracleCaller) GetAssetsAggregator(opts *bind.CallOpts, asset common.Address) (common.Address, *big.Int, error) {
+	var out []interface{}
+	err := _BscPledgeOracle.contract.Call(opts, &out, "getAssetsAggregator", asset)
+
+	if err != nil {
+		return *new(common.Address), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetAssetsAggregator is a free data retrieval call binding the contract method 0xb889a989.
+//
+// Solidity: function getAssetsAggregator(address asset) view returns(address, uint256)
+func (_BscPledgeOracle *BscPledgeOracleSession) GetAssetsAggregator(asset common.Address) (common.Address, *big.Int, error) {
+	return _BscPledgeOracle.Contract.GetAssetsAggregator(&_BscPledgeOracle.CallOpts, asset)
+}
+
+// GetAssetsAggregator is a free data retrieval call binding the contract method 0xb889a989.
+//
+// Solidity: function getAssetsAggregator(address asset) view returns(address, uint256)
+func (_BscPledgeOracle *BscPledgeOracleCallerSession) GetAssetsAggregator(asset common.Address) (common.Address, *big.Int, error) {
+	return _BscPledgeOracle.Contract.GetAssetsAggregator(&_BscPledgeOracle.CallOpts, asset)
+}
+
+// GetPrice is a free data retrieval call binding the contract method 0x41976e09.
+//
+// Solidity: function getPrice(address asset) view returns(uint256)
+func (_BscPledgeOracle *BscPledgeOracleCaller) GetPrice(opts *bind.CallOpts, asset common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BscPledgeOracle.contract.Call(opts, &out, "getPrice", asset)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetPrice is a free data retrieval call binding the contract method 0x41976e09.
+//
+// Solidity: function getPrice(address asset) view returns(uint256)
+func (_BscPledgeOracle *BscPledgeOracleSession) GetPrice(asset common.Address) (*big.Int, error) {
+	return _BscPledgeOracle.Contract.GetPrice(&_BscPledgeOracle.CallOpts, asset)
+}
+
+// GetPrice is a free data retrieval call binding the contract method 0x41976e09.
+//
+// Solidity: function getPrice(address asset) view returns(uint256)
+func (_BscPledgeOracle *BscPledgeOracleCallerSession) GetPrice(asset common.Address) (*big.Int, error) {
+	return _BscPledgeOracle.Contract.GetPrice(&_BscPledgeOracle.CallOpts, asset)
+}
+
+// GetPrices is a free data retrieval call binding the contract method 0x09cb3a4e.
+//
+// Solidity: function getPrices(uint256[] assets) view returns(uint256[])
+func (_BscPledgeOracle *BscPledgeOracleCaller) GetPrices(opts *bind.CallOpts, assets []*big.Int) ([]*big.Int, error) {
+	var out []interface{}
+	err := _BscPledgeOracle.contract.Call(opts, &out, "getPrices", assets)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetPrices is a free data retrieval call binding the contract method 0x09cb3a4e.
+//
+// Solidity: function getPrices(uint256[] assets) view returns(uint256[])
+func (_BscPledgeOracle *BscPledgeOracleSession) GetPrices(assets []*big.Int) ([]*big.Int, error) {
+	return _BscPledgeOracle.Contract.GetPrices(&_BscPledgeOracle.CallOpts, assets)
+}
+
+// GetPrices is a free data retrieval call binding the contract method 0x09cb3a4e.
+//
+// Solidity: function getPrices(uint256[] assets) view returns(uint256[])
+func (_BscPledgeOracle *BscPledgeOracleCallerSession) GetPrices(assets []*big.Int) ([]*big.Int, error) {
+	return _BscPledgeOracle.Contract.GetPrices(&_BscPledgeOracle.CallOpts, assets)
+}
+
+// GetUnderlyingAggregator is a free data retrieval call binding the contract method 0x75e443aa.
+//
+// Solidity: function getUnderlyingAggregator(uint256 underlying) view returns(address, uint256)
+func (_BscPledgeOracle *BscPledgeOracleCaller) GetUnderlyingAggregator(opts *bind.CallOpts, underlying *big.Int) (common.Address, *big.Int, error) {
+	var out []interface{}
+	err := _BscPledgeOracle.contract.Call(opts, &out, "getUnderlyingAggregator", underlying)
+
+	if err != nil {
+		return *new(common.Address), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	out1 := *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return out0, out1, err
+
+}
+
+// GetUnderlyingAggregator is a free data retrieval call binding the contract method 0x75e443aa.
+//
+// Solidity: function getUnderlyingAggregator(uint256 underlying) view returns(address, uint256)
+func (_BscPledgeOracle *BscPledgeOracleSession) GetUnderlyingAggregator(underlying *big.Int) (common.Address, *big.Int, error) {
+	return _BscPledgeOracle.Contract.GetUnderlyingAggregator(&_BscPledgeOracle.CallOpts, underlying)
+}
+
+// GetUnderlyingAggregator is a free data retrieval call binding the contract method 0x75e443aa.
+//
+// Solidity: function getUnderlyingAggregator(uint256 underlying) view returns(address, uint256)
+func (_BscPledgeOracle *BscPledgeOracleCallerSession) GetUnderlyingAggregator(underlying *big.Int) (common.Address, *big.Int, error) {
+	return _BscPledgeOracle.Contract.GetUnderlyingAggregator(&_BscPledgeOracle.CallOpts, underlying)
+}
+
+// GetUnderlyingPrice is a free data retrieval call binding the contract method 0xda663257.
+//
+// Solidity: function getUnderlyingPrice(uint256 underlying) view returns(uint256)
+func (_BscPledgeOracle *BscPledgeOracleCaller) GetUnderlyingPrice(opts *bind.CallOpts, underlying *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _BscPledgeOracle.contract.Call(opts, &out, "getUnderlyingPrice", underlying)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetUnderlyingPrice is a free data retrieval call binding the contract method 0xda663257.
+//
+// Solidity: function getUnderlyingPrice(uint256 underlying) view returns(uint256)
+func (_BscPledgeOracle *BscPledgeOracleSession) GetUnderlyingPrice(underlying *big.Int) (*big.Int, error) {
+	return _BscPledgeOracle.Contract.GetUnderlyingPrice(&_BscPledgeOracle.CallOpts, underlying)
+}
+
+// GetUnderlyingPrice is a free data retrieval call binding the contract method 0xda663257.
+//
+// Solidity: function getUnderlyingPrice(uint256 underlying) view returns(uint256)
+func (_BscPledgeOracle *BscPledgeOracleCallerSession) GetUnderlyingPrice(underlying *big.Int) (*big.Int, error) {
+	return _BscPledgeOracle.Contract.GetUnderlyingPrice(&_BscPledgeOracle.CallOpts, underlying)
+}
+
+// SetAssetsAggregator is a paid mutator transaction binding the contract method 0xcd9ffa0b.
+//
+// Solidity: function setAssetsAggregator(address asset, address aggergator, uint256 _decimals) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactor) SetAssetsAggregator(opts *bind.TransactOpts, asset common.Address, aggergator common.Address, _decimals *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.contract.Transact(opts, "setAssetsAggregator", asset, aggergator, _decimals)
+}
+
+// SetAssetsAggregator is a paid mutator transaction binding the contract method 0xcd9ffa0b.
+//
+// Solidity: function setAssetsAggregator(address asset, address aggergator, uint256 _decimals) returns()
+func (_BscPledgeOracle *BscPledgeOracleSession) SetAssetsAggregator(asset common.Address, aggergator common.Address, _decimals *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetAssetsAggregator(&_BscPledgeOracle.TransactOpts, asset, aggergator, _decimals)
+}
+
+// SetAssetsAggregator is a paid mutator transaction binding the contract method 0xcd9ffa0b.
+//
+// Solidity: function setAssetsAggregator(address asset, address aggergator, uint256 _decimals) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactorSession) SetAssetsAggregator(asset common.Address, aggergator common.Address, _decimals *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetAssetsAggregator(&_BscPledgeOracle.TransactOpts, asset, aggergator, _decimals)
+}
+
+// SetDecimals is a paid mutator transaction binding the contract method 0x8c8885c8.
+//
+// Solidity: function setDecimals(uint256 newDecimals) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactor) SetDecimals(opts *bind.TransactOpts, newDecimals *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.contract.Transact(opts, "setDecimals", newDecimals)
+}
+
+// SetDecimals is a paid mutator transaction binding the contract method 0x8c8885c8.
+//
+// Solidity: function setDecimals(uint256 newDecimals) returns()
+func (_BscPledgeOracle *BscPledgeOracleSession) SetDecimals(newDecimals *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetDecimals(&_BscPledgeOracle.TransactOpts, newDecimals)
+}
+
+// SetDecimals is a paid mutator transaction binding the contract method 0x8c8885c8.
+//
+// Solidity: function setDecimals(uint256 newDecimals) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactorSession) SetDecimals(newDecimals *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetDecimals(&_BscPledgeOracle.TransactOpts, newDecimals)
+}
+
+// SetPrice is a paid mutator transaction binding the contract method 0x00e4768b.
+//
+// Solidity: function setPrice(address asset, uint256 price) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactor) SetPrice(opts *bind.TransactOpts, asset common.Address, price *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.contract.Transact(opts, "setPrice", asset, price)
+}
+
+// SetPrice is a paid mutator transaction binding the contract method 0x00e4768b.
+//
+// Solidity: function setPrice(address asset, uint256 price) returns()
+func (_BscPledgeOracle *BscPledgeOracleSession) SetPrice(asset common.Address, price *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetPrice(&_BscPledgeOracle.TransactOpts, asset, price)
+}
+
+// SetPrice is a paid mutator transaction binding the contract method 0x00e4768b.
+//
+// Solidity: function setPrice(address asset, uint256 price) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactorSession) SetPrice(asset common.Address, price *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetPrice(&_BscPledgeOracle.TransactOpts, asset, price)
+}
+
+// SetPrices is a paid mutator transaction binding the contract method 0xd05eaae0.
+//
+// Solidity: function setPrices(uint256[] assets, uint256[] prices) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactor) SetPrices(opts *bind.TransactOpts, assets []*big.Int, prices []*big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.contract.Transact(opts, "setPrices", assets, prices)
+}
+
+// SetPrices is a paid mutator transaction binding the contract method 0xd05eaae0.
+//
+// Solidity: function setPrices(uint256[] assets, uint256[] prices) returns()
+func (_BscPledgeOracle *BscPledgeOracleSession) SetPrices(assets []*big.Int, prices []*big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetPrices(&_BscPledgeOracle.TransactOpts, assets, prices)
+}
+
+// SetPrices is a paid mutator transaction binding the contract method 0xd05eaae0.
+//
+// Solidity: function setPrices(uint256[] assets, uint256[] prices) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactorSession) SetPrices(assets []*big.Int, prices []*big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetPrices(&_BscPledgeOracle.TransactOpts, assets, prices)
+}
+
+// SetUnderlyingAggregator is a paid mutator transaction binding the contract method 0x434b33ac.
+//
+// Solidity: function setUnderlyingAggregator(uint256 underlying, address aggergator, uint256 _decimals) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactor) SetUnderlyingAggregator(opts *bind.TransactOpts, underlying *big.Int, aggergator common.Address, _decimals *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.contract.Transact(opts, "setUnderlyingAggregator", underlying, aggergator, _decimals)
+}
+
+// SetUnderlyingAggregator is a paid mutator transaction binding the contract method 0x434b33ac.
+//
+// Solidity: function setUnderlyingAggregator(uint256 underlying, address aggergator, uint256 _decimals) returns()
+func (_BscPledgeOracle *BscPledgeOracleSession) SetUnderlyingAggregator(underlying *big.Int, aggergator common.Address, _decimals *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetUnderlyingAggregator(&_BscPledgeOracle.TransactOpts, underlying, aggergator, _decimals)
+}
+
+// SetUnderlyingAggregator is a paid mutator transaction binding the contract method 0x434b33ac.
+//
+// Solidity: function setUnderlyingAggregator(uint256 underlying, address aggergator, uint256 _decimals) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactorSession) SetUnderlyingAggregator(underlying *big.Int, aggergator common.Address, _decimals *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetUnderlyingAggregator(&_BscPledgeOracle.TransactOpts, underlying, aggergator, _decimals)
+}
+
+// SetUnderlyingPrice is a paid mutator transaction binding the contract method 0x83532667.
+//
+// Solidity: function setUnderlyingPrice(uint256 underlying, uint256 price) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactor) SetUnderlyingPrice(opts *bind.TransactOpts, underlying *big.Int, price *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.contract.Transact(opts, "setUnderlyingPrice", underlying, price)
+}
+
+// SetUnderlyingPrice is a paid mutator transaction binding the contract method 0x83532667.
+//
+// Solidity: function setUnderlyingPrice(uint256 underlying, uint256 price) returns()
+func (_BscPledgeOracle *BscPledgeOracleSession) SetUnderlyingPrice(underlying *big.Int, price *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetUnderlyingPrice(&_BscPledgeOracle.TransactOpts, underlying, price)
+}
+
+// SetUnderlyingPrice is a paid mutator transaction binding the contract method 0x83532667.
+//
+// Solidity: function setUnderlyingPrice(uint256 underlying, uint256 price) returns()
+func (_BscPledgeOracle *BscPledgeOracleTransactorSession) SetUnderlyingPrice(underlying *big.Int, price *big.Int) (*types.Transaction, error) {
+	return _BscPledgeOracle.Contract.SetUnderlyingPrice(&_BscPledgeOracle.TransactOpts, underlying, price)
+}
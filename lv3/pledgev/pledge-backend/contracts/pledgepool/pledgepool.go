@@ -0,0 +1,3731 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package pledgepool
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// PledgePoolMetaData contains all meta data concerning the PledgePool contract.
+var PledgePoolMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"_oracle\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_swapRouter\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_feeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_multiSignature\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"poolLength\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"poolBaseInfo\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"settleTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"endTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"interestRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lendSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"borrowSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"martgageRate\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"lendToken\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"borrowToken\",\"type\":\"address\"},{\"internalType\":\"enumPledgePool.PoolState\",\"name\":\"state\",\"type\":\"uint8\"},{\"internalType\":\"contractIDebtToken\",\"name\":\"spCoin\",\"type\":\"address\"},{\"internalType\":\"contractIDebtToken\",\"name\":\"jpCoin\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"autoLiquidateThreshold\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"poolDataInfo\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"settleAmountLend\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"settleAmountBorrow\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"finishAmountLend\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"finishAmountBorrow\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"liquidationAmounLend\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"liquidationAmounBorrow\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"userBorrowInfo\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"stakeAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"refundAmount\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"hasNoRefund\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"hasNoClaim\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"userLendInfo\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"stakeAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"refundAmount\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"hasNoRefund\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"hasNoClaim\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPoolState\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lendFee\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"borrowFee\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"minAmount\",\"inputs\":[],\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"oracle\",\"inputs\":[],\"outputs\":[{\"internalType\":\"contractIBscPledgeOracle\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"swapRouter\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"feeAddress\",\"inputs\":[],\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"globalPaused\",\"inputs\":[],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setFee\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_lendFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_borrowFee\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setSwapRouterAddress\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"_swapRouter\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setFeeAddress\",\"inputs\":[{\"internalType\":\"address\",\"name\":\"_feeAddress\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setMinAmount\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_minAmount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setPause\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"createPoolInfo\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_settleTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_endTime\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"_interestRate\",\"type\":\"uint64\"},{\"internalType\":\"uint256\",\"name\":\"_maxSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_martgageRate\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_lendToken\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_borrowToken\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_spToken\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_jpToken\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_autoLiquidateThreshold\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"depositLend\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_stakeAmount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"refundLend\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"claimLend\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawLend\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_spAmount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"emergencyLendWithdrawal\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"depositBorrow\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_stakeAmount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"refundBorrow\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"claimBorrow\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawBorrow\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_jpAmount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"emergencyBorrowWithdrawal\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"checkoutSettle\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"settle\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"checkoutFinish\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"finish\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"checkoutLiquidate\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"liquidate\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getUnderlyingPriceView\",\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pid\",\"type\":\"uint256\"}],\"outputs\":[{\"internalType\":\"uint256[2]\",\"name\":\"\",\"type\":\"uint256[2]\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"DepositLend\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"mintAmount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"RefundLend\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"refund\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"ClaimLend\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"WithdrawLend\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"burnAmount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"DepositBorrow\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"mintAmount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"RefundBorrow\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"refund\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"ClaimBorrow\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"WithdrawBorrow\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"burnAmount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"Swap\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"fromCoin\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"toCoin\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"fromValue\",\"type\":\"uint256\",\"indexed\":false},{\"internalType\":\"uint256\",\"name\":\"toValue\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"EmergencyBorrowWithdrawal\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"EmergencyLendWithdrawal\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}]},{\"type\":\"event\",\"name\":\"StateChange\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"pid\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"beforeState\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"afterState\",\"type\":\"uint256\",\"indexed\":true}]},{\"type\":\"event\",\"name\":\"SetFee\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"newLendFee\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"newBorrowFee\",\"type\":\"uint256\",\"indexed\":true}]},{\"type\":\"event\",\"name\":\"SetSwapRouterAddress\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"oldSwapAddress\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"newSwapAddress\",\"type\":\"address\",\"indexed\":true}]},{\"type\":\"event\",\"name\":\"SetFeeAddress\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"oldFeeAddress\",\"type\":\"address\",\"indexed\":true},{\"internalType\":\"address\",\"name\":\"newFeeAddress\",\"type\":\"address\",\"indexed\":true}]},{\"type\":\"event\",\"name\":\"SetMinAmount\",\"anonymous\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"oldMinAmount\",\"type\":\"uint256\",\"indexed\":true},{\"internalType\":\"uint256\",\"name\":\"newMinAmount\",\"type\":\"uint256\",\"indexed\":true}]}]",
+}
+
+// PledgePoolABI is the input ABI used to generate the binding from.
+// Deprecated: Use PledgePoolMetaData.ABI instead.
+var PledgePoolABI = PledgePoolMetaData.ABI
+
+// PledgePool is an auto generated Go binding around an Ethereum contract.
+type PledgePool struct {
+	PledgePoolCaller     // Read-only binding to the contract
+	PledgePoolTransactor // Write-only binding to the contract
+	PledgePoolFilterer   // Log filterer for contract events
+}
+
+// PledgePoolCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PledgePoolCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PledgePoolTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PledgePoolTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PledgePoolFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PledgePoolFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PledgePoolSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PledgePoolSession struct {
+	Contract     *PledgePool       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// PledgePoolCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PledgePoolCallerSession struct {
+	Contract *PledgePoolCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// PledgePoolTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PledgePoolTransactorSession struct {
+	Contract     *PledgePoolTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// PledgePoolRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PledgePoolRaw struct {
+	Contract *PledgePool // Generic contract binding to access the raw methods on
+}
+
+// PledgePoolCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PledgePoolCallerRaw struct {
+	Contract *PledgePoolCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PledgePoolTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PledgePoolTransactorRaw struct {
+	Contract *PledgePoolTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPledgePool creates a new instance of PledgePool, bound to a specific deployed contract.
+func NewPledgePool(address common.Address, backend bind.ContractBackend) (*PledgePool, error) {
+	contract, err := bindPledgePool(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePool{PledgePoolCaller: PledgePoolCaller{contract: contract}, PledgePoolTransactor: PledgePoolTransactor{contract: contract}, PledgePoolFilterer: PledgePoolFilterer{contract: contract}}, nil
+}
+
+// NewPledgePoolCaller creates a new read-only instance of PledgePool, bound to a specific deployed contract.
+func NewPledgePoolCaller(address common.Address, caller bind.ContractCaller) (*PledgePoolCaller, error) {
+	contract, err := bindPledgePool(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolCaller{contract: contract}, nil
+}
+
+// NewPledgePoolTransactor creates a new write-only instance of PledgePool, bound to a specific deployed contract.
+func NewPledgePoolTransactor(address common.Address, transactor bind.ContractTransactor) (*PledgePoolTransactor, error) {
+	contract, err := bindPledgePool(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolTransactor{contract: contract}, nil
+}
+
+// NewPledgePoolFilterer creates a new log filterer instance of PledgePool, bound to a specific deployed contract.
+func NewPledgePoolFilterer(address common.Address, filterer bind.ContractFilterer) (*PledgePoolFilterer, error) {
+	contract, err := bindPledgePool(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolFilterer{contract: contract}, nil
+}
+
+// bindPledgePool binds a generic wrapper to an already deployed contract.
+func bindPledgePool(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := PledgePoolMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PledgePool *PledgePoolRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PledgePool.Contract.PledgePoolCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PledgePool *PledgePoolRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PledgePool.Contract.PledgePoolTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PledgePool *PledgePoolRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PledgePool.Contract.PledgePoolTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PledgePool *PledgePoolCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PledgePool.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PledgePool *PledgePoolTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PledgePool.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PledgePool *PledgePoolTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PledgePool.Contract.contract.Transact(opts, method, params...)
+}
+
+// BorrowFee is a free data retrieval call binding the contract method 0xe626648a.
+//
+// Solidity: function borrowFee() view returns(uint256)
+func (_PledgePool *PledgePoolCaller) BorrowFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "borrowFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BorrowFee is a free data retrieval call binding the contract method 0xe626648a.
+//
+// Solidity: function borrowFee() view returns(uint256)
+func (_PledgePool *PledgePoolSession) BorrowFee() (*big.Int, error) {
+	return _PledgePool.Contract.BorrowFee(&_PledgePool.CallOpts)
+}
+
+// BorrowFee is a free data retrieval call binding the contract method 0xe626648a.
+//
+// Solidity: function borrowFee() view returns(uint256)
+func (_PledgePool *PledgePoolCallerSession) BorrowFee() (*big.Int, error) {
+	return _PledgePool.Contract.BorrowFee(&_PledgePool.CallOpts)
+}
+
+// CheckoutFinish is a free data retrieval call binding the contract method 0x6abd7f29.
+//
+// Solidity: function checkoutFinish(uint256 _pid) view returns(bool)
+func (_PledgePool *PledgePoolCaller) CheckoutFinish(opts *bind.CallOpts, _pid *big.Int) (bool, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "checkoutFinish", _pid)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CheckoutFinish is a free data retrieval call binding the contract method 0x6abd7f29.
+//
+// Solidity: function checkoutFinish(uint256 _pid) view returns(bool)
+func (_PledgePool *PledgePoolSession) CheckoutFinish(_pid *big.Int) (bool, error) {
+	return _PledgePool.Contract.CheckoutFinish(&_PledgePool.CallOpts, _pid)
+}
+
+// CheckoutFinish is a free data retrieval call binding the contract method 0x6abd7f29.
+//
+// Solidity: function checkoutFinish(uint256 _pid) view returns(bool)
+func (_PledgePool *PledgePoolCallerSession) CheckoutFinish(_pid *big.Int) (bool, error) {
+	return _PledgePool.Contract.CheckoutFinish(&_PledgePool.CallOpts, _pid)
+}
+
+// CheckoutLiquidate is a free data retrieval call binding the contract method 0x08e7305f.
+//
+// Solidity: function checkoutLiquidate(uint256 _pid) view returns(bool)
+func (_PledgePool *PledgePoolCaller) CheckoutLiquidate(opts *bind.CallOpts, _pid *big.Int) (bool, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "checkoutLiquidate", _pid)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CheckoutLiquidate is a free data retrieval call binding the contract method 0x08e7305f.
+//
+// Solidity: function checkoutLiquidate(uint256 _pid) view returns(bool)
+func (_PledgePool *PledgePoolSession) CheckoutLiquidate(_pid *big.Int) (bool, error) {
+	return _PledgePool.Contract.CheckoutLiquidate(&_PledgePool.CallOpts, _pid)
+}
+
+// CheckoutLiquidate is a free data retrieval call binding the contract method 0x08e7305f.
+//
+// Solidity: function checkoutLiquidate(uint256 _pid) view returns(bool)
+func (_PledgePool *PledgePoolCallerSession) CheckoutLiquidate(_pid *big.Int) (bool, error) {
+	return _PledgePool.Contract.CheckoutLiquidate(&_PledgePool.CallOpts, _pid)
+}
+
+// CheckoutSettle is a free data retrieval call binding the contract method 0x14c090cc.
+//
+// Solidity: function checkoutSettle(uint256 _pid) view returns(bool)
+func (_PledgePool *PledgePoolCaller) CheckoutSettle(opts *bind.CallOpts, _pid *big.Int) (bool, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "checkoutSettle", _pid)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// CheckoutSettle is a free data retrieval call binding the contract method 0x14c090cc.
+//
+// Solidity: function checkoutSettle(uint256 _pid) view returns(bool)
+func (_PledgePool *PledgePoolSession) CheckoutSettle(_pid *big.Int) (bool, error) {
+	return _PledgePool.Contract.CheckoutSettle(&_PledgePool.CallOpts, _pid)
+}
+
+// CheckoutSettle is a free data retrieval call binding the contract method 0x14c090cc.
+//
+// Solidity: function checkoutSettle(uint256 _pid) view returns(bool)
+func (_PledgePool *PledgePoolCallerSession) CheckoutSettle(_pid *big.Int) (bool, error) {
+	return _PledgePool.Contract.CheckoutSettle(&_PledgePool.CallOpts, _pid)
+}
+
+// FeeAddress is a free data retrieval call binding the contract method 0x41275358.
+//
+// Solidity: function feeAddress() view returns(address)
+func (_PledgePool *PledgePoolCaller) FeeAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "feeAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FeeAddress is a free data retrieval call binding the contract method 0x41275358.
+//
+// Solidity: function feeAddress() view returns(address)
+func (_PledgePool *PledgePoolSession) FeeAddress() (common.Address, error) {
+	return _PledgePool.Contract.FeeAddress(&_PledgePool.CallOpts)
+}
+
+// FeeAddress is a free data retrieval call binding the contract method 0x41275358.
+//
+// Solidity: function feeAddress() view returns(address)
+func (_PledgePool *PledgePoolCallerSession) FeeAddress() (common.Address, error) {
+	return _PledgePool.Contract.FeeAddress(&_PledgePool.CallOpts)
+}
+
+// GetPoolState is a free data retrieval call binding the contract method 0xb1597517.
+//
+// Solidity: function getPoolState(uint256 _pid) view returns(uint256)
+func (_PledgePool *PledgePoolCaller) GetPoolState(opts *bind.CallOpts, _pid *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "getPoolState", _pid)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetPoolState is a free data retrieval call binding the contract method 0xb1597517.
+//
+// Solidity: function getPoolState(uint256 _pid) view returns(uint256)
+func (_PledgePool *PledgePoolSession) GetPoolState(_pid *big.Int) (*big.Int, error) {
+	return _PledgePool.Contract.GetPoolState(&_PledgePool.CallOpts, _pid)
+}
+
+// GetPoolState is a free data retrieval call binding the contract method 0xb1597517.
+//
+// Solidity: function getPoolState(uint256 _pid) view returns(uint256)
+func (_PledgePool *PledgePoolCallerSession) GetPoolState(_pid *big.Int) (*big.Int, error) {
+	return _PledgePool.Contract.GetPoolState(&_PledgePool.CallOpts, _pid)
+}
+
+// GetUnderlyingPriceView is a free data retrieval call binding the contract method 0xc9333756.
+//
+// Solidity: function getUnderlyingPriceView(uint256 _pid) view returns(uint256[2])
+func (_PledgePool *PledgePoolCaller) GetUnderlyingPriceView(opts *bind.CallOpts, _pid *big.Int) ([2]*big.Int, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "getUnderlyingPriceView", _pid)
+
+	if err != nil {
+		return *new([2]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([2]*big.Int)).(*[2]*big.Int)
+
+	return out0, err
+
+}
+
+// GetUnderlyingPriceView is a free data retrieval call binding the contract method 0xc9333756.
+//
+// Solidity: function getUnderlyingPriceView(uint256 _pid) view returns(uint256[2])
+func (_PledgePool *PledgePoolSession) GetUnderlyingPriceView(_pid *big.Int) ([2]*big.Int, error) {
+	return _PledgePool.Contract.GetUnderlyingPriceView(&_PledgePool.CallOpts, _pid)
+}
+
+// GetUnderlyingPriceView is a free data retrieval call binding the contract method 0xc9333756.
+//
+// Solidity: function getUnderlyingPriceView(uint256 _pid) view returns(uint256[2])
+func (_PledgePool *PledgePoolCallerSession) GetUnderlyingPriceView(_pid *big.Int) ([2]*big.Int, error) {
+	return _PledgePool.Contract.GetUnderlyingPriceView(&_PledgePool.CallOpts, _pid)
+}
+
+// GlobalPaused is a free data retrieval call binding the contract method 0x61a552dc.
+//
+// Solidity: function globalPaused() view returns(bool)
+func (_PledgePool *PledgePoolCaller) GlobalPaused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "globalPaused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// GlobalPaused is a free data retrieval call binding the contract method 0x61a552dc.
+//
+// Solidity: function globalPaused() view returns(bool)
+func (_PledgePool *PledgePoolSession) GlobalPaused() (bool, error) {
+	return _PledgePool.Contract.GlobalPaused(&_PledgePool.CallOpts)
+}
+
+// GlobalPaused is a free data retrieval call binding the contract method 0x61a552dc.
+//
+// Solidity: function globalPaused() view returns(bool)
+func (_PledgePool *PledgePoolCallerSession) GlobalPaused() (bool, error) {
+	return _PledgePool.Contract.GlobalPaused(&_PledgePool.CallOpts)
+}
+
+// LendFee is a free data retrieval call binding the contract method 0x4aea0aec.
+//
+// Solidity: function lendFee() view returns(uint256)
+func (_PledgePool *PledgePoolCaller) LendFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "lendFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LendFee is a free data retrieval call binding the contract method 0x4aea0aec.
+//
+// Solidity: function lendFee() view returns(uint256)
+func (_PledgePool *PledgePoolSession) LendFee() (*big.Int, error) {
+	return _PledgePool.Contract.LendFee(&_PledgePool.CallOpts)
+}
+
+// LendFee is a free data retrieval call binding the contract method 0x4aea0aec.
+//
+// Solidity: function lendFee() view returns(uint256)
+func (_PledgePool *PledgePoolCallerSession) LendFee() (*big.Int, error) {
+	return _PledgePool.Contract.LendFee(&_PledgePool.CallOpts)
+}
+
+// MinAmount is a free data retrieval call binding the contract method 0x9b2cb5d8.
+//
+// Solidity: function minAmount() view returns(uint256)
+func (_PledgePool *PledgePoolCaller) MinAmount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "minAmount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MinAmount is a free data retrieval call binding the contract method 0x9b2cb5d8.
+//
+// Solidity: function minAmount() view returns(uint256)
+func (_PledgePool *PledgePoolSession) MinAmount() (*big.Int, error) {
+	return _PledgePool.Contract.MinAmount(&_PledgePool.CallOpts)
+}
+
+// MinAmount is a free data retrieval call binding the contract method 0x9b2cb5d8.
+//
+// Solidity: function minAmount() view returns(uint256)
+func (_PledgePool *PledgePoolCallerSession) MinAmount() (*big.Int, error) {
+	return _PledgePool.Contract.MinAmount(&_PledgePool.CallOpts)
+}
+
+// Oracle is a free data retrieval call binding the contract method 0x7dc0d1d0.
+//
+// Solidity: function oracle() view returns(address)
+func (_PledgePool *PledgePoolCaller) Oracle(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "oracle")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Oracle is a free data retrieval call binding the contract method 0x7dc0d1d0.
+//
+// Solidity: function oracle() view returns(address)
+func (_PledgePool *PledgePoolSession) Oracle() (common.Address, error) {
+	return _PledgePool.Contract.Oracle(&_PledgePool.CallOpts)
+}
+
+// Oracle is a free data retrieval call binding the contract method 0x7dc0d1d0.
+//
+// Solidity: function oracle() view returns(address)
+func (_PledgePool *PledgePoolCallerSession) Oracle() (common.Address, error) {
+	return _PledgePool.Contract.Oracle(&_PledgePool.CallOpts)
+}
+
+// PoolBaseInfo is a free data retrieval call binding the contract method 0x5a5a971e.
+//
+// Solidity: function poolBaseInfo(uint256 ) view returns(uint256 settleTime, uint256 endTime, uint256 interestRate, uint256 maxSupply, uint256 lendSupply, uint256 borrowSupply, uint256 martgageRate, address lendToken, address borrowToken, uint8 state, address spCoin, address jpCoin, uint256 autoLiquidateThreshold)
+func (_PledgePool *PledgePoolCaller) PoolBaseInfo(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	SettleTime             *big.Int
+	EndTime                *big.Int
+	InterestRate           *big.Int
+	MaxSupply              *big.Int
+	LendSupply             *big.Int
+	BorrowSupply           *big.Int
+	MartgageRate           *big.Int
+	LendToken              common.Address
+	BorrowToken            common.Address
+	State                  uint8
+	SpCoin                 common.Address
+	JpCoin                 common.Address
+	AutoLiquidateThreshold *big.Int
+}, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "poolBaseInfo", arg0)
+
+	outstruct := new(struct {
+		SettleTime             *big.Int
+		EndTime                *big.Int
+		InterestRate           *big.Int
+		MaxSupply              *big.Int
+		LendSupply             *big.Int
+		BorrowSupply           *big.Int
+		MartgageRate           *big.Int
+		LendToken              common.Address
+		BorrowToken            common.Address
+		State                  uint8
+		SpCoin                 common.Address
+		JpCoin                 common.Address
+		AutoLiquidateThreshold *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.SettleTime = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.EndTime = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.InterestRate = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.MaxSupply = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.LendSupply = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.BorrowSupply = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+	outstruct.MartgageRate = *abi.ConvertType(out[6], new(*big.Int)).(**big.Int)
+	outstruct.LendToken = *abi.ConvertType(out[7], new(common.Address)).(*common.Address)
+	outstruct.BorrowToken = *abi.ConvertType(out[8], new(common.Address)).(*common.Address)
+	outstruct.State = *abi.ConvertType(out[9], new(uint8)).(*uint8)
+	outstruct.SpCoin = *abi.ConvertType(out[10], new(common.Address)).(*common.Address)
+	outstruct.JpCoin = *abi.ConvertType(out[11], new(common.Address)).(*common.Address)
+	outstruct.AutoLiquidateThreshold = *abi.ConvertType(out[12], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// PoolBaseInfo is a free data retrieval call binding the contract method 0x5a5a971e.
+//
+// Solidity: function poolBaseInfo(uint256 ) view returns(uint256 settleTime, uint256 endTime, uint256 interestRate, uint256 maxSupply, uint256 lendSupply, uint256 borrowSupply, uint256 martgageRate, address lendToken, address borrowToken, uint8 state, address spCoin, address jpCoin, uint256 autoLiquidateThreshold)
+func (_PledgePool *PledgePoolSession) PoolBaseInfo(arg0 *big.Int) (struct {
+	SettleTime             *big.Int
+	EndTime                *big.Int
+	InterestRate           *big.Int
+	MaxSupply              *big.Int
+	LendSupply             *big.Int
+	BorrowSupply           *big.Int
+	MartgageRate           *big.Int
+	LendToken              common.Address
+	BorrowToken            common.Address
+	State                  uint8
+	SpCoin                 common.Address
+	JpCoin                 common.Address
+	AutoLiquidateThreshold *big.Int
+}, error) {
+	return _PledgePool.Contract.PoolBaseInfo(&_PledgePool.CallOpts, arg0)
+}
+
+// PoolBaseInfo is a free data retrieval call binding the contract method 0x5a5a971e.
+//
+// Solidity: function poolBaseInfo(uint256 ) view returns(uint256 settleTime, uint256 endTime, uint256 interestRate, uint256 maxSupply, uint256 lendSupply, uint256 borrowSupply, uint256 martgageRate, address lendToken, address borrowToken, uint8 state, address spCoin, address jpCoin, uint256 autoLiquidateThreshold)
+func (_PledgePool *PledgePoolCallerSession) PoolBaseInfo(arg0 *big.Int) (struct {
+	SettleTime             *big.Int
+	EndTime                *big.Int
+	InterestRate           *big.Int
+	MaxSupply              *big.Int
+	LendSupply             *big.Int
+	BorrowSupply           *big.Int
+	MartgageRate           *big.Int
+	LendToken              common.Address
+	BorrowToken            common.Address
+	State                  uint8
+	SpCoin                 common.Address
+	JpCoin                 common.Address
+	AutoLiquidateThreshold *big.Int
+}, error) {
+	return _PledgePool.Contract.PoolBaseInfo(&_PledgePool.CallOpts, arg0)
+}
+
+// PoolDataInfo is a free data retrieval call binding the contract method 0x0177b68c.
+//
+// Solidity: function poolDataInfo(uint256 ) view returns(uint256 settleAmountLend, uint256 settleAmountBorrow, uint256 finishAmountLend, uint256 finishAmountBorrow, uint256 liquidationAmounLend, uint256 liquidationAmounBorrow)
+func (_PledgePool *PledgePoolCaller) PoolDataInfo(opts *bind.CallOpts, arg0 *big.Int) (struct {
+	SettleAmountLend       *big.Int
+	SettleAmountBorrow     *big.Int
+	FinishAmountLend       *big.Int
+	FinishAmountBorrow     *big.Int
+	LiquidationAmounLend   *big.Int
+	LiquidationAmounBorrow *big.Int
+}, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "poolDataInfo", arg0)
+
+	outstruct := new(struct {
+		SettleAmountLend       *big.Int
+		SettleAmountBorrow     *big.Int
+		FinishAmountLend       *big.Int
+		FinishAmountBorrow     *big.Int
+		LiquidationAmounLend   *big.Int
+		LiquidationAmounBorrow *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.SettleAmountLend = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.SettleAmountBorrow = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.FinishAmountLend = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.FinishAmountBorrow = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.LiquidationAmounLend = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.LiquidationAmounBorrow = *abi.ConvertType(out[5], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// PoolDataInfo is a free data retrieval call binding the contract method 0x0177b68c.
+//
+// Solidity: function poolDataInfo(uint256 ) view returns(uint256 settleAmountLend, uint256 settleAmountBorrow, uint256 finishAmountLend, uint256 finishAmountBorrow, uint256 liquidationAmounLend, uint256 liquidationAmounBorrow)
+func (_PledgePool *PledgePoolSession) PoolDataInfo(arg0 *big.Int) (struct {
+	SettleAmountLend       *big.Int
+	SettleAmountBorrow     *big.Int
+	FinishAmountLend       *big.Int
+	FinishAmountBorrow     *big.Int
+	LiquidationAmounLend   *big.Int
+	LiquidationAmounBorrow *big.Int
+}, error) {
+	return _PledgePool.Contract.PoolDataInfo(&_PledgePool.CallOpts, arg0)
+}
+
+// PoolDataInfo is a free data retrieval call binding the contract method 0x0177b68c.
+//
+// Solidity: function poolDataInfo(uint256 ) view returns(uint256 settleAmountLend, uint256 settleAmountBorrow, uint256 finishAmountLend, uint256 finishAmountBorrow, uint256 liquidationAmounLend, uint256 liquidationAmounBorrow)
+func (_PledgePool *PledgePoolCallerSession) PoolDataInfo(arg0 *big.Int) (struct {
+	SettleAmountLend       *big.Int
+	SettleAmountBorrow     *big.Int
+	FinishAmountLend       *big.Int
+	FinishAmountBorrow     *big.Int
+	LiquidationAmounLend   *big.Int
+	LiquidationAmounBorrow *big.Int
+}, error) {
+	return _PledgePool.Contract.PoolDataInfo(&_PledgePool.CallOpts, arg0)
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_PledgePool *PledgePoolCaller) PoolLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "poolLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_PledgePool *PledgePoolSession) PoolLength() (*big.Int, error) {
+	return _PledgePool.Contract.PoolLength(&_PledgePool.CallOpts)
+}
+
+// PoolLength is a free data retrieval call binding the contract method 0x081e3eda.
+//
+// Solidity: function poolLength() view returns(uint256)
+func (_PledgePool *PledgePoolCallerSession) PoolLength() (*big.Int, error) {
+	return _PledgePool.Contract.PoolLength(&_PledgePool.CallOpts)
+}
+
+// SwapRouter is a free data retrieval call binding the contract method 0xc31c9c07.
+//
+// Solidity: function swapRouter() view returns(address)
+func (_PledgePool *PledgePoolCaller) SwapRouter(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "swapRouter")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SwapRouter is a free data retrieval call binding the contract method 0xc31c9c07.
+//
+// Solidity: function swapRouter() view returns(address)
+func (_PledgePool *PledgePoolSession) SwapRouter() (common.Address, error) {
+	return _PledgePool.Contract.SwapRouter(&_PledgePool.CallOpts)
+}
+
+// SwapRouter is a free data retrieval call binding the contract method 0xc31c9c07.
+//
+// Solidity: function swapRouter() view returns(address)
+func (_PledgePool *PledgePoolCallerSession) SwapRouter() (common.Address, error) {
+	return _PledgePool.Contract.SwapRouter(&_PledgePool.CallOpts)
+}
+
+// UserBorrowInfo is a free data retrieval call binding the contract method 0x3c9fadc3.
+//
+// Solidity: function userBorrowInfo(address , uint256 ) view returns(uint256 stakeAmount, uint256 refundAmount, bool hasNoRefund, bool hasNoClaim)
+func (_PledgePool *PledgePoolCaller) UserBorrowInfo(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (struct {
+	StakeAmount  *big.Int
+	RefundAmount *big.Int
+	HasNoRefund  bool
+	HasNoClaim   bool
+}, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "userBorrowInfo", arg0, arg1)
+
+	outstruct := new(struct {
+		StakeAmount  *big.Int
+		RefundAmount *big.Int
+		HasNoRefund  bool
+		HasNoClaim   bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StakeAmount = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.RefundAmount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.HasNoRefund = *abi.ConvertType(out[2], new(bool)).(*bool)
+	outstruct.HasNoClaim = *abi.ConvertType(out[3], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// UserBorrowInfo is a free data retrieval call binding the contract method 0x3c9fadc3.
+//
+// Solidity: function userBorrowInfo(address , uint256 ) view returns(uint256 stakeAmount, uint256 refundAmount, bool hasNoRefund, bool hasNoClaim)
+func (_PledgePool *PledgePoolSession) UserBorrowInfo(arg0 common.Address, arg1 *big.Int) (struct {
+	StakeAmount  *big.Int
+	RefundAmount *big.Int
+	HasNoRefund  bool
+	HasNoClaim   bool
+}, error) {
+	return _PledgePool.Contract.UserBorrowInfo(&_PledgePool.CallOpts, arg0, arg1)
+}
+
+// UserBorrowInfo is a free data retrieval call binding the contract method 0x3c9fadc3.
+//
+// Solidity: function userBorrowInfo(address , uint256 ) view returns(uint256 stakeAmount, uint256 refundAmount, bool hasNoRefund, bool hasNoClaim)
+func (_PledgePool *PledgePoolCallerSession) UserBorrowInfo(arg0 common.Address, arg1 *big.Int) (struct {
+	StakeAmount  *big.Int
+	RefundAmount *big.Int
+	HasNoRefund  bool
+	HasNoClaim   bool
+}, error) {
+	return _PledgePool.Contract.UserBorrowInfo(&_PledgePool.CallOpts, arg0, arg1)
+}
+
+// UserLendInfo is a free data retrieval call binding the contract method 0xbb176a64.
+//
+// Solidity: function userLendInfo(address , uint256 ) view returns(uint256 stakeAmount, uint256 refundAmount, bool hasNoRefund, bool hasNoClaim)
+func (_PledgePool *PledgePoolCaller) UserLendInfo(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (struct {
+	StakeAmount  *big.Int
+	RefundAmount *big.Int
+	HasNoRefund  bool
+	HasNoClaim   bool
+}, error) {
+	var out []interface{}
+	err := _PledgePool.contract.Call(opts, &out, "userLendInfo", arg0, arg1)
+
+	outstruct := new(struct {
+		StakeAmount  *big.Int
+		RefundAmount *big.Int
+		HasNoRefund  bool
+		HasNoClaim   bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.StakeAmount = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.RefundAmount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.HasNoRefund = *abi.ConvertType(out[2], new(bool)).(*bool)
+	outstruct.HasNoClaim = *abi.ConvertType(out[3], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// UserLendInfo is a free data retrieval call binding the contract method 0xbb176a64.
+//
+// Solidity: function userLendInfo(address , uint256 ) view returns(uint256 stakeAmount, uint256 refundAmount, bool hasNoRefund, bool hasNoClaim)
+func (_PledgePool *PledgePoolSession) UserLendInfo(arg0 common.Address, arg1 *big.Int) (struct {
+	StakeAmount  *big.Int
+	RefundAmount *big.Int
+	HasNoRefund  bool
+	HasNoClaim   bool
+}, error) {
+	return _PledgePool.Contract.UserLendInfo(&_PledgePool.CallOpts, arg0, arg1)
+}
+
+// UserLendInfo is a free data retrieval call binding the contract method 0xbb176a64.
+//
+// Solidity: function userLendInfo(address , uint256 ) view returns(uint256 stakeAmount, uint256 refundAmount, bool hasNoRefund, bool hasNoClaim)
+func (_PledgePool *PledgePoolCallerSession) UserLendInfo(arg0 common.Address, arg1 *big.Int) (struct {
+	StakeAmount  *big.Int
+	RefundAmount *big.Int
+	HasNoRefund  bool
+	HasNoClaim   bool
+}, error) {
+	return _PledgePool.Contract.UserLendInfo(&_PledgePool.CallOpts, arg0, arg1)
+}
+
+// ClaimBorrow is a paid mutator transaction binding the contract method 0x3ab4a445.
+//
+// Solidity: function claimBorrow(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactor) ClaimBorrow(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "claimBorrow", _pid)
+}
+
+// ClaimBorrow is a paid mutator transaction binding the contract method 0x3ab4a445.
+//
+// Solidity: function claimBorrow(uint256 _pid) returns()
+func (_PledgePool *PledgePoolSession) ClaimBorrow(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.ClaimBorrow(&_PledgePool.TransactOpts, _pid)
+}
+
+// ClaimBorrow is a paid mutator transaction binding the contract method 0x3ab4a445.
+//
+// Solidity: function claimBorrow(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactorSession) ClaimBorrow(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.ClaimBorrow(&_PledgePool.TransactOpts, _pid)
+}
+
+// ClaimLend is a paid mutator transaction binding the contract method 0x6c42fed2.
+//
+// Solidity: function claimLend(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactor) ClaimLend(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "claimLend", _pid)
+}
+
+// ClaimLend is a paid mutator transaction binding the contract method 0x6c42fed2.
+//
+// Solidity: function claimLend(uint256 _pid) returns()
+func (_PledgePool *PledgePoolSession) ClaimLend(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.ClaimLend(&_PledgePool.TransactOpts, _pid)
+}
+
+// ClaimLend is a paid mutator transaction binding the contract method 0x6c42fed2.
+//
+// Solidity: function claimLend(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactorSession) ClaimLend(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.ClaimLend(&_PledgePool.TransactOpts, _pid)
+}
+
+// CreatePoolInfo is a paid mutator transaction binding the contract method 0xebded017.
+//
+// Solidity: function createPoolInfo(uint256 _settleTime, uint256 _endTime, uint64 _interestRate, uint256 _maxSupply, uint256 _martgageRate, address _lendToken, address _borrowToken, address _spToken, address _jpToken, uint256 _autoLiquidateThreshold) returns()
+func (_PledgePool *PledgePoolTransactor) CreatePoolInfo(opts *bind.TransactOpts, _settleTime *big.Int, _endTime *big.Int, _interestRate uint64, _maxSupply *big.Int, _martgageRate *big.Int, _lendToken common.Address, _borrowToken common.Address, _spToken common.Address, _jpToken common.Address, _autoLiquidateThreshold *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "createPoolInfo", _settleTime, _endTime, _interestRate, _maxSupply, _martgageRate, _lendToken, _borrowToken, _spToken, _jpToken, _autoLiquidateThreshold)
+}
+
+// CreatePoolInfo is a paid mutator transaction binding the contract method 0xebded017.
+//
+// Solidity: function createPoolInfo(uint256 _settleTime, uint256 _endTime, uint64 _interestRate, uint256 _maxSupply, uint256 _martgageRate, address _lendToken, address _borrowToken, address _spToken, address _jpToken, uint256 _autoLiquidateThreshold) returns()
+func (_PledgePool *PledgePoolSession) CreatePoolInfo(_settleTime *big.Int, _endTime *big.Int, _interestRate uint64, _maxSupply *big.Int, _martgageRate *big.Int, _lendToken common.Address, _borrowToken common.Address, _spToken common.Address, _jpToken common.Address, _autoLiquidateThreshold *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.CreatePoolInfo(&_PledgePool.TransactOpts, _settleTime, _endTime, _interestRate, _maxSupply, _martgageRate, _lendToken, _borrowToken, _spToken, _jpToken, _autoLiquidateThreshold)
+}
+
+// CreatePoolInfo is a paid mutator transaction binding the contract method 0xebded017.
+//
+// Solidity: function createPoolInfo(uint256 _settleTime, uint256 _endTime, uint64 _interestRate, uint256 _maxSupply, uint256 _martgageRate, address _lendToken, address _borrowToken, address _spToken, address _jpToken, uint256 _autoLiquidateThreshold) returns()
+func (_PledgePool *PledgePoolTransactorSession) CreatePoolInfo(_settleTime *big.Int, _endTime *big.Int, _interestRate uint64, _maxSupply *big.Int, _martgageRate *big.Int, _lendToken common.Address, _borrowToken common.Address, _spToken common.Address, _jpToken common.Address, _autoLiquidateThreshold *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.CreatePoolInfo(&_PledgePool.TransactOpts, _settleTime, _endTime, _interestRate, _maxSupply, _martgageRate, _lendToken, _borrowToken, _spToken, _jpToken, _autoLiquidateThreshold)
+}
+
+// DepositBorrow is a paid mutator transaction binding the contract method 0x16f941b5.
+//
+// Solidity: function depositBorrow(uint256 _pid, uint256 _stakeAmount) payable returns()
+func (_PledgePool *PledgePoolTransactor) DepositBorrow(opts *bind.TransactOpts, _pid *big.Int, _stakeAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "depositBorrow", _pid, _stakeAmount)
+}
+
+// DepositBorrow is a paid mutator transaction binding the contract method 0x16f941b5.
+//
+// Solidity: function depositBorrow(uint256 _pid, uint256 _stakeAmount) payable returns()
+func (_PledgePool *PledgePoolSession) DepositBorrow(_pid *big.Int, _stakeAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.DepositBorrow(&_PledgePool.TransactOpts, _pid, _stakeAmount)
+}
+
+// DepositBorrow is a paid mutator transaction binding the contract method 0x16f941b5.
+//
+// Solidity: function depositBorrow(uint256 _pid, uint256 _stakeAmount) payable returns()
+func (_PledgePool *PledgePoolTransactorSession) DepositBorrow(_pid *big.Int, _stakeAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.DepositBorrow(&_PledgePool.TransactOpts, _pid, _stakeAmount)
+}
+
+// DepositLend is a paid mutator transaction binding the contract method 0x90590da0.
+//
+// Solidity: function depositLend(uint256 _pid, uint256 _stakeAmount) payable returns()
+func (_PledgePool *PledgePoolTransactor) DepositLend(opts *bind.TransactOpts, _pid *big.Int, _stakeAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "depositLend", _pid, _stakeAmount)
+}
+
+// DepositLend is a paid mutator transaction binding the contract method 0x90590da0.
+//
+// Solidity: function depositLend(uint256 _pid, uint256 _stakeAmount) payable returns()
+func (_PledgePool *PledgePoolSession) DepositLend(_pid *big.Int, _stakeAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.DepositLend(&_PledgePool.TransactOpts, _pid, _stakeAmount)
+}
+
+// DepositLend is a paid mutator transaction binding the contract method 0x90590da0.
+//
+// Solidity: function depositLend(uint256 _pid, uint256 _stakeAmount) payable returns()
+func (_PledgePool *PledgePoolTransactorSession) DepositLend(_pid *big.Int, _stakeAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.DepositLend(&_PledgePool.TransactOpts, _pid, _stakeAmount)
+}
+
+// EmergencyBorrowWithdrawal is a paid mutator transaction binding the contract method 0xe271fa0c.
+//
+// Solidity: function emergencyBorrowWithdrawal(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactor) EmergencyBorrowWithdrawal(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "emergencyBorrowWithdrawal", _pid)
+}
+
+// EmergencyBorrowWithdrawal is a paid mutator transaction binding the contract method 0xe271fa0c.
+//
+// Solidity: function emergencyBorrowWithdrawal(uint256 _pid) returns()
+func (_PledgePool *PledgePoolSession) EmergencyBorrowWithdrawal(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.EmergencyBorrowWithdrawal(&_PledgePool.TransactOpts, _pid)
+}
+
+// EmergencyBorrowWithdrawal is a paid mutator transaction binding the contract method 0xe271fa0c.
+//
+// Solidity: function emergencyBorrowWithdrawal(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactorSession) EmergencyBorrowWithdrawal(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.EmergencyBorrowWithdrawal(&_PledgePool.TransactOpts, _pid)
+}
+
+// EmergencyLendWithdrawal is a paid mutator transaction binding the contract method 0xbf38b8f6.
+//
+// Solidity: function emergencyLendWithdrawal(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactor) EmergencyLendWithdrawal(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "emergencyLendWithdrawal", _pid)
+}
+
+// EmergencyLendWithdrawal is a paid mutator transaction binding the contract method 0xbf38b8f6.
+//
+// Solidity: function emergencyLendWithdrawal(uint256 _pid) returns()
+func (_PledgePool *PledgePoolSession) EmergencyLendWithdrawal(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.EmergencyLendWithdrawal(&_PledgePool.TransactOpts, _pid)
+}
+
+// EmergencyLendWithdrawal is a paid mutator transaction binding the contract method 0xbf38b8f6.
+//
+// Solidity: function emergencyLendWithdrawal(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactorSession) EmergencyLendWithdrawal(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.EmergencyLendWithdrawal(&_PledgePool.TransactOpts, _pid)
+}
+
+// Finish is a paid mutator transaction binding the contract method 0xd353a1cb.
+//
+// Solidity: function finish(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactor) Finish(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "finish", _pid)
+}
+
+// Finish is a paid mutator transaction binding the contract method 0xd353a1cb.
+//
+// Solidity: function finish(uint256 _pid) returns()
+func (_PledgePool *PledgePoolSession) Finish(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.Finish(&_PledgePool.TransactOpts, _pid)
+}
+
+// Finish is a paid mutator transaction binding the contract method 0xd353a1cb.
+//
+// Solidity: function finish(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactorSession) Finish(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.Finish(&_PledgePool.TransactOpts, _pid)
+}
+
+// Liquidate is a paid mutator transaction binding the contract method 0x415f1240.
+//
+// Solidity: function liquidate(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactor) Liquidate(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "liquidate", _pid)
+}
+
+// Liquidate is a paid mutator transaction binding the contract method 0x415f1240.
+//
+// Solidity: function liquidate(uint256 _pid) returns()
+func (_PledgePool *PledgePoolSession) Liquidate(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.Liquidate(&_PledgePool.TransactOpts, _pid)
+}
+
+// Liquidate is a paid mutator transaction binding the contract method 0x415f1240.
+//
+// Solidity: function liquidate(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactorSession) Liquidate(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.Liquidate(&_PledgePool.TransactOpts, _pid)
+}
+
+// RefundBorrow is a paid mutator transaction binding the contract method 0xa62ff164.
+//
+// Solidity: function refundBorrow(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactor) RefundBorrow(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "refundBorrow", _pid)
+}
+
+// RefundBorrow is a paid mutator transaction binding the contract method 0xa62ff164.
+//
+// Solidity: function refundBorrow(uint256 _pid) returns()
+func (_PledgePool *PledgePoolSession) RefundBorrow(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.RefundBorrow(&_PledgePool.TransactOpts, _pid)
+}
+
+// RefundBorrow is a paid mutator transaction binding the contract method 0xa62ff164.
+//
+// Solidity: function refundBorrow(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactorSession) RefundBorrow(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.RefundBorrow(&_PledgePool.TransactOpts, _pid)
+}
+
+// RefundLend is a paid mutator transaction binding the contract method 0xeec8d506.
+//
+// Solidity: function refundLend(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactor) RefundLend(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "refundLend", _pid)
+}
+
+// RefundLend is a paid mutator transaction binding the contract method 0xeec8d506.
+//
+// Solidity: function refundLend(uint256 _pid) returns()
+func (_PledgePool *PledgePoolSession) RefundLend(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.RefundLend(&_PledgePool.TransactOpts, _pid)
+}
+
+// RefundLend is a paid mutator transaction binding the contract method 0xeec8d506.
+//
+// Solidity: function refundLend(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactorSession) RefundLend(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.RefundLend(&_PledgePool.TransactOpts, _pid)
+}
+
+// SetFee is a paid mutator transaction binding the contract method 0x52f7c988.
+//
+// Solidity: function setFee(uint256 _lendFee, uint256 _borrowFee) returns()
+func (_PledgePool *PledgePoolTransactor) SetFee(opts *bind.TransactOpts, _lendFee *big.Int, _borrowFee *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "setFee", _lendFee, _borrowFee)
+}
+
+// SetFee is a paid mutator transaction binding the contract method 0x52f7c988.
+//
+// Solidity: function setFee(uint256 _lendFee, uint256 _borrowFee) returns()
+func (_PledgePool *PledgePoolSession) SetFee(_lendFee *big.Int, _borrowFee *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.SetFee(&_PledgePool.TransactOpts, _lendFee, _borrowFee)
+}
+
+// SetFee is a paid mutator transaction binding the contract method 0x52f7c988.
+//
+// Solidity: function setFee(uint256 _lendFee, uint256 _borrowFee) returns()
+func (_PledgePool *PledgePoolTransactorSession) SetFee(_lendFee *big.Int, _borrowFee *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.SetFee(&_PledgePool.TransactOpts, _lendFee, _borrowFee)
+}
+
+// SetFeeAddress is a paid mutator transaction binding the contract method 0x8705fcd4.
+//
+// Solidity: function setFeeAddress(address _feeAddress) returns()
+func (_PledgePool *PledgePoolTransactor) SetFeeAddress(opts *bind.TransactOpts, _feeAddress common.Address) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "setFeeAddress", _feeAddress)
+}
+
+// SetFeeAddress is a paid mutator transaction binding the contract method 0x8705fcd4.
+//
+// Solidity: function setFeeAddress(address _feeAddress) returns()
+func (_PledgePool *PledgePoolSession) SetFeeAddress(_feeAddress common.Address) (*types.Transaction, error) {
+	return _PledgePool.Contract.SetFeeAddress(&_PledgePool.TransactOpts, _feeAddress)
+}
+
+// SetFeeAddress is a paid mutator transaction binding the contract method 0x8705fcd4.
+//
+// Solidity: function setFeeAddress(address _feeAddress) returns()
+func (_PledgePool *PledgePoolTransactorSession) SetFeeAddress(_feeAddress common.Address) (*types.Transaction, error) {
+	return _PledgePool.Contract.SetFeeAddress(&_PledgePool.TransactOpts, _feeAddress)
+}
+
+// SetMinAmount is a paid mutator transaction binding the contract method 0x897b0637.
+//
+// Solidity: function setMinAmount(uint256 _minAmount) returns()
+func (_PledgePool *PledgePoolTransactor) SetMinAmount(opts *bind.TransactOpts, _minAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "setMinAmount", _minAmount)
+}
+
+// SetMinAmount is a paid mutator transaction binding the contract method 0x897b0637.
+//
+// Solidity: function setMinAmount(uint256 _minAmount) returns()
+func (_PledgePool *PledgePoolSession) SetMinAmount(_minAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.SetMinAmount(&_PledgePool.TransactOpts, _minAmount)
+}
+
+// SetMinAmount is a paid mutator transaction binding the contract method 0x897b0637.
+//
+// Solidity: function setMinAmount(uint256 _minAmount) returns()
+func (_PledgePool *PledgePoolTransactorSession) SetMinAmount(_minAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.SetMinAmount(&_PledgePool.TransactOpts, _minAmount)
+}
+
+// SetPause is a paid mutator transaction binding the contract method 0xd431b1ac.
+//
+// Solidity: function setPause() returns()
+func (_PledgePool *PledgePoolTransactor) SetPause(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "setPause")
+}
+
+// SetPause is a paid mutator transaction binding the contract method 0xd431b1ac.
+//
+// Solidity: function setPause() returns()
+func (_PledgePool *PledgePoolSession) SetPause() (*types.Transaction, error) {
+	return _PledgePool.Contract.SetPause(&_PledgePool.TransactOpts)
+}
+
+// SetPause is a paid mutator transaction binding the contract method 0xd431b1ac.
+//
+// Solidity: function setPause() returns()
+func (_PledgePool *PledgePoolTransactorSession) SetPause() (*types.Transaction, error) {
+	return _PledgePool.Contract.SetPause(&_PledgePool.TransactOpts)
+}
+
+// SetSwapRouterAddress is a paid mutator transaction binding the contract method 0x5249961b.
+//
+// Solidity: function setSwapRouterAddress(address _swapRouter) returns()
+func (_PledgePool *PledgePoolTransactor) SetSwapRouterAddress(opts *bind.TransactOpts, _swapRouter common.Address) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "setSwapRouterAddress", _swapRouter)
+}
+
+// SetSwapRouterAddress is a paid mutator transaction binding the contract method 0x5249961b.
+//
+// Solidity: function setSwapRouterAddress(address _swapRouter) returns()
+func (_PledgePool *PledgePoolSession) SetSwapRouterAddress(_swapRouter common.Address) (*types.Transaction, error) {
+	return _PledgePool.Contract.SetSwapRouterAddress(&_PledgePool.TransactOpts, _swapRouter)
+}
+
+// SetSwapRouterAddress is a paid mutator transaction binding the contract method 0x5249961b.
+//
+// Solidity: function setSwapRouterAddress(address _swapRouter) returns()
+func (_PledgePool *PledgePoolTransactorSession) SetSwapRouterAddress(_swapRouter common.Address) (*types.Transaction, error) {
+	return _PledgePool.Contract.SetSwapRouterAddress(&_PledgePool.TransactOpts, _swapRouter)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x8df82800.
+//
+// Solidity: function settle(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactor) Settle(opts *bind.TransactOpts, _pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "settle", _pid)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x8df82800.
+//
+// Solidity: function settle(uint256 _pid) returns()
+func (_PledgePool *PledgePoolSession) Settle(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.Settle(&_PledgePool.TransactOpts, _pid)
+}
+
+// Settle is a paid mutator transaction binding the contract method 0x8df82800.
+//
+// Solidity: function settle(uint256 _pid) returns()
+func (_PledgePool *PledgePoolTransactorSession) Settle(_pid *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.Settle(&_PledgePool.TransactOpts, _pid)
+}
+
+// WithdrawBorrow is a paid mutator transaction binding the contract method 0x1e107979.
+//
+// Solidity: function withdrawBorrow(uint256 _pid, uint256 _jpAmount) returns()
+func (_PledgePool *PledgePoolTransactor) WithdrawBorrow(opts *bind.TransactOpts, _pid *big.Int, _jpAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "withdrawBorrow", _pid, _jpAmount)
+}
+
+// WithdrawBorrow is a paid mutator transaction binding the contract method 0x1e107979.
+//
+// Solidity: function withdrawBorrow(uint256 _pid, uint256 _jpAmount) returns()
+func (_PledgePool *PledgePoolSession) WithdrawBorrow(_pid *big.Int, _jpAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.WithdrawBorrow(&_PledgePool.TransactOpts, _pid, _jpAmount)
+}
+
+// WithdrawBorrow is a paid mutator transaction binding the contract method 0x1e107979.
+//
+// Solidity: function withdrawBorrow(uint256 _pid, uint256 _jpAmount) returns()
+func (_PledgePool *PledgePoolTransactorSession) WithdrawBorrow(_pid *big.Int, _jpAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.WithdrawBorrow(&_PledgePool.TransactOpts, _pid, _jpAmount)
+}
+
+// WithdrawLend is a paid mutator transaction binding the contract method 0x38f2aa76.
+//
+// Solidity: function withdrawLend(uint256 _pid, uint256 _spAmount) returns()
+func (_PledgePool *PledgePoolTransactor) WithdrawLend(opts *bind.TransactOpts, _pid *big.Int, _spAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.contract.Transact(opts, "withdrawLend", _pid, _spAmount)
+}
+
+// WithdrawLend is a paid mutator transaction binding the contract method 0x38f2aa76.
+//
+// Solidity: function withdrawLend(uint256 _pid, uint256 _spAmount) returns()
+func (_PledgePool *PledgePoolSession) WithdrawLend(_pid *big.Int, _spAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.WithdrawLend(&_PledgePool.TransactOpts, _pid, _spAmount)
+}
+
+// WithdrawLend is a paid mutator transaction binding the contract method 0x38f2aa76.
+//
+// Solidity: function withdrawLend(uint256 _pid, uint256 _spAmount) returns()
+func (_PledgePool *PledgePoolTransactorSession) WithdrawLend(_pid *big.Int, _spAmount *big.Int) (*types.Transaction, error) {
+	return _PledgePool.Contract.WithdrawLend(&_PledgePool.TransactOpts, _pid, _spAmount)
+}
+
+// PledgePoolClaimBorrowIterator is returned from FilterClaimBorrow and is used to iterate over the raw logs and unpacked data for ClaimBorrow events raised by the PledgePool contract.
+type PledgePoolClaimBorrowIterator struct {
+	Event *PledgePoolClaimBorrow // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolClaimBorrowIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolClaimBorrow)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolClaimBorrow)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolClaimBorrowIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolClaimBorrowIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolClaimBorrow represents a ClaimBorrow event raised by the PledgePool contract.
+type PledgePoolClaimBorrow struct {
+	From   common.Address
+	Token  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterClaimBorrow is a free log retrieval operation binding the contract event 0x3ddafe3ebb4d0c818317027aabfa82dc9983942ceeb80523167e2de047b17fbd.
+//
+// Solidity: event ClaimBorrow(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) FilterClaimBorrow(opts *bind.FilterOpts, from []common.Address, token []common.Address) (*PledgePoolClaimBorrowIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "ClaimBorrow", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolClaimBorrowIterator{contract: _PledgePool.contract, event: "ClaimBorrow", logs: logs, sub: sub}, nil
+}
+
+// WatchClaimBorrow is a free log subscription operation binding the contract event 0x3ddafe3ebb4d0c818317027aabfa82dc9983942ceeb80523167e2de047b17fbd.
+//
+// Solidity: event ClaimBorrow(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) WatchClaimBorrow(opts *bind.WatchOpts, sink chan<- *PledgePoolClaimBorrow, from []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "ClaimBorrow", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolClaimBorrow)
+				if err := _PledgePool.contract.UnpackLog(event, "ClaimBorrow", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseClaimBorrow is a log parse operation binding the contract event 0x3ddafe3ebb4d0c818317027aabfa82dc9983942ceeb80523167e2de047b17fbd.
+//
+// Solidity: event ClaimBorrow(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) ParseClaimBorrow(log types.Log) (*PledgePoolClaimBorrow, error) {
+	event := new(PledgePoolClaimBorrow)
+	if err := _PledgePool.contract.UnpackLog(event, "ClaimBorrow", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolClaimLendIterator is returned from FilterClaimLend and is used to iterate over the raw logs and unpacked data for ClaimLend events raised by the PledgePool contract.
+type PledgePoolClaimLendIterator struct {
+	Event *PledgePoolClaimLend // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolClaimLendIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolClaimLend)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolClaimLend)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolClaimLendIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolClaimLendIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolClaimLend represents a ClaimLend event raised by the PledgePool contract.
+type PledgePoolClaimLend struct {
+	From   common.Address
+	Token  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterClaimLend is a free log retrieval operation binding the contract event 0x6f4dd2687b3c3bfa99d39742b01d6e0ad9604c48559791d5df4ff5df44b41dfd.
+//
+// Solidity: event ClaimLend(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) FilterClaimLend(opts *bind.FilterOpts, from []common.Address, token []common.Address) (*PledgePoolClaimLendIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "ClaimLend", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolClaimLendIterator{contract: _PledgePool.contract, event: "ClaimLend", logs: logs, sub: sub}, nil
+}
+
+// WatchClaimLend is a free log subscription operation binding the contract event 0x6f4dd2687b3c3bfa99d39742b01d6e0ad9604c48559791d5df4ff5df44b41dfd.
+//
+// Solidity: event ClaimLend(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) WatchClaimLend(opts *bind.WatchOpts, sink chan<- *PledgePoolClaimLend, from []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "ClaimLend", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolClaimLend)
+				if err := _PledgePool.contract.UnpackLog(event, "ClaimLend", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseClaimLend is a log parse operation binding the contract event 0x6f4dd2687b3c3bfa99d39742b01d6e0ad9604c48559791d5df4ff5df44b41dfd.
+//
+// Solidity: event ClaimLend(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) ParseClaimLend(log types.Log) (*PledgePoolClaimLend, error) {
+	event := new(PledgePoolClaimLend)
+	if err := _PledgePool.contract.UnpackLog(event, "ClaimLend", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolDepositBorrowIterator is returned from FilterDepositBorrow and is used to iterate over the raw logs and unpacked data for DepositBorrow events raised by the PledgePool contract.
+type PledgePoolDepositBorrowIterator struct {
+	Event *PledgePoolDepositBorrow // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolDepositBorrowIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolDepositBorrow)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolDepositBorrow)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolDepositBorrowIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolDepositBorrowIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolDepositBorrow represents a DepositBorrow event raised by the PledgePool contract.
+type PledgePoolDepositBorrow struct {
+	From       common.Address
+	Token      common.Address
+	Amount     *big.Int
+	MintAmount *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterDepositBorrow is a free log retrieval operation binding the contract event 0x1d7b72e666a0b6217efe7cfa1b604ea5c7b39219563ce48b30c9da77045247a5.
+//
+// Solidity: event DepositBorrow(address indexed from, address indexed token, uint256 amount, uint256 mintAmount)
+func (_PledgePool *PledgePoolFilterer) FilterDepositBorrow(opts *bind.FilterOpts, from []common.Address, token []common.Address) (*PledgePoolDepositBorrowIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "DepositBorrow", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolDepositBorrowIterator{contract: _PledgePool.contract, event: "DepositBorrow", logs: logs, sub: sub}, nil
+}
+
+// WatchDepositBorrow is a free log subscription operation binding the contract event 0x1d7b72e666a0b6217efe7cfa1b604ea5c7b39219563ce48b30c9da77045247a5.
+//
+// Solidity: event DepositBorrow(address indexed from, address indexed token, uint256 amount, uint256 mintAmount)
+func (_PledgePool *PledgePoolFilterer) WatchDepositBorrow(opts *bind.WatchOpts, sink chan<- *PledgePoolDepositBorrow, from []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "DepositBorrow", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolDepositBorrow)
+				if err := _PledgePool.contract.UnpackLog(event, "DepositBorrow", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDepositBorrow is a log parse operation binding the contract event 0x1d7b72e666a0b6217efe7cfa1b604ea5c7b39219563ce48b30c9da77045247a5.
+//
+// Solidity: event DepositBorrow(address indexed from, address indexed token, uint256 amount, uint256 mintAmount)
+func (_PledgePool *PledgePoolFilterer) ParseDepositBorrow(log types.Log) (*PledgePoolDepositBorrow, error) {
+	event := new(PledgePoolDepositBorrow)
+	if err := _PledgePool.contract.UnpackLog(event, "DepositBorrow", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolDepositLendIterator is returned from FilterDepositLend and is used to iterate over the raw logs and unpacked data for DepositLend events raised by the PledgePool contract.
+type PledgePoolDepositLendIterator struct {
+	Event *PledgePoolDepositLend // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolDepositLendIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolDepositLend)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolDepositLend)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolDepositLendIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolDepositLendIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolDepositLend represents a DepositLend event raised by the PledgePool contract.
+type PledgePoolDepositLend struct {
+	From       common.Address
+	Token      common.Address
+	Amount     *big.Int
+	MintAmount *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterDepositLend is a free log retrieval operation binding the contract event 0x129e8c18c2f7baf99c7eb257934c21f038c72412803512dcf0a942a4562a82ea.
+//
+// Solidity: event DepositLend(address indexed from, address indexed token, uint256 amount, uint256 mintAmount)
+func (_PledgePool *PledgePoolFilterer) FilterDepositLend(opts *bind.FilterOpts, from []common.Address, token []common.Address) (*PledgePoolDepositLendIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "DepositLend", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolDepositLendIterator{contract: _PledgePool.contract, event: "DepositLend", logs: logs, sub: sub}, nil
+}
+
+// WatchDepositLend is a free log subscription operation binding the contract event 0x129e8c18c2f7baf99c7eb257934c21f038c72412803512dcf0a942a4562a82ea.
+//
+// Solidity: event DepositLend(address indexed from, address indexed token, uint256 amount, uint256 mintAmount)
+func (_PledgePool *PledgePoolFilterer) WatchDepositLend(opts *bind.WatchOpts, sink chan<- *PledgePoolDepositLend, from []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "DepositLend", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolDepositLend)
+				if err := _PledgePool.contract.UnpackLog(event, "DepositLend", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDepositLend is a log parse operation binding the contract event 0x129e8c18c2f7baf99c7eb257934c21f038c72412803512dcf0a942a4562a82ea.
+//
+// Solidity: event DepositLend(address indexed from, address indexed token, uint256 amount, uint256 mintAmount)
+func (_PledgePool *PledgePoolFilterer) ParseDepositLend(log types.Log) (*PledgePoolDepositLend, error) {
+	event := new(PledgePoolDepositLend)
+	if err := _PledgePool.contract.UnpackLog(event, "DepositLend", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolEmergencyBorrowWithdrawalIterator is returned from FilterEmergencyBorrowWithdrawal and is used to iterate over the raw logs and unpacked data for EmergencyBorrowWithdrawal events raised by the PledgePool contract.
+type PledgePoolEmergencyBorrowWithdrawalIterator struct {
+	Event *PledgePoolEmergencyBorrowWithdrawal // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolEmergencyBorrowWithdrawalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolEmergencyBorrowWithdrawal)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolEmergencyBorrowWithdrawal)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolEmergencyBorrowWithdrawalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolEmergencyBorrowWithdrawalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolEmergencyBorrowWithdrawal represents a EmergencyBorrowWithdrawal event raised by the PledgePool contract.
+type PledgePoolEmergencyBorrowWithdrawal struct {
+	From   common.Address
+	Token  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterEmergencyBorrowWithdrawal is a free log retrieval operation binding the contract event 0x5a06c7de92f1dc59e8cba872927d016c80ce5f0fb2295c898dfb7a2f08e43fb1.
+//
+// Solidity: event EmergencyBorrowWithdrawal(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) FilterEmergencyBorrowWithdrawal(opts *bind.FilterOpts, from []common.Address, token []common.Address) (*PledgePoolEmergencyBorrowWithdrawalIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "EmergencyBorrowWithdrawal", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolEmergencyBorrowWithdrawalIterator{contract: _PledgePool.contract, event: "EmergencyBorrowWithdrawal", logs: logs, sub: sub}, nil
+}
+
+// WatchEmergencyBorrowWithdrawal is a free log subscription operation binding the contract event 0x5a06c7de92f1dc59e8cba872927d016c80ce5f0fb2295c898dfb7a2f08e43fb1.
+//
+// Solidity: event EmergencyBorrowWithdrawal(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) WatchEmergencyBorrowWithdrawal(opts *bind.WatchOpts, sink chan<- *PledgePoolEmergencyBorrowWithdrawal, from []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "EmergencyBorrowWithdrawal", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolEmergencyBorrowWithdrawal)
+				if err := _PledgePool.contract.UnpackLog(event, "EmergencyBorrowWithdrawal", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEmergencyBorrowWithdrawal is a log parse operation binding the contract event 0x5a06c7de92f1dc59e8cba872927d016c80ce5f0fb2295c898dfb7a2f08e43fb1.
+//
+// Solidity: event EmergencyBorrowWithdrawal(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) ParseEmergencyBorrowWithdrawal(log types.Log) (*PledgePoolEmergencyBorrowWithdrawal, error) {
+	event := new(PledgePoolEmergencyBorrowWithdrawal)
+	if err := _PledgePool.contract.UnpackLog(event, "EmergencyBorrowWithdrawal", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolEmergencyLendWithdrawalIterator is returned from FilterEmergencyLendWithdrawal and is used to iterate over the raw logs and unpacked data for EmergencyLendWithdrawal events raised by the PledgePool contract.
+type PledgePoolEmergencyLendWithdrawalIterator struct {
+	Event *PledgePoolEmergencyLendWithdrawal // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolEmergencyLendWithdrawalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolEmergencyLendWithdrawal)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolEmergencyLendWithdrawal)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolEmergencyLendWithdrawalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolEmergencyLendWithdrawalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolEmergencyLendWithdrawal represents a EmergencyLendWithdrawal event raised by the PledgePool contract.
+type PledgePoolEmergencyLendWithdrawal struct {
+	From   common.Address
+	Token  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterEmergencyLendWithdrawal is a free log retrieval operation binding the contract event 0x71d14c5f08cb34cbfb59c06ea5151aafbf742d0b6ed00fdb83addd9afb5c0fd0.
+//
+// Solidity: event EmergencyLendWithdrawal(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) FilterEmergencyLendWithdrawal(opts *bind.FilterOpts, from []common.Address, token []common.Address) (*PledgePoolEmergencyLendWithdrawalIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "EmergencyLendWithdrawal", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolEmergencyLendWithdrawalIterator{contract: _PledgePool.contract, event: "EmergencyLendWithdrawal", logs: logs, sub: sub}, nil
+}
+
+// WatchEmergencyLendWithdrawal is a free log subscription operation binding the contract event 0x71d14c5f08cb34cbfb59c06ea5151aafbf742d0b6ed00fdb83addd9afb5c0fd0.
+//
+// Solidity: event EmergencyLendWithdrawal(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) WatchEmergencyLendWithdrawal(opts *bind.WatchOpts, sink chan<- *PledgePoolEmergencyLendWithdrawal, from []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "EmergencyLendWithdrawal", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolEmergencyLendWithdrawal)
+				if err := _PledgePool.contract.UnpackLog(event, "EmergencyLendWithdrawal", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEmergencyLendWithdrawal is a log parse operation binding the contract event 0x71d14c5f08cb34cbfb59c06ea5151aafbf742d0b6ed00fdb83addd9afb5c0fd0.
+//
+// Solidity: event EmergencyLendWithdrawal(address indexed from, address indexed token, uint256 amount)
+func (_PledgePool *PledgePoolFilterer) ParseEmergencyLendWithdrawal(log types.Log) (*PledgePoolEmergencyLendWithdrawal, error) {
+	event := new(PledgePoolEmergencyLendWithdrawal)
+	if err := _PledgePool.contract.UnpackLog(event, "EmergencyLendWithdrawal", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolRefundBorrowIterator is returned from FilterRefundBorrow and is used to iterate over the raw logs and unpacked data for RefundBorrow events raised by the PledgePool contract.
+type PledgePoolRefundBorrowIterator struct {
+	Event *PledgePoolRefundBorrow // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolRefundBorrowIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolRefundBorrow)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolRefundBorrow)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolRefundBorrowIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolRefundBorrowIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolRefundBorrow represents a RefundBorrow event raised by the PledgePool contract.
+type PledgePoolRefundBorrow struct {
+	From   common.Address
+	Token  common.Address
+	Refund *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterRefundBorrow is a free log retrieval operation binding the contract event 0x732816f48de550f238bd0d4f5b79819c7b24a49d6132928978e3cd36568dd5db.
+//
+// Solidity: event RefundBorrow(address indexed from, address indexed token, uint256 refund)
+func (_PledgePool *PledgePoolFilterer) FilterRefundBorrow(opts *bind.FilterOpts, from []common.Address, token []common.Address) (*PledgePoolRefundBorrowIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "RefundBorrow", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolRefundBorrowIterator{contract: _PledgePool.contract, event: "RefundBorrow", logs: logs, sub: sub}, nil
+}
+
+// WatchRefundBorrow is a free log subscription operation binding the contract event 0x732816f48de550f238bd0d4f5b79819c7b24a49d6132928978e3cd36568dd5db.
+//
+// Solidity: event RefundBorrow(address indexed from, address indexed token, uint256 refund)
+func (_PledgePool *PledgePoolFilterer) WatchRefundBorrow(opts *bind.WatchOpts, sink chan<- *PledgePoolRefundBorrow, from []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "RefundBorrow", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolRefundBorrow)
+				if err := _PledgePool.contract.UnpackLog(event, "RefundBorrow", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRefundBorrow is a log parse operation binding the contract event 0x732816f48de550f238bd0d4f5b79819c7b24a49d6132928978e3cd36568dd5db.
+//
+// Solidity: event RefundBorrow(address indexed from, address indexed token, uint256 refund)
+func (_PledgePool *PledgePoolFilterer) ParseRefundBorrow(log types.Log) (*PledgePoolRefundBorrow, error) {
+	event := new(PledgePoolRefundBorrow)
+	if err := _PledgePool.contract.UnpackLog(event, "RefundBorrow", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolRefundLendIterator is returned from FilterRefundLend and is used to iterate over the raw logs and unpacked data for RefundLend events raised by the PledgePool contract.
+type PledgePoolRefundLendIterator struct {
+	Event *PledgePoolRefundLend // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolRefundLendIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolRefundLend)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolRefundLend)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolRefundLendIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolRefundLendIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolRefundLend represents a RefundLend event raised by the PledgePool contract.
+type PledgePoolRefundLend struct {
+	From   common.Address
+	Token  common.Address
+	Refund *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterRefundLend is a free log retrieval operation binding the contract event 0xc3e20279d41b3ed21d277920877e5e5c6665bf6aca607046a3fe0fd2bd6bda7d.
+//
+// Solidity: event RefundLend(address indexed from, address indexed token, uint256 refund)
+func (_PledgePool *PledgePoolFilterer) FilterRefundLend(opts *bind.FilterOpts, from []common.Address, token []common.Address) (*PledgePoolRefundLendIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "RefundLend", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolRefundLendIterator{contract: _PledgePool.contract, event: "RefundLend", logs: logs, sub: sub}, nil
+}
+
+// WatchRefundLend is a free log subscription operation binding the contract event 0xc3e20279d41b3ed21d277920877e5e5c6665bf6aca607046a3fe0fd2bd6bda7d.
+//
+// Solidity: event RefundLend(address indexed from, address indexed token, uint256 refund)
+func (_PledgePool *PledgePoolFilterer) WatchRefundLend(opts *bind.WatchOpts, sink chan<- *PledgePoolRefundLend, from []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "RefundLend", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolRefundLend)
+				if err := _PledgePool.contract.UnpackLog(event, "RefundLend", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRefundLend is a log parse operation binding the contract event 0xc3e20279d41b3ed21d277920877e5e5c6665bf6aca607046a3fe0fd2bd6bda7d.
+//
+// Solidity: event RefundLend(address indexed from, address indexed token, uint256 refund)
+func (_PledgePool *PledgePoolFilterer) ParseRefundLend(log types.Log) (*PledgePoolRefundLend, error) {
+	event := new(PledgePoolRefundLend)
+	if err := _PledgePool.contract.UnpackLog(event, "RefundLend", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolSetFeeIterator is returned from FilterSetFee and is used to iterate over the raw logs and unpacked data for SetFee events raised by the PledgePool contract.
+type PledgePoolSetFeeIterator struct {
+	Event *PledgePoolSetFee // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolSetFeeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolSetFee)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolSetFee)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolSetFeeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolSetFeeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolSetFee represents a SetFee event raised by the PledgePool contract.
+type PledgePoolSetFee struct {
+	NewLendFee   *big.Int
+	NewBorrowFee *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetFee is a free log retrieval operation binding the contract event 0x032dc6a2d839eb179729a55633fdf1c41a1fc4739394154117005db2b354b9b5.
+//
+// Solidity: event SetFee(uint256 indexed newLendFee, uint256 indexed newBorrowFee)
+func (_PledgePool *PledgePoolFilterer) FilterSetFee(opts *bind.FilterOpts, newLendFee []*big.Int, newBorrowFee []*big.Int) (*PledgePoolSetFeeIterator, error) {
+
+	var newLendFeeRule []interface{}
+	for _, newLendFeeItem := range newLendFee {
+		newLendFeeRule = append(newLendFeeRule, newLendFeeItem)
+	}
+	var newBorrowFeeRule []interface{}
+	for _, newBorrowFeeItem := range newBorrowFee {
+		newBorrowFeeRule = append(newBorrowFeeRule, newBorrowFeeItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "SetFee", newLendFeeRule, newBorrowFeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolSetFeeIterator{contract: _PledgePool.contract, event: "SetFee", logs: logs, sub: sub}, nil
+}
+
+// WatchSetFee is a free log subscription operation binding the contract event 0x032dc6a2d839eb179729a55633fdf1c41a1fc4739394154117005db2b354b9b5.
+//
+// Solidity: event SetFee(uint256 indexed newLendFee, uint256 indexed newBorrowFee)
+func (_PledgePool *PledgePoolFilterer) WatchSetFee(opts *bind.WatchOpts, sink chan<- *PledgePoolSetFee, newLendFee []*big.Int, newBorrowFee []*big.Int) (event.Subscription, error) {
+
+	var newLendFeeRule []interface{}
+	for _, newLendFeeItem := range newLendFee {
+		newLendFeeRule = append(newLendFeeRule, newLendFeeItem)
+	}
+	var newBorrowFeeRule []interface{}
+	for _, newBorrowFeeItem := range newBorrowFee {
+		newBorrowFeeRule = append(newBorrowFeeRule, newBorrowFeeItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "SetFee", newLendFeeRule, newBorrowFeeRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolSetFee)
+				if err := _PledgePool.contract.UnpackLog(event, "SetFee", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetFee is a log parse operation binding the contract event 0x032dc6a2d839eb179729a55633fdf1c41a1fc4739394154117005db2b354b9b5.
+//
+// Solidity: event SetFee(uint256 indexed newLendFee, uint256 indexed newBorrowFee)
+func (_PledgePool *PledgePoolFilterer) ParseSetFee(log types.Log) (*PledgePoolSetFee, error) {
+	event := new(PledgePoolSetFee)
+	if err := _PledgePool.contract.UnpackLog(event, "SetFee", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolSetFeeAddressIterator is returned from FilterSetFeeAddress and is used to iterate over the raw logs and unpacked data for SetFeeAddress events raised by the PledgePool contract.
+type PledgePoolSetFeeAddressIterator struct {
+	Event *PledgePoolSetFeeAddress // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolSetFeeAddressIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolSetFeeAddress)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolSetFeeAddress)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolSetFeeAddressIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolSetFeeAddressIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolSetFeeAddress represents a SetFeeAddress event raised by the PledgePool contract.
+type PledgePoolSetFeeAddress struct {
+	OldFeeAddress common.Address
+	NewFeeAddress common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetFeeAddress is a free log retrieval operation binding the contract event 0xd44190acf9d04bdb5d3a1aafff7e6dee8b40b93dfb8c5d3f0eea4b9f4539c3f7.
+//
+// Solidity: event SetFeeAddress(address indexed oldFeeAddress, address indexed newFeeAddress)
+func (_PledgePool *PledgePoolFilterer) FilterSetFeeAddress(opts *bind.FilterOpts, oldFeeAddress []common.Address, newFeeAddress []common.Address) (*PledgePoolSetFeeAddressIterator, error) {
+
+	var oldFeeAddressRule []interface{}
+	for _, oldFeeAddressItem := range oldFeeAddress {
+		oldFeeAddressRule = append(oldFeeAddressRule, oldFeeAddressItem)
+	}
+	var newFeeAddressRule []interface{}
+	for _, newFeeAddressItem := range newFeeAddress {
+		newFeeAddressRule = append(newFeeAddressRule, newFeeAddressItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "SetFeeAddress", oldFeeAddressRule, newFeeAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolSetFeeAddressIterator{contract: _PledgePool.contract, event: "SetFeeAddress", logs: logs, sub: sub}, nil
+}
+
+// WatchSetFeeAddress is a free log subscription operation binding the contract event 0xd44190acf9d04bdb5d3a1aafff7e6dee8b40b93dfb8c5d3f0eea4b9f4539c3f7.
+//
+// Solidity: event SetFeeAddress(address indexed oldFeeAddress, address indexed newFeeAddress)
+func (_PledgePool *PledgePoolFilterer) WatchSetFeeAddress(opts *bind.WatchOpts, sink chan<- *PledgePoolSetFeeAddress, oldFeeAddress []common.Address, newFeeAddress []common.Address) (event.Subscription, error) {
+
+	var oldFeeAddressRule []interface{}
+	for _, oldFeeAddressItem := range oldFeeAddress {
+		oldFeeAddressRule = append(oldFeeAddressRule, oldFeeAddressItem)
+	}
+	var newFeeAddressRule []interface{}
+	for _, newFeeAddressItem := range newFeeAddress {
+		newFeeAddressRule = append(newFeeAddressRule, newFeeAddressItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "SetFeeAddress", oldFeeAddressRule, newFeeAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolSetFeeAddress)
+				if err := _PledgePool.contract.UnpackLog(event, "SetFeeAddress", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetFeeAddress is a log parse operation binding the contract event 0xd44190acf9d04bdb5d3a1aafff7e6dee8b40b93dfb8c5d3f0eea4b9f4539c3f7.
+//
+// Solidity: event SetFeeAddress(address indexed oldFeeAddress, address indexed newFeeAddress)
+func (_PledgePool *PledgePoolFilterer) ParseSetFeeAddress(log types.Log) (*PledgePoolSetFeeAddress, error) {
+	event := new(PledgePoolSetFeeAddress)
+	if err := _PledgePool.contract.UnpackLog(event, "SetFeeAddress", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolSetMinAmountIterator is returned from FilterSetMinAmount and is used to iterate over the raw logs and unpacked data for SetMinAmount events raised by the PledgePool contract.
+type PledgePoolSetMinAmountIterator struct {
+	Event *PledgePoolSetMinAmount // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolSetMinAmountIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolSetMinAmount)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolSetMinAmount)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolSetMinAmountIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolSetMinAmountIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolSetMinAmount represents a SetMinAmount event raised by the PledgePool contract.
+type PledgePoolSetMinAmount struct {
+	OldMinAmount *big.Int
+	NewMinAmount *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetMinAmount is a free log retrieval operation binding the contract event 0xfa6189b739625142c695478e9d0095a1cb9e6fad92ad8a727e0055a5cc85b06b.
+//
+// Solidity: event SetMinAmount(uint256 indexed oldMinAmount, uint256 indexed newMinAmount)
+func (_PledgePool *PledgePoolFilterer) FilterSetMinAmount(opts *bind.FilterOpts, oldMinAmount []*big.Int, newMinAmount []*big.Int) (*PledgePoolSetMinAmountIterator, error) {
+
+	var oldMinAmountRule []interface{}
+	for _, oldMinAmountItem := range oldMinAmount {
+		oldMinAmountRule = append(oldMinAmountRule, oldMinAmountItem)
+	}
+	var newMinAmountRule []interface{}
+	for _, newMinAmountItem := range newMinAmount {
+		newMinAmountRule = append(newMinAmountRule, newMinAmountItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "SetMinAmount", oldMinAmountRule, newMinAmountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolSetMinAmountIterator{contract: _PledgePool.contract, event: "SetMinAmount", logs: logs, sub: sub}, nil
+}
+
+// WatchSetMinAmount is a free log subscription operation binding the contract event 0xfa6189b739625142c695478e9d0095a1cb9e6fad92ad8a727e0055a5cc85b06b.
+//
+// Solidity: event SetMinAmount(uint256 indexed oldMinAmount, uint256 indexed newMinAmount)
+func (_PledgePool *PledgePoolFilterer) WatchSetMinAmount(opts *bind.WatchOpts, sink chan<- *PledgePoolSetMinAmount, oldMinAmount []*big.Int, newMinAmount []*big.Int) (event.Subscription, error) {
+
+	var oldMinAmountRule []interface{}
+	for _, oldMinAmountItem := range oldMinAmount {
+		oldMinAmountRule = append(oldMinAmountRule, oldMinAmountItem)
+	}
+	var newMinAmountRule []interface{}
+	for _, newMinAmountItem := range newMinAmount {
+		newMinAmountRule = append(newMinAmountRule, newMinAmountItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "SetMinAmount", oldMinAmountRule, newMinAmountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolSetMinAmount)
+				if err := _PledgePool.contract.UnpackLog(event, "SetMinAmount", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetMinAmount is a log parse operation binding the contract event 0xfa6189b739625142c695478e9d0095a1cb9e6fad92ad8a727e0055a5cc85b06b.
+//
+// Solidity: event SetMinAmount(uint256 indexed oldMinAmount, uint256 indexed newMinAmount)
+func (_PledgePool *PledgePoolFilterer) ParseSetMinAmount(log types.Log) (*PledgePoolSetMinAmount, error) {
+	event := new(PledgePoolSetMinAmount)
+	if err := _PledgePool.contract.UnpackLog(event, "SetMinAmount", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolSetSwapRouterAddressIterator is returned from FilterSetSwapRouterAddress and is used to iterate over the raw logs and unpacked data for SetSwapRouterAddress events raised by the PledgePool contract.
+type PledgePoolSetSwapRouterAddressIterator struct {
+	Event *PledgePoolSetSwapRouterAddress // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolSetSwapRouterAddressIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolSetSwapRouterAddress)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolSetSwapRouterAddress)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolSetSwapRouterAddressIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolSetSwapRouterAddressIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolSetSwapRouterAddress represents a SetSwapRouterAddress event raised by the PledgePool contract.
+type PledgePoolSetSwapRouterAddress struct {
+	OldSwapAddress common.Address
+	NewSwapAddress common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetSwapRouterAddress is a free log retrieval operation binding the contract event 0x4558149b3c5427365f76d4ff19bef30aba41f17e5e601d4661330d8d2b687627.
+//
+// Solidity: event SetSwapRouterAddress(address indexed oldSwapAddress, address indexed newSwapAddress)
+func (_PledgePool *PledgePoolFilterer) FilterSetSwapRouterAddress(opts *bind.FilterOpts, oldSwapAddress []common.Address, newSwapAddress []common.Address) (*PledgePoolSetSwapRouterAddressIterator, error) {
+
+	var oldSwapAddressRule []interface{}
+	for _, oldSwapAddressItem := range oldSwapAddress {
+		oldSwapAddressRule = append(oldSwapAddressRule, oldSwapAddressItem)
+	}
+	var newSwapAddressRule []interface{}
+	for _, newSwapAddressItem := range newSwapAddress {
+		newSwapAddressRule = append(newSwapAddressRule, newSwapAddressItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "SetSwapRouterAddress", oldSwapAddressRule, newSwapAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolSetSwapRouterAddressIterator{contract: _PledgePool.contract, event: "SetSwapRouterAddress", logs: logs, sub: sub}, nil
+}
+
+// WatchSetSwapRouterAddress is a free log subscription operation binding the contract event 0x4558149b3c5427365f76d4ff19bef30aba41f17e5e601d4661330d8d2b687627.
+//
+// Solidity: event SetSwapRouterAddress(address indexed oldSwapAddress, address indexed newSwapAddress)
+func (_PledgePool *PledgePoolFilterer) WatchSetSwapRouterAddress(opts *bind.WatchOpts, sink chan<- *PledgePoolSetSwapRouterAddress, oldSwapAddress []common.Address, newSwapAddress []common.Address) (event.Subscription, error) {
+
+	var oldSwapAddressRule []interface{}
+	for _, oldSwapAddressItem := range oldSwapAddress {
+		oldSwapAddressRule = append(oldSwapAddressRule, oldSwapAddressItem)
+	}
+	var newSwapAddressRule []interface{}
+	for _, newSwapAddressItem := range newSwapAddress {
+		newSwapAddressRule = append(newSwapAddressRule, newSwapAddressItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "SetSwapRouterAddress", oldSwapAddressRule, newSwapAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolSetSwapRouterAddress)
+				if err := _PledgePool.contract.UnpackLog(event, "SetSwapRouterAddress", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetSwapRouterAddress is a log parse operation binding the contract event 0x4558149b3c5427365f76d4ff19bef30aba41f17e5e601d4661330d8d2b687627.
+//
+// Solidity: event SetSwapRouterAddress(address indexed oldSwapAddress, address indexed newSwapAddress)
+func (_PledgePool *PledgePoolFilterer) ParseSetSwapRouterAddress(log types.Log) (*PledgePoolSetSwapRouterAddress, error) {
+	event := new(PledgePoolSetSwapRouterAddress)
+	if err := _PledgePool.contract.UnpackLog(event, "SetSwapRouterAddress", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolStateChangeIterator is returned from FilterStateChange and is used to iterate over the raw logs and unpacked data for StateChange events raised by the PledgePool contract.
+type PledgePoolStateChangeIterator struct {
+	Event *PledgePoolStateChange // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolStateChangeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolStateChange)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolStateChange)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolStateChangeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolStateChangeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolStateChange represents a StateChange event raised by the PledgePool contract.
+type PledgePoolStateChange struct {
+	Pid         *big.Int
+	BeforeState *big.Int
+	AfterState  *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterStateChange is a free log retrieval operation binding the contract event 0x516112f3bf06e373fcea44db364769c04cc7ef4392e6de95d2b250720bcacefb.
+//
+// Solidity: event StateChange(uint256 indexed pid, uint256 indexed beforeState, uint256 indexed afterState)
+func (_PledgePool *PledgePoolFilterer) FilterStateChange(opts *bind.FilterOpts, pid []*big.Int, beforeState []*big.Int, afterState []*big.Int) (*PledgePoolStateChangeIterator, error) {
+
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+	var beforeStateRule []interface{}
+	for _, beforeStateItem := range beforeState {
+		beforeStateRule = append(beforeStateRule, beforeStateItem)
+	}
+	var afterStateRule []interface{}
+	for _, afterStateItem := range afterState {
+		afterStateRule = append(afterStateRule, afterStateItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "StateChange", pidRule, beforeStateRule, afterStateRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolStateChangeIterator{contract: _PledgePool.contract, event: "StateChange", logs: logs, sub: sub}, nil
+}
+
+// WatchStateChange is a free log subscription operation binding the contract event 0x516112f3bf06e373fcea44db364769c04cc7ef4392e6de95d2b250720bcacefb.
+//
+// Solidity: event StateChange(uint256 indexed pid, uint256 indexed beforeState, uint256 indexed afterState)
+func (_PledgePool *PledgePoolFilterer) WatchStateChange(opts *bind.WatchOpts, sink chan<- *PledgePoolStateChange, pid []*big.Int, beforeState []*big.Int, afterState []*big.Int) (event.Subscription, error) {
+
+	var pidRule []interface{}
+	for _, pidItem := range pid {
+		pidRule = append(pidRule, pidItem)
+	}
+	var beforeStateRule []interface{}
+	for _, beforeStateItem := range beforeState {
+		beforeStateRule = append(beforeStateRule, beforeStateItem)
+	}
+	var afterStateRule []interface{}
+	for _, afterStateItem := range afterState {
+		afterStateRule = append(afterStateRule, afterStateItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "StateChange", pidRule, beforeStateRule, afterStateRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolStateChange)
+				if err := _PledgePool.contract.UnpackLog(event, "StateChange", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStateChange is a log parse operation binding the contract event 0x516112f3bf06e373fcea44db364769c04cc7ef4392e6de95d2b250720bcacefb.
+//
+// Solidity: event StateChange(uint256 indexed pid, uint256 indexed beforeState, uint256 indexed afterState)
+func (_PledgePool *PledgePoolFilterer) ParseStateChange(log types.Log) (*PledgePoolStateChange, error) {
+	event := new(PledgePoolStateChange)
+	if err := _PledgePool.contract.UnpackLog(event, "StateChange", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolSwapIterator is returned from FilterSwap and is used to iterate over the raw logs and unpacked data for Swap events raised by the PledgePool contract.
+type PledgePoolSwapIterator struct {
+	Event *PledgePoolSwap // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolSwapIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolSwap)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolSwap)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolSwapIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolSwapIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolSwap represents a Swap event raised by the PledgePool contract.
+type PledgePoolSwap struct {
+	FromCoin  common.Address
+	ToCoin    common.Address
+	FromValue *big.Int
+	ToValue   *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterSwap is a free log retrieval operation binding the contract event 0xfa2dda1cc1b86e41239702756b13effbc1a092b5c57e3ad320fbe4f3b13fe235.
+//
+// Solidity: event Swap(address indexed fromCoin, address indexed toCoin, uint256 fromValue, uint256 toValue)
+func (_PledgePool *PledgePoolFilterer) FilterSwap(opts *bind.FilterOpts, fromCoin []common.Address, toCoin []common.Address) (*PledgePoolSwapIterator, error) {
+
+	var fromCoinRule []interface{}
+	for _, fromCoinItem := range fromCoin {
+		fromCoinRule = append(fromCoinRule, fromCoinItem)
+	}
+	var toCoinRule []interface{}
+	for _, toCoinItem := range toCoin {
+		toCoinRule = append(toCoinRule, toCoinItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "Swap", fromCoinRule, toCoinRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolSwapIterator{contract: _PledgePool.contract, event: "Swap", logs: logs, sub: sub}, nil
+}
+
+// WatchSwap is a free log subscription operation binding the contract event 0xfa2dda1cc1b86e41239702756b13effbc1a092b5c57e3ad320fbe4f3b13fe235.
+//
+// Solidity: event Swap(address indexed fromCoin, address indexed toCoin, uint256 fromValue, uint256 toValue)
+func (_PledgePool *PledgePoolFilterer) WatchSwap(opts *bind.WatchOpts, sink chan<- *PledgePoolSwap, fromCoin []common.Address, toCoin []common.Address) (event.Subscription, error) {
+
+	var fromCoinRule []interface{}
+	for _, fromCoinItem := range fromCoin {
+		fromCoinRule = append(fromCoinRule, fromCoinItem)
+	}
+	var toCoinRule []interface{}
+	for _, toCoinItem := range toCoin {
+		toCoinRule = append(toCoinRule, toCoinItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "Swap", fromCoinRule, toCoinRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolSwap)
+				if err := _PledgePool.contract.UnpackLog(event, "Swap", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSwap is a log parse operation binding the contract event 0xfa2dda1cc1b86e41239702756b13effbc1a092b5c57e3ad320fbe4f3b13fe235.
+//
+// Solidity: event Swap(address indexed fromCoin, address indexed toCoin, uint256 fromValue, uint256 toValue)
+func (_PledgePool *PledgePoolFilterer) ParseSwap(log types.Log) (*PledgePoolSwap, error) {
+	event := new(PledgePoolSwap)
+	if err := _PledgePool.contract.UnpackLog(event, "Swap", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolWithdrawBorrowIterator is returned from FilterWithdrawBorrow and is used to iterate over the raw logs and unpacked data for WithdrawBorrow events raised by the PledgePool contract.
+type PledgePoolWithdrawBorrowIterator struct {
+	Event *PledgePoolWithdrawBorrow // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolWithdrawBorrowIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolWithdrawBorrow)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolWithdrawBorrow)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolWithdrawBorrowIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolWithdrawBorrowIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolWithdrawBorrow represents a WithdrawBorrow event raised by the PledgePool contract.
+type PledgePoolWithdrawBorrow struct {
+	From       common.Address
+	Token      common.Address
+	Amount     *big.Int
+	BurnAmount *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawBorrow is a free log retrieval operation binding the contract event 0x0f5e74952c2f9259a748f3aa9a6c4534a6f46a5966e5baabdb6bd337f05234a8.
+//
+// Solidity: event WithdrawBorrow(address indexed from, address indexed token, uint256 amount, uint256 burnAmount)
+func (_PledgePool *PledgePoolFilterer) FilterWithdrawBorrow(opts *bind.FilterOpts, from []common.Address, token []common.Address) (*PledgePoolWithdrawBorrowIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "WithdrawBorrow", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolWithdrawBorrowIterator{contract: _PledgePool.contract, event: "WithdrawBorrow", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawBorrow is a free log subscription operation binding the contract event 0x0f5e74952c2f9259a748f3aa9a6c4534a6f46a5966e5baabdb6bd337f05234a8.
+//
+// Solidity: event WithdrawBorrow(address indexed from, address indexed token, uint256 amount, uint256 burnAmount)
+func (_PledgePool *PledgePoolFilterer) WatchWithdrawBorrow(opts *bind.WatchOpts, sink chan<- *PledgePoolWithdrawBorrow, from []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "WithdrawBorrow", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolWithdrawBorrow)
+				if err := _PledgePool.contract.UnpackLog(event, "WithdrawBorrow", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawBorrow is a log parse operation binding the contract event 0x0f5e74952c2f9259a748f3aa9a6c4534a6f46a5966e5baabdb6bd337f05234a8.
+//
+// Solidity: event WithdrawBorrow(address indexed from, address indexed token, uint256 amount, uint256 burnAmount)
+func (_PledgePool *PledgePoolFilterer) ParseWithdrawBorrow(log types.Log) (*PledgePoolWithdrawBorrow, error) {
+	event := new(PledgePoolWithdrawBorrow)
+	if err := _PledgePool.contract.UnpackLog(event, "WithdrawBorrow", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PledgePoolWithdrawLendIterator is returned from FilterWithdrawLend and is used to iterate over the raw logs and unpacked data for WithdrawLend events raised by the PledgePool contract.
+type PledgePoolWithdrawLendIterator struct {
+	Event *PledgePoolWithdrawLend // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PledgePoolWithdrawLendIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PledgePoolWithdrawLend)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PledgePoolWithdrawLend)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PledgePoolWithdrawLendIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PledgePoolWithdrawLendIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PledgePoolWithdrawLend represents a WithdrawLend event raised by the PledgePool contract.
+type PledgePoolWithdrawLend struct {
+	From       common.Address
+	Token      common.Address
+	Amount     *big.Int
+	BurnAmount *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawLend is a free log retrieval operation binding the contract event 0x690f32ccf3e832d5ff975d781039bc2affebee9c973939c9b710091b87954c9d.
+//
+// Solidity: event WithdrawLend(address indexed from, address indexed token, uint256 amount, uint256 burnAmount)
+func (_PledgePool *PledgePoolFilterer) FilterWithdrawLend(opts *bind.FilterOpts, from []common.Address, token []common.Address) (*PledgePoolWithdrawLendIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.FilterLogs(opts, "WithdrawLend", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PledgePoolWithdrawLendIterator{contract: _PledgePool.contract, event: "WithdrawLend", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawLend is a free log subscription operation binding the contract event 0x690f32ccf3e832d5ff975d781039bc2affebee9c973939c9b710091b87954c9d.
+//
+// Solidity: event WithdrawLend(address indexed from, address indexed token, uint256 amount, uint256 burnAmount)
+func (_PledgePool *PledgePoolFilterer) WatchWithdrawLend(opts *bind.WatchOpts, sink chan<- *PledgePoolWithdrawLend, from []common.Address, token []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var tokenRule []interface{}
+	for _, tokenItem := range token {
+		tokenRule = append(tokenRule, tokenItem)
+	}
+
+	logs, sub, err := _PledgePool.contract.WatchLogs(opts, "WithdrawLend", fromRule, tokenRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PledgePoolWithdrawLend)
+				if err := _PledgePool.contract.UnpackLog(event, "WithdrawLend", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawLend is a log parse operation binding the contract event 0x690f32ccf3e832d5ff975d781039bc2affebee9c973939c9b710091b87954c9d.
+//
+// Solidity: event WithdrawLend(address indexed from, address indexed token, uint256 amount, uint256 burnAmount)
+func (_PledgePool *PledgePoolFilterer) ParseWithdrawLend(log types.Log) (*PledgePoolWithdrawLend, error) {
+	event := new(PledgePoolWithdrawLend)
+	if err := _PledgePool.contract.UnpackLog(event, "WithdrawLend", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,338 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"pledge-backend/contracts/pledgepool"
+	"pledge-backend/model"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Backend 是索引需要的链接口，*ethclient.Client 满足
+type Backend interface {
+	bind.ContractBackend
+	ethereum.BlockNumberReader
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+}
+
+type Config struct {
+	Pool          common.Address
+	FromBlock     uint64        // 第一次启动时从这个区块开始
+	Confirmations uint64        // 只索引 head - Confirmations 之前的区块，避开短重组
+	BatchSize     uint64        // 每次 FilterLogs 的区块跨度
+	Interval      time.Duration // 轮询间隔
+}
+
+// Indexer 把 PledgePool 的事件写进数据库，每轮结束后刷新池子快照
+type Indexer struct {
+	cfg      Config
+	db       *gorm.DB
+	backend  Backend
+	contract *pledgepool.PledgePool
+	abi      *abi.ABI
+}
+
+func New(cfg Config, db *gorm.DB, backend Backend) (*Indexer, error) {
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = 2000
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = 5 * time.Second
+	}
+	contract, err := pledgepool.NewPledgePool(cfg.Pool, backend)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := pledgepool.PledgePoolMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Indexer{cfg: cfg, db: db, backend: backend, contract: contract, abi: parsed}, nil
+}
+
+func (ix *Indexer) cursorName() string {
+	return "pledgepool:" + strings.ToLower(ix.cfg.Pool.Hex())
+}
+
+// Cursor 返回已经索引到的区块，还没开始时 ok 为 false
+func (ix *Indexer) Cursor() (block uint64, ok bool, err error) {
+	var c model.Cursor
+	err = ix.db.Where("name = ?", ix.cursorName()).Limit(1).Find(&c).Error
+	if err != nil || c.Name == "" {
+		return 0, false, err
+	}
+	return c.Block, true, nil
+}
+
+// Run 轮询直到 ctx 取消，单轮出错只打日志，下一轮从游标处重试
+func (ix *Indexer) Run(ctx context.Context) {
+	ticker := time.NewTicker(ix.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if err := ix.Sync(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("indexer: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync 索引到当前确认高度并刷新池子快照
+func (ix *Indexer) Sync(ctx context.Context) error {
+	head, err := ix.backend.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if head < ix.cfg.Confirmations {
+		return nil
+	}
+	head -= ix.cfg.Confirmations
+
+	from := ix.cfg.FromBlock
+	if block, ok, err := ix.Cursor(); err != nil {
+		return err
+	} else if ok {
+		from = block + 1
+	}
+	for from <= head {
+		to := min(from+ix.cfg.BatchSize-1, head)
+		if err := ix.indexRange(ctx, from, to); err != nil {
+			return fmt.Errorf("blocks %d-%d: %w", from, to, err)
+		}
+		from = to + 1
+	}
+	return ix.SyncPools(ctx, head)
+}
+
+func (ix *Indexer) indexRange(ctx context.Context, from, to uint64) error {
+	logs, err := ix.backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{ix.cfg.Pool},
+	})
+	if err != nil {
+		return err
+	}
+	d := &decoder{ix: ix, pids: map[common.Hash]int64{}, times: map[uint64]int64{}}
+	events := make([]model.Event, 0, len(logs))
+	for _, l := range logs {
+		if l.Removed {
+			continue
+		}
+		e, ok, err := d.decode(ctx, l)
+		if err != nil {
+			return err
+		}
+		if ok {
+			events = append(events, e)
+		}
+	}
+
+	// 事件和游标在同一个事务里提交，重启后不会漏也不会重复
+	return ix.db.Transaction(func(tx *gorm.DB) error {
+		if len(events) > 0 {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&events).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Save(&model.Cursor{Name: ix.cursorName(), Block: to}).Error
+	})
+}
+
+// SyncPools 读取所有池子的 poolBaseInfo / poolDataInfo 写进快照表
+func (ix *Indexer) SyncPools(ctx context.Context, block uint64) error {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}
+	n, err := ix.contract.PoolLength(opts)
+	if err != nil {
+		return err
+	}
+	for pid := uint64(0); pid < n.Uint64(); pid++ {
+		id := new(big.Int).SetUint64(pid)
+		base, err := ix.contract.PoolBaseInfo(opts, id)
+		if err != nil {
+			return err
+		}
+		data, err := ix.contract.PoolDataInfo(opts, id)
+		if err != nil {
+			return err
+		}
+		pool := model.Pool{
+			ID:                      pid,
+			SettleTime:              base.SettleTime.Int64(),
+			EndTime:                 base.EndTime.Int64(),
+			InterestRate:            base.InterestRate.String(),
+			MaxSupply:               base.MaxSupply.String(),
+			LendSupply:              base.LendSupply.String(),
+			BorrowSupply:            base.BorrowSupply.String(),
+			MortgageRate:            base.MartgageRate.String(),
+			LendToken:               hexAddress(base.LendToken),
+			BorrowToken:             hexAddress(base.BorrowToken),
+			State:                   int(base.State),
+			SpCoin:                  hexAddress(base.SpCoin),
+			JpCoin:                  hexAddress(base.JpCoin),
+			AutoLiquidateThreshold:  base.AutoLiquidateThreshold.String(),
+			SettleAmountLend:        data.SettleAmountLend.String(),
+			SettleAmountBorrow:      data.SettleAmountBorrow.String(),
+			FinishAmountLend:        data.FinishAmountLend.String(),
+			FinishAmountBorrow:      data.FinishAmountBorrow.String(),
+			LiquidationAmountLend:   data.LiquidationAmounLend.String(),
+			LiquidationAmountBorrow: data.LiquidationAmounBorrow.String(),
+			UpdatedBlock:            block,
+		}
+		// pid 0 是零值主键，Save 会当成新记录 INSERT，所以用 upsert
+		if err := ix.db.Clauses(clause.OnConflict{UpdateAll: true}).Create(&pool).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decoder 缓存一轮里交易对应的池 ID 和区块时间
+type decoder struct {
+	ix    *Indexer
+	pids  map[common.Hash]int64
+	times map[uint64]int64
+}
+
+func (d *decoder) decode(ctx context.Context, l types.Log) (model.Event, bool, error) {
+	if len(l.Topics) == 0 {
+		return model.Event{}, false, nil
+	}
+	ev, err := d.ix.abi.EventByID(l.Topics[0])
+	if err != nil {
+		return model.Event{}, false, nil // 不认识的事件直接跳过
+	}
+	args := map[string]any{}
+	if err := d.ix.abi.UnpackIntoMap(args, ev.Name, l.Data); err != nil {
+		return model.Event{}, false, fmt.Errorf("unpack %s: %w", ev.Name, err)
+	}
+	var indexed abi.Arguments
+	for _, in := range ev.Inputs {
+		if in.Indexed {
+			indexed = append(indexed, in)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, l.Topics[1:]); err != nil {
+		return model.Event{}, false, fmt.Errorf("topics %s: %w", ev.Name, err)
+	}
+
+	raw, err := json.Marshal(jsonArgs(args))
+	if err != nil {
+		return model.Event{}, false, err
+	}
+	e := model.Event{
+		Block:    l.BlockNumber,
+		LogIndex: l.Index,
+		TxHash:   l.TxHash.Hex(),
+		Name:     ev.Name,
+		Pool:     -1,
+		Args:     string(raw),
+	}
+	if e.Time, err = d.blockTime(ctx, l.BlockNumber); err != nil {
+		return model.Event{}, false, err
+	}
+
+	switch ev.Name {
+	case "Swap":
+		e.Token = hexAddress(args["fromCoin"].(common.Address))
+		e.Amount = args["fromValue"].(*big.Int).String()
+		e.Amount2 = args["toValue"].(*big.Int).String()
+	default:
+		if from, ok := args["from"].(common.Address); ok {
+			e.User = hexAddress(from)
+		}
+		if token, ok := args["token"].(common.Address); ok {
+			e.Token = hexAddress(token)
+		}
+		for _, name := range []string{"amount", "refund"} {
+			if v, ok := args[name].(*big.Int); ok {
+				e.Amount = v.String()
+			}
+		}
+		for _, name := range []string{"mintAmount", "burnAmount"} {
+			if v, ok := args[name].(*big.Int); ok {
+				e.Amount2 = v.String()
+			}
+		}
+	}
+
+	if pid, ok := args["pid"].(*big.Int); ok {
+		e.Pool = pid.Int64()
+	} else if e.Pool, err = d.txPool(ctx, l.TxHash); err != nil {
+		return model.Event{}, false, err
+	}
+	return e, true, nil
+}
+
+// txPool 从交易 input 里解出 _pid。只有 StateChange 带池 ID，其他用户事件要靠调用参数判断属于哪个池
+func (d *decoder) txPool(ctx context.Context, hash common.Hash) (int64, error) {
+	if pid, ok := d.pids[hash]; ok {
+		return pid, nil
+	}
+	tx, _, err := d.ix.backend.TransactionByHash(ctx, hash)
+	if err != nil {
+		return 0, fmt.Errorf("tx %s: %w", hash.Hex(), err)
+	}
+	pid := int64(-1)
+	if tx.To() != nil && *tx.To() == d.ix.cfg.Pool && len(tx.Data()) >= 4 {
+		if method, err := d.ix.abi.MethodById(tx.Data()[:4]); err == nil &&
+			len(method.Inputs) > 0 && method.Inputs[0].Name == "_pid" {
+			if values, err := method.Inputs.Unpack(tx.Data()[4:]); err == nil {
+				if v, ok := values[0].(*big.Int); ok {
+					pid = v.Int64()
+				}
+			}
+		}
+	}
+	d.pids[hash] = pid
+	return pid, nil
+}
+
+func (d *decoder) blockTime(ctx context.Context, number uint64) (int64, error) {
+	if t, ok := d.times[number]; ok {
+		return t, nil
+	}
+	header, err := d.ix.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return 0, fmt.Errorf("header %d: %w", number, err)
+	}
+	d.times[number] = int64(header.Time)
+	return d.times[number], nil
+}
+
+// jsonArgs 把地址和大整数转成字符串，保证 JSON 里不丢精度
+func jsonArgs(args map[string]any) map[string]any {
+	out := make(map[string]any, len(args))
+	for k, v := range args {
+		switch v := v.(type) {
+		case common.Address:
+			out[k] = hexAddress(v)
+		case *big.Int:
+			out[k] = v.String()
+		default:
+			out[k] = v
+		}
+	}
+	return out
+}
+
+func hexAddress(a common.Address) string {
+	return strings.ToLower(a.Hex())
+}
@@ -0,0 +1,262 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"pledge-backend/contracts/pledgepool"
+	"pledge-backend/model"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm"
+)
+
+// fixture 是 testdata/logs.json，由 testdata/gen.go 生成
+type fixture struct {
+	Pool    common.Address    `json:"pool"`
+	Head    uint64            `json:"head"`
+	Headers map[uint64]uint64 `json:"headers"`
+	Txs     []hexutil.Bytes   `json:"txs"`
+	Logs    []types.Log       `json:"logs"`
+}
+
+// fakeBackend 按样本回答 eth_getLogs / eth_getTransactionByHash / 区块头，合约调用返回 pools 里的快照。
+// 没用到的 bind.ContractBackend 方法调用时会 panic
+type fakeBackend struct {
+	bind.ContractBackend
+	abi     *abi.ABI
+	head    uint64
+	headers map[uint64]uint64
+	txs     map[common.Hash]*types.Transaction
+	logs    []types.Log
+	pools   []pool
+	queries [][2]uint64 // FilterLogs 的区块范围
+}
+
+// pool 是 poolBaseInfo / poolDataInfo 按 ABI 输出顺序排列的返回值
+type pool struct {
+	base []any
+	data []any
+}
+
+func loadFixture(t *testing.T) (*fakeBackend, common.Address) {
+	t.Helper()
+	raw, err := os.ReadFile("testdata/logs.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f fixture
+	if err := json.Unmarshal(raw, &f); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := pledgepool.PledgePoolMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &fakeBackend{abi: parsed, head: f.Head, headers: f.Headers, txs: map[common.Hash]*types.Transaction{}, logs: f.Logs}
+	for _, enc := range f.Txs {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(enc); err != nil {
+			t.Fatal(err)
+		}
+		b.txs[tx.Hash()] = tx
+	}
+	return b, f.Pool
+}
+
+func (b *fakeBackend) BlockNumber(ctx context.Context) (uint64, error) {
+	return b.head, nil
+}
+
+func (b *fakeBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	t, ok := b.headers[number.Uint64()]
+	if !ok {
+		return nil, ethereum.NotFound
+	}
+	return &types.Header{Number: number, Time: t}, nil
+}
+
+func (b *fakeBackend) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	tx, ok := b.txs[hash]
+	if !ok {
+		return nil, false, ethereum.NotFound
+	}
+	return tx, false, nil
+}
+
+func (b *fakeBackend) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	b.queries = append(b.queries, [2]uint64{from, to})
+	var out []types.Log
+	for _, l := range b.logs {
+		if l.BlockNumber < from || l.BlockNumber > to {
+			continue
+		}
+		for _, addr := range q.Addresses {
+			if l.Address == addr {
+				out = append(out, l)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (b *fakeBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	method, err := b.abi.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "poolLength":
+		return method.Outputs.Pack(big.NewInt(int64(len(b.pools))))
+	case "poolBaseInfo":
+		return method.Outputs.Pack(b.pools[args[0].(*big.Int).Int64()].base...)
+	case "poolDataInfo":
+		return method.Outputs.Pack(b.pools[args[0].(*big.Int).Int64()].data...)
+	}
+	return nil, fmt.Errorf("unexpected call %s", method.Name)
+}
+
+func openDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := model.Open(filepath.Join(t.TempDir(), "pledge.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func events(t *testing.T, db *gorm.DB) []model.Event {
+	t.Helper()
+	var out []model.Event
+	if err := db.Order("block, log_index").Find(&out).Error; err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+const (
+	lender   = "0x70997970c51812dc3a010c7d01b50e0d17dc79c8"
+	busd     = "0xe9e7cea3dedca5984780bafc599bd69add087d56"
+	btcb     = "0x7130d2a12b9bcbfae4f2634d864a1ee1ce3ead9c"
+	eth100   = "100000000000000000000"
+	eth2     = "2000000000000000000"
+	eth1     = "1000000000000000000"
+	eth40    = "40000000000000000000"
+	eth41    = "41000000000000000000"
+	eth30000 = "30000000000000000000000"
+)
+
+func TestSync(t *testing.T) {
+	backend, addr := loadFixture(t)
+	backend.pools = []pool{{
+		base: []any{big.NewInt(1700000006), big.NewInt(1702592006), big.NewInt(5e6), big.NewInt(1000), big.NewInt(100), big.NewInt(2),
+			big.NewInt(2e8), common.HexToAddress(busd), common.HexToAddress(btcb), uint8(model.StateExecution),
+			common.HexToAddress("0x01"), common.HexToAddress("0x02"), big.NewInt(2e7)},
+		data: []any{big.NewInt(100), big.NewInt(2), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0)},
+	}}
+	db := openDB(t)
+	ix, err := New(Config{Pool: addr, FromBlock: 100, Confirmations: 2, BatchSize: 4}, db, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := ix.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// head 112 减去 2 个确认，分 4 个区块一批
+	if want := [][2]uint64{{100, 103}, {104, 107}, {108, 110}}; fmt.Sprint(backend.queries) != fmt.Sprint(want) {
+		t.Fatalf("queries = %v, want %v", backend.queries, want)
+	}
+	if block, ok, err := ix.Cursor(); err != nil || !ok || block != 110 {
+		t.Fatalf("cursor = %d, %v, %v", block, ok, err)
+	}
+
+	want := []model.Event{
+		{Block: 100, LogIndex: 0, Name: "SetFee", Pool: -1, Time: 1700000000},
+		{Block: 101, LogIndex: 0, Name: "DepositLend", Pool: 0, User: lender, Token: busd, Amount: eth100, Amount2: eth100, Time: 1700000003},
+		// LogIndex 1 是 BUSD 合约的日志，不属于池子
+		{Block: 101, LogIndex: 2, Name: "DepositBorrow", Pool: 1, Token: btcb, Amount: eth2, Amount2: eth2, Time: 1700000003},
+		{Block: 102, LogIndex: 0, Name: "StateChange", Pool: 0, Time: 1700000006},
+		{Block: 103, LogIndex: 0, Name: "Swap", Pool: 1, Token: btcb, Amount: eth1, Amount2: eth30000, Time: 1700000009},
+		{Block: 103, LogIndex: 1, Name: "StateChange", Pool: 1, Time: 1700000009},
+		{Block: 104, LogIndex: 0, Name: "ClaimLend", Pool: 0, User: lender, Token: btcb, Amount: eth100, Time: 1700000012},
+		{Block: 105, LogIndex: 0, Name: "WithdrawLend", Pool: 0, User: lender, Token: busd, Amount: eth41, Amount2: eth40, Time: 1700000015},
+		// 经多签合约转发，input 里解不出 _pid
+		{Block: 105, LogIndex: 1, Name: "RefundLend", Pool: -1, User: lender, Token: busd, Amount: "3000000000000000000", Time: 1700000015},
+		// 106 的 Paused 不在 ABI 里，107 的日志已被移除，111 还没到确认数
+	}
+	got := events(t, db)
+	if len(got) != len(want) {
+		t.Fatalf("indexed %d events, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Block != w.Block || g.LogIndex != w.LogIndex || g.Name != w.Name || g.Pool != w.Pool || g.Token != w.Token ||
+			g.Amount != w.Amount || g.Amount2 != w.Amount2 || g.Time != w.Time || (w.User != "" && g.User != w.User) {
+			t.Errorf("event %d = %+v, want %+v", i, g, w)
+		}
+	}
+
+	var args map[string]string
+	if err := json.Unmarshal([]byte(got[3].Args), &args); err != nil {
+		t.Fatal(err)
+	}
+	if args["pid"] != "0" || args["beforeState"] != "0" || args["afterState"] != "1" {
+		t.Errorf("StateChange args = %v", args)
+	}
+
+	var snapshot model.Pool
+	if err := db.First(&snapshot, 0).Error; err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.State != model.StateExecution || snapshot.LendToken != busd || snapshot.InterestRate != "5000000" ||
+		snapshot.SettleAmountLend != "100" || snapshot.UpdatedBlock != 110 {
+		t.Errorf("pool snapshot = %+v", snapshot)
+	}
+
+	// 重启后从游标继续，不重复写入；head 前进后补上 111
+	backend.queries = nil
+	backend.head = 113
+	ix, err = New(Config{Pool: addr, FromBlock: 100, Confirmations: 2, BatchSize: 4}, db, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ix.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if want := [][2]uint64{{111, 111}}; fmt.Sprint(backend.queries) != fmt.Sprint(want) {
+		t.Fatalf("queries after restart = %v, want %v", backend.queries, want)
+	}
+	got = events(t, db)
+	if last := got[len(got)-1]; len(got) != len(want)+1 || last.Name != "WithdrawBorrow" || last.Pool != 1 || last.Amount2 != eth1 {
+		t.Fatalf("after restart: %d events, last %+v", len(got), last)
+	}
+	// 池 0 的快照要被更新而不是重复插入
+	if err := db.First(&snapshot, 0).Error; err != nil || snapshot.UpdatedBlock != 111 {
+		t.Fatalf("pool snapshot after restart = %+v, %v", snapshot, err)
+	}
+
+	// 同一范围重新索引（比如游标被手动回退）不会重复
+	if err := ix.indexRange(ctx, 100, 111); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(events(t, db)); n != len(want)+1 {
+		t.Fatalf("reindex produced %d events", n)
+	}
+}
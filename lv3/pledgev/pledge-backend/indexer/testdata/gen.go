@@ -0,0 +1,201 @@
+//go:build ignore
+
+// 生成 indexer 测试用的日志样本 logs.json。PledgePool.sol 在仓库里不完整、编译不了，
+// 所以日志按 PledgePool.abi 编码，交易用固定私钥签名，和节点 eth_getLogs / eth_getTransactionByHash 返回的一致。
+//
+//	go run testdata/gen.go > testdata/logs.json
+package main
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"log"
+	"math/big"
+	"os"
+
+	"pledge-backend/contracts/pledgepool"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	chainID  = big.NewInt(97)
+	pool     = common.HexToAddress("0x5fbdb2315678afecb367f032d93f642f64180aa3")
+	multisig = common.HexToAddress("0xe7f1725e7734ce288f8367e1bb143e90bb3f0512") // 转发调用的合约，input 里没有 _pid
+	busd     = common.HexToAddress("0xe9e7cea3dedca5984780bafc599bd69add087d56")
+	btcb     = common.HexToAddress("0x7130d2a12b9bcbfae4f2634d864a1ee1ce3ead9c")
+	ether    = big.NewInt(1e18)
+)
+
+// Fixture 是 logs.json 的格式
+type Fixture struct {
+	Pool    common.Address    `json:"pool"`
+	Head    uint64            `json:"head"`
+	Headers map[uint64]uint64 `json:"headers"` // 区块号 -> 时间戳
+	Txs     []hexutil.Bytes   `json:"txs"`     // 签名后的交易
+	Logs    []types.Log       `json:"logs"`
+}
+
+type gen struct {
+	abi   *abi.ABI
+	f     Fixture
+	nonce map[common.Address]uint64
+	index uint
+}
+
+func key(hex string) *ecdsa.PrivateKey {
+	k, err := crypto.HexToECDSA(hex)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return k
+}
+
+func eth(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), ether)
+}
+
+// tx 签名一笔调用 method 的交易
+func (g *gen) tx(k *ecdsa.PrivateKey, to common.Address, method string, args ...any) *types.Transaction {
+	data, err := g.abi.Pack(method, args...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(k.PublicKey)
+	tx, err := types.SignNewTx(k, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     g.nonce[from],
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(5e9),
+		Gas:       300000,
+		To:        &to,
+		Data:      data,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	g.nonce[from]++
+	g.f.Txs = append(g.f.Txs, mustBinary(tx))
+	return tx
+}
+
+func mustBinary(tx *types.Transaction) hexutil.Bytes {
+	b, err := tx.MarshalBinary()
+	if err != nil {
+		log.Fatal(err)
+	}
+	return b
+}
+
+// emit 按 ABI 编码事件，args 按事件参数顺序给出
+func (g *gen) emit(block uint64, tx *types.Transaction, addr common.Address, event string, args ...any) *types.Log {
+	ev := g.abi.Events[event]
+	topics := []common.Hash{ev.ID}
+	var data []any
+	var nonIndexed abi.Arguments
+	for i, in := range ev.Inputs {
+		if !in.Indexed {
+			nonIndexed = append(nonIndexed, in)
+			data = append(data, args[i])
+			continue
+		}
+		switch v := args[i].(type) {
+		case common.Address:
+			topics = append(topics, common.BytesToHash(v.Bytes()))
+		case *big.Int:
+			topics = append(topics, common.BigToHash(v))
+		}
+	}
+	packed, err := nonIndexed.Pack(data...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return g.raw(block, tx, addr, topics, packed)
+}
+
+func (g *gen) raw(block uint64, tx *types.Transaction, addr common.Address, topics []common.Hash, data []byte) *types.Log {
+	if _, ok := g.f.Headers[block]; !ok {
+		g.f.Headers[block] = 1700000000 + (block-100)*3
+		g.index = 0
+	}
+	l := types.Log{
+		Address:     addr,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: block,
+		TxHash:      tx.Hash(),
+		BlockHash:   crypto.Keccak256Hash(new(big.Int).SetUint64(block).Bytes()),
+		Index:       g.index,
+	}
+	g.index++
+	g.f.Logs = append(g.f.Logs, l)
+	return &g.f.Logs[len(g.f.Logs)-1]
+}
+
+func main() {
+	parsed, err := pledgepool.PledgePoolMetaData.GetAbi()
+	if err != nil {
+		log.Fatal(err)
+	}
+	g := &gen{abi: parsed, nonce: map[common.Address]uint64{}}
+	g.f = Fixture{Pool: pool, Head: 112, Headers: map[uint64]uint64{}}
+
+	admin := key("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	lender := key("59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d")
+	borrower := key("5de4111afa1a4b94908f83f44bb6c5b8e9d4e8e2b7e1c7c1e3f1b7a2b1b2e4a1")
+	lenderAddr := crypto.PubkeyToAddress(lender.PublicKey)
+	borrowerAddr := crypto.PubkeyToAddress(borrower.PublicKey)
+
+	// 100：管理员设置手续费，方法没有 _pid
+	tx := g.tx(admin, pool, "setFee", big.NewInt(2e6), big.NewInt(2e6))
+	g.emit(100, tx, pool, "SetFee", big.NewInt(2e6), big.NewInt(2e6))
+
+	// 101：池 0 出借、池 1 抵押借款；同一区块里还有一条别的合约的日志
+	tx = g.tx(lender, pool, "depositLend", big.NewInt(0), eth(100))
+	g.emit(101, tx, pool, "DepositLend", lenderAddr, busd, eth(100), eth(100))
+	tx = g.tx(borrower, pool, "depositBorrow", big.NewInt(1), eth(2))
+	g.emit(101, tx, busd, "DepositBorrow", borrowerAddr, btcb, eth(2), eth(2))
+	g.emit(101, tx, pool, "DepositBorrow", borrowerAddr, btcb, eth(2), eth(2))
+
+	// 102：池 0 结算，StateChange 自带 pid
+	tx = g.tx(admin, pool, "settle", big.NewInt(0))
+	g.emit(102, tx, pool, "StateChange", big.NewInt(0), big.NewInt(0), big.NewInt(1))
+
+	// 103：池 1 清算，同一笔交易里的 Swap 靠 input 里的 _pid 归到池 1
+	tx = g.tx(admin, pool, "liquidate", big.NewInt(1))
+	g.emit(103, tx, pool, "Swap", btcb, busd, eth(1), eth(30000))
+	g.emit(103, tx, pool, "StateChange", big.NewInt(1), big.NewInt(1), big.NewInt(3))
+
+	// 104：领取 sp_token，事件里的 token 是 borrowToken
+	tx = g.tx(lender, pool, "claimLend", big.NewInt(0))
+	g.emit(104, tx, pool, "ClaimLend", lenderAddr, btcb, eth(100))
+
+	// 105：赎回，burnAmount 进 Amount2；另一笔经多签合约转发的退款解不出池 ID
+	tx = g.tx(lender, pool, "withdrawLend", big.NewInt(0), eth(40))
+	g.emit(105, tx, pool, "WithdrawLend", lenderAddr, busd, eth(41), eth(40))
+	tx = g.tx(admin, multisig, "refundLend", big.NewInt(0))
+	g.emit(105, tx, pool, "RefundLend", lenderAddr, busd, eth(3))
+
+	// 106：不在 ABI 里的事件（OpenZeppelin Paused）要跳过
+	tx = g.tx(admin, pool, "setPause")
+	paused := crypto.Keccak256Hash([]byte("Paused(address)"))
+	g.raw(106, tx, pool, []common.Hash{paused}, common.LeftPadBytes(crypto.PubkeyToAddress(admin.PublicKey).Bytes(), 32))
+
+	// 107：被重组移除的日志要跳过
+	tx = g.tx(borrower, pool, "claimBorrow", big.NewInt(1))
+	g.emit(107, tx, pool, "ClaimBorrow", borrowerAddr, busd, eth(1)).Removed = true
+
+	// 111：在确认数之内，head 前进之后才索引
+	tx = g.tx(borrower, pool, "withdrawBorrow", big.NewInt(1), eth(1))
+	g.emit(111, tx, pool, "WithdrawBorrow", borrowerAddr, btcb, eth(1), eth(1))
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(g.f); err != nil {
+		log.Fatal(err)
+	}
+}
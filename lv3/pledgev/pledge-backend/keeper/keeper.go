@@ -0,0 +1,360 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"pledge-backend/contracts/pledgepool"
+	"pledge-backend/model"
+
+	"ethkit/preflight"
+	"ethkit/signer"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gorm.io/gorm"
+)
+
+// Backend 是 keeper 需要的链接口，*ethclient.Client 满足
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+type Action string
+
+const (
+	Settle    Action = "settle"
+	Finish    Action = "finish"
+	Liquidate Action = "liquidate"
+)
+
+type Config struct {
+	Pool       common.Address
+	Interval   time.Duration
+	RetryAfter time.Duration // 交易 revert 或被节点拒绝后隔多久才重新提交同一个操作
+	DryRun     bool          // 只记录判断结果，不发交易
+}
+
+// Keeper 定时检查每个池子，到期时提交 settle / finish / liquidate。
+// 发出的交易先写进 keeper_txes 表再广播：同一个池子的同一个操作有未确认的交易时不会重复提交，
+// 重启后未上链的交易会按原 nonce 重新广播
+type Keeper struct {
+	cfg      Config
+	db       *gorm.DB
+	backend  Backend
+	contract *pledgepool.PledgePool
+	auth     *bind.TransactOpts // 经过 preflight.Guard，签名前先检查
+	nonce    uint64             // 下一个可用的 nonce，0 表示还没从链上读取
+}
+
+// New 创建 keeper，auth 的签名器会包上 preflight.Guard，每笔交易签名前先检查
+func New(cfg Config, db *gorm.DB, backend Backend, auth *bind.TransactOpts) (*Keeper, error) {
+	if cfg.Interval == 0 {
+		cfg.Interval = 15 * time.Second
+	}
+	if cfg.RetryAfter == 0 {
+		cfg.RetryAfter = 5 * time.Minute
+	}
+	contract, err := pledgepool.NewPledgePool(cfg.Pool, backend)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := pledgepool.PledgePoolMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	auth = preflight.Guard(auth, backend, preflight.Options{ABIs: []abi.ABI{*parsed}})
+	return &Keeper{cfg: cfg, db: db, backend: backend, contract: contract, auth: auth}, nil
+}
+
+// Run 轮询直到 ctx 取消
+func (k *Keeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(k.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if err := k.Tick(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("keeper: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick 先核对已发出的交易，再逐个池子判断并提交到期的操作
+func (k *Keeper) Tick(ctx context.Context) error {
+	if err := k.reconcile(ctx); err != nil {
+		return fmt.Errorf("reconcile: %w", err)
+	}
+	// 合约用 block.timestamp 判断时间，这里也用最新区块的时间而不是本机时间
+	head, err := k.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	n, err := k.contract.PoolLength(opts)
+	if err != nil {
+		return err
+	}
+	for pid := uint64(0); pid < n.Uint64(); pid++ {
+		action, reason, err := k.Decide(ctx, pid, head.Time)
+		if err != nil {
+			log.Printf("keeper: pool %d: %v", pid, err)
+			continue
+		}
+		if action == "" {
+			log.Printf("keeper: pool %d: no action, %s", pid, reason)
+			continue
+		}
+		if skip, why, err := k.submitted(pid, action); err != nil {
+			return err
+		} else if skip {
+			log.Printf("keeper: pool %d: %s due (%s) but %s", pid, action, reason, why)
+			continue
+		}
+		if k.cfg.DryRun {
+			log.Printf("keeper: pool %d: %s due (%s), dry run", pid, action, reason)
+			continue
+		}
+		log.Printf("keeper: pool %d: %s due (%s), submitting", pid, action, reason)
+		if err := k.submit(ctx, pid, action); err != nil {
+			log.Printf("keeper: pool %d: %s: %v", pid, action, err)
+		}
+	}
+	return nil
+}
+
+// Decide 根据池子状态和区块时间判断该执行的操作，最终以合约的 checkout* 视图函数为准。
+// 不需要操作时返回空 Action，reason 说明原因
+func (k *Keeper) Decide(ctx context.Context, pid uint64, now uint64) (Action, string, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	id := new(big.Int).SetUint64(pid)
+	base, err := k.contract.PoolBaseInfo(opts, id)
+	if err != nil {
+		return "", "", err
+	}
+	settleTime, endTime := base.SettleTime.Uint64(), base.EndTime.Uint64()
+	switch base.State {
+	case model.StateMatch:
+		if now < settleTime {
+			return "", fmt.Sprintf("matching until %d (%ds left)", settleTime, settleTime-now), nil
+		}
+		ok, err := k.contract.CheckoutSettle(opts, id)
+		if err != nil {
+			return "", "", err
+		}
+		if !ok {
+			return "", fmt.Sprintf("settle time %d reached but checkoutSettle is false", settleTime), nil
+		}
+		return Settle, fmt.Sprintf("settle time %d reached", settleTime), nil
+	case model.StateExecution:
+		if now >= endTime {
+			ok, err := k.contract.CheckoutFinish(opts, id)
+			if err != nil {
+				return "", "", err
+			}
+			if !ok {
+				return "", fmt.Sprintf("end time %d reached but checkoutFinish is false", endTime), nil
+			}
+			return Finish, fmt.Sprintf("end time %d reached", endTime), nil
+		}
+		ok, err := k.contract.CheckoutLiquidate(opts, id)
+		if err != nil {
+			return "", "", err
+		}
+		if ok {
+			return Liquidate, fmt.Sprintf("collateral below threshold %s", base.AutoLiquidateThreshold), nil
+		}
+		return "", fmt.Sprintf("executing until %d, collateral healthy", endTime), nil
+	default:
+		return "", fmt.Sprintf("state %s is final", stateName(base.State)), nil
+	}
+}
+
+// submitted 判断这个操作是否已经提交过：有 pending 的交易，或者最近一次 revert / 被拒绝还没过重试间隔
+func (k *Keeper) submitted(pid uint64, action Action) (bool, string, error) {
+	var last model.KeeperTx
+	err := k.db.Where("pool = ? AND action = ?", pid, string(action)).Order("id desc").Limit(1).Find(&last).Error
+	if err != nil || last.ID == 0 {
+		return false, "", err
+	}
+	switch last.Status {
+	case model.TxPending:
+		return true, fmt.Sprintf("tx %s (nonce %d) is still pending", last.TxHash, last.Nonce), nil
+	case model.TxReverted, model.TxFailed:
+		if wait := time.Until(last.UpdatedAt.Add(k.cfg.RetryAfter)); wait > 0 {
+			return true, fmt.Sprintf("tx %s %s, retrying in %s", last.TxHash, last.Status, wait.Round(time.Second)), nil
+		}
+	}
+	return false, "", nil
+}
+
+func (k *Keeper) submit(ctx context.Context, pid uint64, action Action) error {
+	nonce, err := k.nextNonce(ctx)
+	if err != nil {
+		return err
+	}
+	opts := signer.WithContext(ctx, k.auth)
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+	opts.NoSend = true // 先签名落库，再广播
+
+	// 签名前 preflight 会模拟执行并检查 gas 和余额，会 revert 或付不起手续费时在这里就返回错误，不占用 nonce
+	id := new(big.Int).SetUint64(pid)
+	var tx *types.Transaction
+	switch action {
+	case Settle:
+		tx, err = k.contract.Settle(opts, id)
+	case Finish:
+		tx, err = k.contract.Finish(opts, id)
+	case Liquidate:
+		tx, err = k.contract.Liquidate(opts, id)
+	default:
+		return fmt.Errorf("unknown action %s", action)
+	}
+	if err != nil {
+		return err
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	record := model.KeeperTx{
+		Pool:   pid,
+		Action: string(action),
+		From:   strings.ToLower(k.auth.From.Hex()),
+		Nonce:  nonce,
+		TxHash: tx.Hash().Hex(),
+		Raw:    raw,
+		Status: model.TxPending,
+	}
+	if err := k.db.Create(&record).Error; err != nil {
+		return err
+	}
+	k.nonce = nonce + 1
+	if err := k.send(ctx, &record, tx); err != nil {
+		k.nonce = 0
+		return fmt.Errorf("send %s (nonce %d): %w", tx.Hash().Hex(), nonce, err)
+	}
+	log.Printf("keeper: pool %d: %s sent %s (nonce %d)", pid, action, tx.Hash().Hex(), nonce)
+	return nil
+}
+
+// send 广播交易。节点明确拒绝时把记录标记为 failed，不再重发，过了 RetryAfter 以后重新签名提交；
+// 连接失败、超时这类错误保留为 pending，下一轮 reconcile 会重发或者判定为 dropped
+func (k *Keeper) send(ctx context.Context, r *model.KeeperTx, tx *types.Transaction) error {
+	err := k.backend.SendTransaction(ctx, tx)
+	if err == nil || alreadyKnown(err) {
+		return nil
+	}
+	if rejected(err) {
+		r.Status = model.TxFailed
+		r.Error = err.Error()
+		log.Printf("keeper: pool %d: %s %s rejected: %v", r.Pool, r.Action, r.TxHash, err)
+		if err := k.db.Save(r).Error; err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// rejected 判断错误是不是节点返回的 JSON-RPC 错误（余额不足、手续费过低、intrinsic gas 不够等），
+// 这类交易原样重发也不会成功；网络错误没有错误码
+func rejected(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr)
+}
+
+// alreadyKnown 是交易已经在节点交易池里，重发时常见，当作成功
+func alreadyKnown(err error) bool {
+	return strings.Contains(err.Error(), "already known")
+}
+
+// nextNonce 取链上 pending nonce 和本地计数里较大的一个，避免连续提交时节点还没看到上一笔交易
+func (k *Keeper) nextNonce(ctx context.Context) (uint64, error) {
+	pending, err := k.backend.PendingNonceAt(ctx, k.auth.From)
+	if err != nil {
+		return 0, err
+	}
+	return max(pending, k.nonce), nil
+}
+
+// reconcile 更新 pending 交易的状态：已上链的记录结果；不在节点里且 nonce 还没被用掉的重新广播，
+// 重发被节点拒绝的标记为 failed；nonce 已经被别的交易用掉的标记为 dropped
+func (k *Keeper) reconcile(ctx context.Context) error {
+	var pending []model.KeeperTx
+	if err := k.db.Where("status = ?", model.TxPending).Order("nonce").Find(&pending).Error; err != nil {
+		return err
+	}
+	for _, r := range pending {
+		hash := common.HexToHash(r.TxHash)
+		receipt, err := k.backend.TransactionReceipt(ctx, hash)
+		if err == nil {
+			r.Status = model.TxMined
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				r.Status = model.TxReverted
+			}
+			r.Block = receipt.BlockNumber.Uint64()
+			log.Printf("keeper: pool %d: %s %s %s in block %d", r.Pool, r.Action, r.TxHash, r.Status, r.Block)
+			if err := k.db.Save(&r).Error; err != nil {
+				return err
+			}
+			continue
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return err
+		}
+
+		mined, err := k.backend.NonceAt(ctx, common.HexToAddress(r.From), nil)
+		if err != nil {
+			return err
+		}
+		if mined > r.Nonce {
+			r.Status = model.TxDropped
+			log.Printf("keeper: pool %d: %s %s dropped, nonce %d used by another tx", r.Pool, r.Action, r.TxHash, r.Nonce)
+			if err := k.db.Save(&r).Error; err != nil {
+				return err
+			}
+			continue
+		}
+		if _, _, err := k.backend.TransactionByHash(ctx, hash); err == nil {
+			continue // 还在交易池里
+		} else if !errors.Is(err, ethereum.NotFound) {
+			return err
+		}
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(r.Raw); err != nil {
+			return err
+		}
+		log.Printf("keeper: pool %d: %s %s not found, rebroadcasting (nonce %d)", r.Pool, r.Action, r.TxHash, r.Nonce)
+		if err := k.send(ctx, &r, tx); err != nil {
+			log.Printf("keeper: rebroadcast %s: %v", r.TxHash, err)
+			if r.Status == model.TxFailed {
+				k.nonce = 0
+			}
+		}
+	}
+	return nil
+}
+
+func stateName(state uint8) string {
+	if int(state) >= len(model.StateNames) {
+		return fmt.Sprintf("UNKNOWN(%d)", state)
+	}
+	return model.StateNames[state]
+}
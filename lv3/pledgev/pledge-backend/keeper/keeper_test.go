@@ -0,0 +1,304 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"pledge-backend/contracts/pledgepool"
+	"pledge-backend/model"
+
+	"ethkit/preflight"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+	"gorm.io/gorm"
+)
+
+// chain 是部署了 testdata/PledgePoolStub 的模拟链，账户 0 是管理员，1 是 keeper，2 没有余额
+type chain struct {
+	t       *testing.T
+	sim     *simulated.Backend
+	client  simulated.Client
+	auths   []*bind.TransactOpts
+	pool    common.Address
+	stub    *bind.BoundContract
+	binding *pledgepool.PledgePool
+}
+
+func newChain(t *testing.T) *chain {
+	t.Helper()
+	c := &chain{t: t}
+	alloc := types.GenesisAlloc{}
+	for i := 0; i < 3; i++ {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.auths = append(c.auths, auth)
+		if i < 2 {
+			alloc[auth.From] = types.Account{Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(1e18))}
+		}
+	}
+	c.sim = simulated.NewBackend(alloc)
+	t.Cleanup(func() { c.sim.Close() })
+	c.client = c.sim.Client()
+
+	abiJSON, err := os.ReadFile("testdata/PledgePoolStub.abi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bin, err := os.ReadFile("testdata/PledgePoolStub.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := abi.JSON(strings.NewReader(string(abiJSON)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.pool, _, c.stub, err = bind.DeployContract(c.auths[0], parsed, common.FromHex(string(bin)), c.client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.sim.Commit()
+	if c.binding, err = pledgepool.NewPledgePool(c.pool, c.client); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// admin 用管理员账户调用桩合约并出块
+func (c *chain) admin(method string, args ...any) {
+	c.t.Helper()
+	if _, err := c.stub.Transact(c.auths[0], method, args...); err != nil {
+		c.t.Fatalf("%s: %v", method, err)
+	}
+	c.sim.Commit()
+}
+
+func (c *chain) now() uint64 {
+	c.t.Helper()
+	head, err := c.client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return head.Time
+}
+
+func (c *chain) states() []uint64 {
+	c.t.Helper()
+	var out []uint64
+	for pid := int64(0); pid < 3; pid++ {
+		s, err := c.binding.GetPoolState(&bind.CallOpts{}, big.NewInt(pid))
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		out = append(out, s.Uint64())
+	}
+	return out
+}
+
+func (c *chain) keeper(db *gorm.DB, account int, retryAfter time.Duration) *Keeper {
+	c.t.Helper()
+	k, err := New(Config{Pool: c.pool, RetryAfter: retryAfter}, db, c.client, c.auths[account])
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return k
+}
+
+func (c *chain) tick(k *Keeper) {
+	c.t.Helper()
+	if err := k.Tick(context.Background()); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func records(t *testing.T, db *gorm.DB) []model.KeeperTx {
+	t.Helper()
+	var out []model.KeeperTx
+	if err := db.Order("id").Find(&out).Error; err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func requireStates(t *testing.T, c *chain, want ...uint64) {
+	t.Helper()
+	if got := c.states(); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Fatalf("pool states = %v, want %v", got, want)
+	}
+}
+
+func TestKeeper(t *testing.T) {
+	c := newChain(t)
+	db, err := model.Open(filepath.Join(t.TempDir(), "pledge.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := c.now()
+	settle, end := start+100, start+1000
+	threshold := big.NewInt(2e7) // 20%
+	for pid := int64(0); pid < 3; pid++ {
+		c.admin("createPoolInfo", new(big.Int).SetUint64(settle), new(big.Int).SetUint64(end), uint64(5e6),
+			big.NewInt(1e6), big.NewInt(2e8), common.Address{}, common.Address{}, common.HexToAddress("0x01"), common.HexToAddress("0x02"), threshold)
+		// 抵押品价值是出借额的 2 倍，高于 120% 的清算线
+		c.admin("setPrice", big.NewInt(pid), big.NewInt(2e8))
+	}
+	// 池 2 没有存款，结算后是 UNDONE
+	c.admin("deposit", big.NewInt(0), big.NewInt(100), big.NewInt(100))
+	c.admin("deposit", big.NewInt(1), big.NewInt(100), big.NewInt(100))
+
+	// 模拟链 Rollback 之后交易池的最低小费变成 1 gwei，keeper 按这个出价，重发时才不会被拒
+	c.auths[1].GasTipCap = big.NewInt(params.GWei)
+	k := c.keeper(db, 1, time.Hour)
+	c.tick(k)
+	if n := len(records(t, db)); n != 0 {
+		t.Fatalf("submitted %d txs before settle time", n)
+	}
+
+	// 到结算时间：三个池子各一笔 settle，nonce 连续
+	if err := c.sim.AdjustTime(101 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	c.tick(k)
+	recs := records(t, db)
+	if len(recs) != 3 {
+		t.Fatalf("records = %+v", recs)
+	}
+	for i, r := range recs {
+		if r.Pool != uint64(i) || r.Action != string(Settle) || r.Nonce != uint64(i) || r.Status != model.TxPending {
+			t.Fatalf("record %d = %+v", i, r)
+		}
+	}
+
+	// 还没出块时再检查、或者重启 keeper，都不会重复提交
+	c.tick(k)
+	k = c.keeper(db, 1, time.Hour)
+	c.tick(k)
+	if n := len(records(t, db)); n != 3 {
+		t.Fatalf("duplicate submissions: %d records", n)
+	}
+	c.sim.Commit()
+	c.tick(k)
+	for _, r := range records(t, db) {
+		if r.Status != model.TxMined || r.Block == 0 {
+			t.Fatalf("after commit: %+v", r)
+		}
+	}
+	requireStates(t, c, model.StateExecution, model.StateExecution, model.StateUndone)
+
+	// 池 1 的抵押品跌到出借额以下，清算交易被节点丢掉后按原 nonce 重发
+	c.admin("setPrice", big.NewInt(1), big.NewInt(1e8))
+	c.tick(k)
+	liq := records(t, db)[3]
+	if liq.Pool != 1 || liq.Action != string(Liquidate) || liq.Nonce != 3 {
+		t.Fatalf("liquidate record = %+v", liq)
+	}
+	c.sim.Rollback()
+	if _, _, err := c.client.TransactionByHash(context.Background(), common.HexToHash(liq.TxHash)); err == nil {
+		t.Fatal("tx still in pool after rollback")
+	}
+	c.tick(k)
+	if _, pending, err := c.client.TransactionByHash(context.Background(), common.HexToHash(liq.TxHash)); err != nil || !pending {
+		t.Fatalf("tx not rebroadcast: %v", err)
+	}
+	c.sim.Commit()
+	c.tick(k)
+	requireStates(t, c, model.StateExecution, model.StateLiquidation, model.StateUndone)
+	if recs := records(t, db); len(recs) != 4 || recs[3].Status != model.TxMined {
+		t.Fatalf("records after liquidation = %+v", recs)
+	}
+
+	// 没有余额的账户：preflight 在签名前发现付不起手续费，交易不签名、不落库，也不占用 nonce
+	if err := c.sim.AdjustTime(1000 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	c.auths[2].GasTipCap = big.NewInt(params.GWei)
+	poor := c.keeper(db, 2, time.Hour)
+	if err := poor.submit(context.Background(), 0, Finish); !errors.Is(err, preflight.ErrInsufficientFunds) {
+		t.Fatalf("unaffordable submit: %v", err)
+	}
+	c.tick(poor)
+	if n := len(records(t, db)); n != 4 {
+		t.Fatalf("unaffordable action recorded: %d records", n)
+	}
+
+	// 充值后出价低于 baseFee：preflight 不检查单价，节点拒绝交易，记录标记为 failed，不再重发
+	fund(t, c, c.auths[2].From)
+	c.auths[2].GasFeeCap, c.auths[2].GasTipCap = big.NewInt(1), big.NewInt(1)
+	poor = c.keeper(db, 2, time.Hour)
+	c.tick(poor)
+	failed := records(t, db)[4]
+	if failed.Pool != 0 || failed.Action != string(Finish) || failed.Status != model.TxFailed ||
+		failed.Nonce != 0 || failed.Error == "" {
+		t.Fatalf("rejected record = %+v", failed)
+	}
+	c.tick(poor)
+	if recs := records(t, db); len(recs) != 5 || recs[4].Status != model.TxFailed {
+		t.Fatalf("retried before RetryAfter: %+v", recs[4:])
+	}
+	if _, _, err := c.client.TransactionByHash(context.Background(), common.HexToHash(failed.TxHash)); err == nil {
+		t.Fatal("rejected tx was rebroadcast")
+	}
+
+	// 出价恢复正常，过了重试间隔，用同一个 nonce 重新提交
+	c.auths[2].GasFeeCap, c.auths[2].GasTipCap = nil, big.NewInt(params.GWei)
+	poor = c.keeper(db, 2, time.Nanosecond)
+	c.tick(poor)
+	retry := records(t, db)[5]
+	if retry.Action != string(Finish) || retry.Nonce != 0 || retry.Status != model.TxPending {
+		t.Fatalf("retry record = %+v", retry)
+	}
+	c.sim.Commit()
+	c.tick(poor)
+	requireStates(t, c, model.StateFinish, model.StateLiquidation, model.StateUndone)
+	if n := len(records(t, db)); n != 6 {
+		t.Fatalf("%d records after finish, want 6", n)
+	}
+}
+
+func fund(t *testing.T, c *chain, to common.Address) {
+	t.Helper()
+	ctx := context.Background()
+	from := c.auths[0]
+	nonce, err := c.client.PendingNonceAt(ctx, from.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tip := big.NewInt(params.GWei)
+	head, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := from.Signer(from.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1337),
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2))),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1e18),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.client.SendTransaction(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	c.sim.Commit()
+}
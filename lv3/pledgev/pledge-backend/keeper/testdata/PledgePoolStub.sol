@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: MIT
+// keeper 测试部署到模拟链上的 PledgePool。仓库里的 PledgePool.sol 不完整、编译不了，
+// 这里只实现 keeper 用到的部分：poolBaseInfo / poolDataInfo 的存储布局、createPoolInfo、
+// checkout* 判断和 settle / finish / liquidate 的状态迁移，条件和上游 PledgePool 一致；
+// 存款和价格由 deposit / setPrice 直接设置，不走代币转账和预言机。
+//
+//	SOLJSON=/path/to/soljson.js node ../../../lv2/task2/ethkit/contracts/solc.js -o keeper/testdata keeper/testdata/PledgePoolStub.sol:PledgePoolStub
+pragma solidity ^0.8.20;
+
+contract PledgePoolStub {
+    enum PoolState { MATCH, EXECUTION, FINISH, LIQUIDATION, UNDONE }
+
+    struct PoolBaseInfo {
+        uint256 settleTime;
+        uint256 endTime;
+        uint256 interestRate;
+        uint256 maxSupply;
+        uint256 lendSupply;
+        uint256 borrowSupply;
+        uint256 martgageRate;
+        address lendToken;
+        address borrowToken;
+        PoolState state;
+        address spCoin;
+        address jpCoin;
+        uint256 autoLiquidateThreshold;
+    }
+
+    struct PoolDataInfo {
+        uint256 settleAmountLend;
+        uint256 settleAmountBorrow;
+        uint256 finishAmountLend;
+        uint256 finishAmountBorrow;
+        uint256 liquidationAmounLend;
+        uint256 liquidationAmounBorrow;
+    }
+
+    uint256 internal constant baseDecimal = 1e8;
+
+    PoolBaseInfo[] public poolBaseInfo;
+    PoolDataInfo[] public poolDataInfo;
+    // 抵押品相对出借币的价格，1e8 精度
+    mapping(uint256 => uint256) public price;
+
+    event StateChange(uint256 indexed pid, uint256 indexed beforeState, uint256 indexed afterState);
+
+    function poolLength() external view returns (uint256) {
+        return poolBaseInfo.length;
+    }
+
+    function createPoolInfo(uint256 _settleTime, uint256 _endTime, uint64 _interestRate,
+                            uint256 _maxSupply, uint256 _martgageRate, address _lendToken, address _borrowToken,
+                            address _spToken, address _jpToken, uint256 _autoLiquidateThreshold) public {
+        require(_endTime > _settleTime, "createPool:end time grate than settle time");
+        poolBaseInfo.push(PoolBaseInfo({
+            settleTime: _settleTime,
+            endTime: _endTime,
+            interestRate: _interestRate,
+            maxSupply: _maxSupply,
+            lendSupply: 0,
+            borrowSupply: 0,
+            martgageRate: _martgageRate,
+            lendToken: _lendToken,
+            borrowToken: _borrowToken,
+            state: PoolState.MATCH,
+            spCoin: _spToken,
+            jpCoin: _jpToken,
+            autoLiquidateThreshold: _autoLiquidateThreshold
+        }));
+        poolDataInfo.push(PoolDataInfo(0, 0, 0, 0, 0, 0));
+    }
+
+    function deposit(uint256 _pid, uint256 _lend, uint256 _borrow) external {
+        poolBaseInfo[_pid].lendSupply += _lend;
+        poolBaseInfo[_pid].borrowSupply += _borrow;
+    }
+
+    function setPrice(uint256 _pid, uint256 _price) external {
+        price[_pid] = _price;
+    }
+
+    function getPoolState(uint256 _pid) public view returns (uint256) {
+        return uint256(poolBaseInfo[_pid].state);
+    }
+
+    function checkoutSettle(uint256 _pid) public view returns (bool) {
+        return block.timestamp > poolBaseInfo[_pid].settleTime;
+    }
+
+    // settle 两边都有存款时进入 EXECUTION，否则 UNDONE
+    function settle(uint256 _pid) public {
+        PoolBaseInfo storage pool = poolBaseInfo[_pid];
+        require(checkoutSettle(_pid), "settle: less than settleTime");
+        require(pool.state == PoolState.MATCH, "settle: pool state must be match");
+        if (pool.lendSupply > 0 && pool.borrowSupply > 0) {
+            PoolDataInfo storage data = poolDataInfo[_pid];
+            data.settleAmountLend = pool.lendSupply;
+            data.settleAmountBorrow = pool.borrowSupply;
+            pool.state = PoolState.EXECUTION;
+            emit StateChange(_pid, uint256(PoolState.MATCH), uint256(PoolState.EXECUTION));
+        } else {
+            pool.state = PoolState.UNDONE;
+            emit StateChange(_pid, uint256(PoolState.MATCH), uint256(PoolState.UNDONE));
+        }
+    }
+
+    function checkoutFinish(uint256 _pid) public view returns (bool) {
+        return block.timestamp > poolBaseInfo[_pid].endTime;
+    }
+
+    function finish(uint256 _pid) public {
+        PoolBaseInfo storage pool = poolBaseInfo[_pid];
+        require(checkoutFinish(_pid), "finish: less than end time");
+        require(pool.state == PoolState.EXECUTION, "finish: pool state must be execution");
+        PoolDataInfo storage data = poolDataInfo[_pid];
+        data.finishAmountLend = data.settleAmountLend;
+        data.finishAmountBorrow = data.settleAmountBorrow;
+        pool.state = PoolState.FINISH;
+        emit StateChange(_pid, uint256(PoolState.EXECUTION), uint256(PoolState.FINISH));
+    }
+
+    // checkoutLiquidate 抵押品价值低于 结算出借额 * (1 + 自动清算阈值) 时可以清算
+    function checkoutLiquidate(uint256 _pid) public view returns (bool) {
+        PoolBaseInfo storage pool = poolBaseInfo[_pid];
+        PoolDataInfo storage data = poolDataInfo[_pid];
+        uint256 borrowValue = data.settleAmountBorrow * price[_pid] / baseDecimal;
+        uint256 valueThreshold = data.settleAmountLend * (baseDecimal + pool.autoLiquidateThreshold) / baseDecimal;
+        return borrowValue < valueThreshold;
+    }
+
+    function liquidate(uint256 _pid) public {
+        PoolBaseInfo storage pool = poolBaseInfo[_pid];
+        require(pool.state == PoolState.EXECUTION, "liquidate: pool state must be execution");
+        require(checkoutLiquidate(_pid), "liquidate: time is not up or value is enough");
+        PoolDataInfo storage data = poolDataInfo[_pid];
+        data.liquidationAmounLend = data.settleAmountLend;
+        data.liquidationAmounBorrow = data.settleAmountBorrow;
+        pool.state = PoolState.LIQUIDATION;
+        emit StateChange(_pid, uint256(PoolState.EXECUTION), uint256(PoolState.LIQUIDATION));
+    }
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"pledge-backend/api"
+	"pledge-backend/indexer"
+	"pledge-backend/model"
+	"pledge-backend/pricewatch"
+	"pledge-backend/service"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/gin-gonic/gin"
+)
+
+// PledgePool 后端：索引合约事件写进 SQLite，并提供池子、用户仓位和历史记录的 REST 接口；
+// 指定 -oracle 时同时监控预言机价格
+// go run . -rpc http://127.0.0.1:8545 -pool 0x... -from-block 0
+// go run . -pool 0x... -oracle 0x... -assets assets.json -reference reference.json
+func main() {
+	rpcURL := flag.String("rpc", "http://127.0.0.1:8545", "JSON-RPC endpoint")
+	pool := flag.String("pool", "", "PledgePool contract address")
+	dbPath := flag.String("db", "pledge.db", "SQLite database file")
+	addr := flag.String("addr", ":8080", "HTTP listen address")
+	fromBlock := flag.Uint64("from-block", 0, "block to start indexing from on first run")
+	confirmations := flag.Uint64("confirmations", 3, "blocks to wait before indexing")
+	interval := flag.Duration("poll", 5*time.Second, "polling interval")
+	oracleAddr := flag.String("oracle", "", "BscPledgeOracle address, enables the price watcher")
+	assetsFile := flag.String("assets", "assets.json", "assets to watch: [{\"name\": ..., \"address\": ...}]")
+	referenceFile := flag.String("reference", "", "reference prices: {\"<name or address>\": \"<price>\"}")
+	priceInterval := flag.Duration("price-poll", 30*time.Second, "oracle polling interval")
+	staleAfter := flag.Duration("stale-after", time.Hour, "flag prices unchanged for longer than this")
+	maxDeviation := flag.Float64("max-deviation", 0.05, "flag prices deviating from the reference by more than this fraction")
+	flag.Parse()
+	if !common.IsHexAddress(*pool) {
+		log.Fatal("-pool is required")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	client, err := ethclient.Dial(*rpcURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, err := model.Open(*dbPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ix, err := indexer.New(indexer.Config{
+		Pool:          common.HexToAddress(*pool),
+		FromBlock:     *fromBlock,
+		Confirmations: *confirmations,
+		Interval:      *interval,
+	}, db, client)
+	if err != nil {
+		log.Fatal(err)
+	}
+	svc, err := service.New(db, common.HexToAddress(*pool), client)
+	if err != nil {
+		log.Fatal(err)
+	}
+	go ix.Run(ctx)
+
+	var prices *pricewatch.Watcher
+	if *oracleAddr != "" {
+		if !common.IsHexAddress(*oracleAddr) {
+			log.Fatalf("invalid -oracle %s", *oracleAddr)
+		}
+		assets, err := pricewatch.LoadAssets(*assetsFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		var ref pricewatch.Reference
+		if *referenceFile != "" {
+			ref = pricewatch.NewFileReference(*referenceFile)
+		}
+		prices, err = pricewatch.New(pricewatch.Config{
+			Oracle:       common.HexToAddress(*oracleAddr),
+			Assets:       assets,
+			Interval:     *priceInterval,
+			StaleAfter:   *staleAfter,
+			MaxDeviation: *maxDeviation,
+		}, db, client, ref)
+		if err != nil {
+			log.Fatal(err)
+		}
+		go prices.Run(ctx)
+	}
+
+	router := gin.Default()
+	api.Register(router, svc, ix, prices)
+	srv := &http.Server{Addr: *addr, Handler: router, ReadTimeout: 10 * time.Second, WriteTimeout: 30 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+	log.Printf("listening on %s", *addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+}
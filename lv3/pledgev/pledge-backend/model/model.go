@@ -0,0 +1,119 @@
+package model
+
+import (
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// 池子状态，和合约里 PoolState 枚举的顺序一致
+const (
+	StateMatch = iota
+	StateExecution
+	StateFinish
+	StateLiquidation
+	StateUndone
+)
+
+var StateNames = []string{"MATCH", "EXECUTION", "FINISH", "LIQUIDATION", "UNDONE"}
+
+// Pool 是 poolBaseInfo + poolDataInfo 的快照，金额都是十进制字符串，避免 uint256 溢出
+type Pool struct {
+	ID                      uint64 `gorm:"primaryKey;autoIncrement:false" json:"pid"`
+	SettleTime              int64  `json:"settle_time"`
+	EndTime                 int64  `json:"end_time"`
+	InterestRate            string `json:"interest_rate"` // 1e8 = 100%
+	MaxSupply               string `json:"max_supply"`
+	LendSupply              string `json:"lend_supply"`
+	BorrowSupply            string `json:"borrow_supply"`
+	MortgageRate            string `json:"mortgage_rate"` // 1e8 = 100%
+	LendToken               string `json:"lend_token"`
+	BorrowToken             string `json:"borrow_token"`
+	State                   int    `json:"state"`
+	SpCoin                  string `json:"sp_coin"`
+	JpCoin                  string `json:"jp_coin"`
+	AutoLiquidateThreshold  string `json:"auto_liquidate_threshold"`
+	SettleAmountLend        string `json:"settle_amount_lend"`
+	SettleAmountBorrow      string `json:"settle_amount_borrow"`
+	FinishAmountLend        string `json:"finish_amount_lend"`
+	FinishAmountBorrow      string `json:"finish_amount_borrow"`
+	LiquidationAmountLend   string `json:"liquidation_amount_lend"`
+	LiquidationAmountBorrow string `json:"liquidation_amount_borrow"`
+	UpdatedBlock            uint64 `json:"updated_block"`
+}
+
+// Event 是一条 PledgePool 日志。用户操作的事件里没有池 ID，索引时从交易 input 的 _pid 参数解出，解不出时为 -1
+type Event struct {
+	ID       uint   `gorm:"primaryKey" json:"-"`
+	Block    uint64 `gorm:"uniqueIndex:idx_event_log" json:"block"`
+	LogIndex uint   `gorm:"uniqueIndex:idx_event_log" json:"log_index"`
+	TxHash   string `gorm:"index" json:"tx_hash"`
+	Time     int64  `json:"time"`
+	Name     string `gorm:"index" json:"name"`
+	Pool     int64  `gorm:"index" json:"pid"`
+	User     string `gorm:"index" json:"user,omitempty"` // 小写十六进制地址
+	Token    string `json:"token,omitempty"`
+	Amount   string `json:"amount,omitempty"`
+	Amount2  string `json:"amount2,omitempty"` // mintAmount / burnAmount / Swap 的 toValue
+	Args     string `json:"args"`              // 全部参数的 JSON
+}
+
+// Price 是预言机价格时间序列里的一个点，Stale / Deviation 是写入时的检查结果
+type Price struct {
+	ID         uint    `gorm:"primaryKey" json:"-"`
+	Asset      string  `gorm:"index:idx_price_asset_time" json:"asset"` // 小写十六进制地址
+	Name       string  `json:"name"`
+	Block      uint64  `json:"block"`
+	Time       int64   `gorm:"index:idx_price_asset_time" json:"time"`
+	Price      string  `json:"price"`               // 合约返回的原始值，1e8 精度
+	LastUpdate int64   `json:"last_update"`         // 价格最后一次变化（或聚合器 updatedAt）的时间
+	Reference  string  `json:"reference,omitempty"` // 参考价格，没有时为空
+	Deviation  float64 `json:"deviation"`           // |price - reference| / reference
+	Stale      bool    `json:"stale"`
+	Deviated   bool    `json:"deviated"`
+}
+
+// keeper 交易状态
+const (
+	TxPending  = "pending"
+	TxMined    = "mined"
+	TxReverted = "reverted"
+	TxDropped  = "dropped" // 同一个 nonce 被别的交易用掉了
+	TxFailed   = "failed"  // 节点拒绝了交易（余额不足、手续费过低等），nonce 没有被占用
+)
+
+// KeeperTx 是 keeper 发出的状态推进交易。签名后先落库再广播，重启后据此判断是否重复提交或需要重发
+type KeeperTx struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Pool      uint64    `gorm:"index:idx_keeper_pool_action" json:"pid"`
+	Action    string    `gorm:"index:idx_keeper_pool_action" json:"action"` // settle / finish / liquidate
+	From      string    `json:"from"`
+	Nonce     uint64    `json:"nonce"`
+	TxHash    string    `gorm:"uniqueIndex" json:"tx_hash"`
+	Raw       []byte    `json:"-"`
+	Status    string    `gorm:"index" json:"status"`
+	Block     uint64    `json:"block,omitempty"`
+	Error     string    `json:"error,omitempty"` // 节点拒绝的原因
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Cursor 记录索引进度
+type Cursor struct {
+	Name  string `gorm:"primaryKey"`
+	Block uint64
+}
+
+// Open 打开 SQLite 数据库并建表
+func Open(path string) (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{Logger: logger.Default.LogMode(logger.Warn)})
+	if err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&Pool{}, &Event{}, &Price{}, &KeeperTx{}, &Cursor{}); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
@@ -0,0 +1,102 @@
+package pricewatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Asset 是要监控的资产，价格按 Address 从预言机读取
+type Asset struct {
+	Name    string         `json:"name"`
+	Address common.Address `json:"address"`
+}
+
+// LoadAssets 读取资产列表 [{"name": "BUSD", "address": "0x..."}]
+func LoadAssets(path string) ([]Asset, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var assets []Asset
+	if err := json.Unmarshal(data, &assets); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return assets, nil
+}
+
+// Reference 提供用来对比的参考价格，精度和预言机一致，没有这个资产时 ok 为 false
+type Reference interface {
+	Price(ctx context.Context, asset Asset) (price *big.Int, ok bool, err error)
+}
+
+// Static 是固定的参考价格，键是资产名或小写地址
+type Static map[string]*big.Int
+
+func (s Static) Price(_ context.Context, asset Asset) (*big.Int, bool, error) {
+	if p, ok := s[asset.Name]; ok {
+		return p, true, nil
+	}
+	p, ok := s[strings.ToLower(asset.Address.Hex())]
+	return p, ok, nil
+}
+
+// FileReference 从 JSON 文件读取参考价格 {"BUSD": "100000000"}，文件修改后自动重新加载
+type FileReference struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	prices  Static
+}
+
+func NewFileReference(path string) *FileReference {
+	return &FileReference{path: path}
+}
+
+func (f *FileReference) Price(ctx context.Context, asset Asset) (*big.Int, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return nil, false, err
+	}
+	if !info.ModTime().Equal(f.modTime) {
+		if err := f.load(); err != nil {
+			return nil, false, err
+		}
+		f.modTime = info.ModTime()
+	}
+	return f.prices.Price(ctx, asset)
+}
+
+func (f *FileReference) load() error {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return err
+	}
+	var raw map[string]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("%s: %w", f.path, err)
+	}
+	prices := make(Static, len(raw))
+	for k, v := range raw {
+		p, ok := new(big.Int).SetString(v, 10)
+		if !ok {
+			return fmt.Errorf("%s: invalid price %q for %s", f.path, v, k)
+		}
+		if common.IsHexAddress(k) {
+			k = strings.ToLower(k)
+		}
+		prices[k] = p
+	}
+	f.prices = prices
+	return nil
+}
@@ -0,0 +1,221 @@
+package pricewatch
+
+import (
+	"context"
+	"log"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"pledge-backend/contracts/oracle"
+	"pledge-backend/model"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+)
+
+// Backend 是价格监控需要的链接口，*ethclient.Client 满足
+type Backend interface {
+	bind.ContractCaller
+	ethereum.BlockNumberReader
+}
+
+type Config struct {
+	Oracle       common.Address
+	Assets       []Asset
+	Interval     time.Duration
+	StaleAfter   time.Duration // 价格超过这么久没有变化就认为过期
+	MaxDeviation float64       // 和参考价格的最大偏离，0.05 表示 5%，0 表示不检查
+}
+
+// Watcher 定时读取 BscPledgeOracle 的价格写进时间序列，并标记过期和偏离过大的价格
+type Watcher struct {
+	cfg     Config
+	db      *gorm.DB
+	backend Backend
+	oracle  *oracle.BscPledgeOracleCaller
+	ref     Reference
+	Now     func() time.Time
+
+	mu     sync.RWMutex
+	latest map[common.Address]model.Price
+}
+
+// New 创建 Watcher，ref 为 nil 时不做偏离检查
+func New(cfg Config, db *gorm.DB, backend Backend, ref Reference) (*Watcher, error) {
+	if cfg.Interval == 0 {
+		cfg.Interval = 30 * time.Second
+	}
+	if cfg.StaleAfter == 0 {
+		cfg.StaleAfter = time.Hour
+	}
+	contract, err := oracle.NewBscPledgeOracleCaller(cfg.Oracle, backend)
+	if err != nil {
+		return nil, err
+	}
+	w := &Watcher{
+		cfg:     cfg,
+		db:      db,
+		backend: backend,
+		oracle:  contract,
+		ref:     ref,
+		Now:     time.Now,
+		latest:  map[common.Address]model.Price{},
+	}
+	// 从数据库恢复每个资产的最新价格，重启后价格没变时 LastUpdate 不会被重置
+	for _, a := range cfg.Assets {
+		var p model.Price
+		if err := db.Where("asset = ?", hexAddress(a.Address)).Order("time desc, id desc").Limit(1).Find(&p).Error; err != nil {
+			return nil, err
+		}
+		if p.Asset != "" {
+			w.latest[a.Address] = p
+		}
+	}
+	return w, nil
+}
+
+// Run 轮询直到 ctx 取消
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if err := w.Poll(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("pricewatch: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll 读取一次所有资产的价格。单个资产失败不影响其他资产，返回最后一个错误
+func (w *Watcher) Poll(ctx context.Context) error {
+	block, err := w.backend.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}
+	var lastErr error
+	for _, a := range w.cfg.Assets {
+		p, err := w.check(ctx, opts, a)
+		if err != nil {
+			log.Printf("pricewatch: %s: %v", a.Name, err)
+			lastErr = err
+			continue
+		}
+		p.Block = block
+		if err := w.db.Create(&p).Error; err != nil {
+			return err
+		}
+		w.mu.Lock()
+		prev, seen := w.latest[a.Address]
+		w.latest[a.Address] = p
+		w.mu.Unlock()
+		if p.Stale && (!seen || !prev.Stale) {
+			log.Printf("pricewatch: %s price %s is stale, last updated %s", a.Name, p.Price, time.Unix(p.LastUpdate, 0).Format(time.RFC3339))
+		}
+		if p.Deviated && (!seen || !prev.Deviated) {
+			log.Printf("pricewatch: %s price %s deviates %.2f%% from reference %s", a.Name, p.Price, p.Deviation*100, p.Reference)
+		}
+		if seen && (prev.Stale || prev.Deviated) && !p.Stale && !p.Deviated {
+			log.Printf("pricewatch: %s price %s back to normal", a.Name, p.Price)
+		}
+	}
+	return lastErr
+}
+
+func (w *Watcher) check(ctx context.Context, opts *bind.CallOpts, a Asset) (model.Price, error) {
+	price, err := w.oracle.GetPrice(opts, a.Address)
+	if err != nil {
+		return model.Price{}, err
+	}
+	now := w.Now()
+	p := model.Price{
+		Asset: hexAddress(a.Address),
+		Name:  a.Name,
+		Time:  now.Unix(),
+		Price: price.String(),
+	}
+
+	// 配置了 Chainlink 聚合器的资产用聚合器的 updatedAt，手动喂价的资产用价格最后一次变化的时间
+	aggregator, _, err := w.oracle.GetAssetsAggregator(opts, a.Address)
+	if err != nil {
+		return model.Price{}, err
+	}
+	if aggregator != (common.Address{}) {
+		feed, err := oracle.NewAggregatorV3Caller(aggregator, w.backend)
+		if err != nil {
+			return model.Price{}, err
+		}
+		round, err := feed.LatestRoundData(opts)
+		if err != nil {
+			return model.Price{}, err
+		}
+		p.LastUpdate = round.UpdatedAt.Int64()
+	} else {
+		w.mu.RLock()
+		prev, ok := w.latest[a.Address]
+		w.mu.RUnlock()
+		p.LastUpdate = now.Unix()
+		if ok && prev.Price == p.Price {
+			p.LastUpdate = prev.LastUpdate
+		}
+	}
+	p.Stale = price.Sign() == 0 || now.Sub(time.Unix(p.LastUpdate, 0)) > w.cfg.StaleAfter
+
+	if w.ref != nil {
+		ref, ok, err := w.ref.Price(ctx, a)
+		if err != nil {
+			return model.Price{}, err
+		}
+		if ok && ref.Sign() > 0 {
+			p.Reference = ref.String()
+			p.Deviation = Deviation(price, ref)
+			p.Deviated = w.cfg.MaxDeviation > 0 && p.Deviation > w.cfg.MaxDeviation
+		}
+	}
+	return p, nil
+}
+
+// Latest 返回每个资产最近一次读到的价格，按名字排序
+func (w *Watcher) Latest() []model.Price {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	prices := make([]model.Price, 0, len(w.latest))
+	for _, p := range w.latest {
+		prices = append(prices, p)
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Name < prices[j].Name })
+	return prices
+}
+
+// History 按时间倒序返回一个资产的价格序列，asset 可以是名字或地址
+func (w *Watcher) History(asset string, since time.Time, limit, offset int) ([]model.Price, error) {
+	q := w.db.Where("time >= ?", since.Unix())
+	if common.IsHexAddress(asset) {
+		q = q.Where("asset = ?", strings.ToLower(asset))
+	} else {
+		q = q.Where("name = ?", asset)
+	}
+	var prices []model.Price
+	err := q.Order("time desc, id desc").Limit(limit).Offset(offset).Find(&prices).Error
+	return prices, err
+}
+
+// Deviation 返回 |price - ref| / ref
+func Deviation(price, ref *big.Int) float64 {
+	diff := new(big.Int).Sub(price, ref)
+	f, _ := new(big.Rat).SetFrac(diff.Abs(diff), ref).Float64()
+	return f
+}
+
+func hexAddress(a common.Address) string {
+	return strings.ToLower(a.Hex())
+}
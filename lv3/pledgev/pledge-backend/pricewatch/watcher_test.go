@@ -0,0 +1,213 @@
+package pricewatch
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"pledge-backend/contracts/oracle"
+	"pledge-backend/model"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+)
+
+var (
+	oracleAddr     = common.HexToAddress("0x9fe46736679d2d9a65f0992f2272de9f3c7fa6e0")
+	btcbAggregator = common.HexToAddress("0xcf7ed3acca5a467e9e704c703e8d87f634fb0fc9")
+	busd           = Asset{Name: "BUSD", Address: common.HexToAddress("0xe9e7cea3dedca5984780bafc599bd69add087d56")}
+	btcb           = Asset{Name: "BTCB", Address: common.HexToAddress("0x7130d2a12b9bcbfae4f2634d864a1ee1ce3ead9c")}
+)
+
+// fakeOracle 按 BscPledgeOracle / AggregatorV3 的 ABI 回答 eth_call。
+// BUSD 是手动喂价，BTCB 配置了聚合器，聚合器的 updatedAt 由 updatedAt 字段给出
+type fakeOracle struct {
+	oracle, aggregator *abi.ABI
+	block              uint64
+	prices             map[common.Address]*big.Int
+	updatedAt          int64
+}
+
+func newFakeOracle(t *testing.T) *fakeOracle {
+	t.Helper()
+	o, err := oracle.BscPledgeOracleMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := oracle.AggregatorV3MetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &fakeOracle{oracle: o, aggregator: a, block: 100, prices: map[common.Address]*big.Int{}}
+}
+
+func (f *fakeOracle) BlockNumber(ctx context.Context) (uint64, error) {
+	return f.block, nil
+}
+
+func (f *fakeOracle) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (f *fakeOracle) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	contract := f.oracle
+	if *call.To == btcbAggregator {
+		contract = f.aggregator
+	}
+	method, err := contract.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "getPrice":
+		price, ok := f.prices[args[0].(common.Address)]
+		if !ok {
+			price = new(big.Int)
+		}
+		return method.Outputs.Pack(price)
+	case "getAssetsAggregator":
+		if args[0].(common.Address) == btcb.Address {
+			return method.Outputs.Pack(btcbAggregator, big.NewInt(18))
+		}
+		return method.Outputs.Pack(common.Address{}, big.NewInt(0))
+	case "latestRoundData":
+		return method.Outputs.Pack(big.NewInt(1), f.prices[btcb.Address], big.NewInt(f.updatedAt), big.NewInt(f.updatedAt), big.NewInt(1))
+	}
+	return nil, fmt.Errorf("unexpected call %s", method.Name)
+}
+
+func openDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := model.Open(filepath.Join(t.TempDir(), "pledge.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func usd(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e8))
+}
+
+func TestPoll(t *testing.T) {
+	db := openDB(t)
+	backend := newFakeOracle(t)
+	start := time.Unix(1700000000, 0)
+	now := start
+	cfg := Config{Oracle: oracleAddr, Assets: []Asset{busd, btcb}, StaleAfter: 10 * time.Minute, MaxDeviation: 0.05}
+	// 参考价格用固定的 map，按名字或小写地址查
+	ref := Static{"BUSD": usd(1), "0x7130d2a12b9bcbfae4f2634d864a1ee1ce3ead9c": usd(60000)}
+	newWatcher := func() *Watcher {
+		t.Helper()
+		w, err := New(cfg, db, backend, ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Now = func() time.Time { return now }
+		return w
+	}
+	w := newWatcher()
+
+	type want struct {
+		price, reference string
+		lastUpdate       int64
+		stale, deviated  bool
+	}
+	steps := []struct {
+		name    string
+		advance time.Duration
+		busd    *big.Int
+		btcb    *big.Int
+		feedAge time.Duration // 聚合器 updatedAt 距离 now
+		busdW   want
+		btcbW   want
+	}{
+		{"fresh", 0, usd(1), usd(61000), 0,
+			want{"100000000", "100000000", 1700000000, false, false},
+			want{"6100000000000", "6000000000000", 1700000000, false, false}},
+		// BUSD 价格没变，LastUpdate 保持第一次读到的时间；聚合器按 updatedAt 判断
+		{"unchanged", 5 * time.Minute, usd(1), usd(61000), 5 * time.Minute,
+			want{"100000000", "100000000", 1700000000, false, false},
+			want{"6100000000000", "6000000000000", 1700000000, false, false}},
+		// 聚合器停在第一次的轮次
+		{"stale", 6 * time.Minute, usd(1), usd(61000), 11 * time.Minute,
+			want{"100000000", "100000000", 1700000000, true, false},
+			want{"6100000000000", "6000000000000", 1700000000, true, false}},
+		// BUSD 脱锚 10%，价格变化后不再过期；BTCB 聚合器更新
+		{"deviated", time.Minute, big.NewInt(0.9e8), usd(66000), 0,
+			want{"90000000", "100000000", 1700000720, false, true},
+			want{"6600000000000", "6000000000000", 1700000720, false, true}},
+		// 价格为 0 一律视为过期
+		{"zero", time.Minute, big.NewInt(0), usd(60000), 0,
+			want{"0", "100000000", 1700000780, true, true},
+			want{"6000000000000", "6000000000000", 1700000780, false, false}},
+	}
+	for _, s := range steps {
+		now = now.Add(s.advance)
+		backend.block++
+		backend.prices[busd.Address] = s.busd
+		backend.prices[btcb.Address] = s.btcb
+		backend.updatedAt = now.Add(-s.feedAge).Unix()
+		if err := w.Poll(context.Background()); err != nil {
+			t.Fatalf("%s: %v", s.name, err)
+		}
+		latest := w.Latest()
+		if len(latest) != 2 || latest[0].Name != "BTCB" || latest[1].Name != "BUSD" {
+			t.Fatalf("%s: latest = %+v", s.name, latest)
+		}
+		for i, wt := range []want{s.btcbW, s.busdW} {
+			p := latest[i]
+			if p.Price != wt.price || p.Reference != wt.reference || p.LastUpdate != wt.lastUpdate ||
+				p.Stale != wt.stale || p.Deviated != wt.deviated || p.Block != backend.block {
+				t.Errorf("%s: %s = %+v, want %+v", s.name, p.Name, p, wt)
+			}
+		}
+	}
+
+	// 重启后从数据库恢复 LastUpdate，价格没变就不会被当成新价格
+	now = now.Add(11 * time.Minute)
+	w = newWatcher()
+	if err := w.Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if p := w.Latest()[1]; p.LastUpdate != 1700000780 || !p.Stale {
+		t.Fatalf("BUSD after restart = %+v", p)
+	}
+
+	history, err := w.History("BUSD", start, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[0].Price != "0" || history[1].Price != "90000000" {
+		t.Fatalf("history = %+v", history)
+	}
+	if history, err = w.History(btcb.Address.Hex(), start.Add(12*time.Minute), 10, 0); err != nil || len(history) != 3 {
+		t.Fatalf("history by address since = %d, %v", len(history), err)
+	}
+}
+
+func TestDeviation(t *testing.T) {
+	tests := []struct {
+		price, ref int64
+		want       float64
+	}{
+		{100, 100, 0},
+		{90, 100, 0.1},
+		{110, 100, 0.1},
+		{1, 3, 2.0 / 3},
+	}
+	for _, tt := range tests {
+		if got := Deviation(big.NewInt(tt.price), big.NewInt(tt.ref)); got != tt.want {
+			t.Errorf("Deviation(%d, %d) = %v, want %v", tt.price, tt.ref, got, tt.want)
+		}
+	}
+}
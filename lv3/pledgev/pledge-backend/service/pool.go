@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"pledge-backend/contracts/pledgepool"
+	"pledge-backend/model"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+)
+
+// 合约里利率和抵押率的精度，1e8 = 100%
+var rateBase = big.NewInt(1e8)
+
+const year = 365 * 24 * 3600
+
+// PoolView 是池子快照加上计算出的指标
+type PoolView struct {
+	model.Pool
+	StateName        string  `json:"state_name"`
+	FillRate         float64 `json:"fill_rate"`         // lendSupply / maxSupply
+	Utilization      float64 `json:"utilization"`       // 结算时实际借出的比例 settleAmountLend / lendSupply
+	Interest         string  `json:"interest"`          // 到现在（最多到 endTime）为止出借方累计的利息
+	MaturityInterest string  `json:"maturity_interest"` // 到期时的总利息
+}
+
+// LendPosition 和 BorrowPosition 是用户在一个池里的实时状态
+type LendPosition struct {
+	Stake    string `json:"stake"`
+	Refund   string `json:"refund"`   // 结算时没有匹配上、可以退回的部分
+	Matched  string `json:"matched"`  // 按份额分到的实际借出本金
+	Interest string `json:"interest"` // matched 累计的利息
+	Refunded bool   `json:"refunded"`
+	Claimed  bool   `json:"claimed"`
+}
+
+type BorrowPosition struct {
+	Stake    string `json:"stake"`
+	Refund   string `json:"refund"`
+	Refunded bool   `json:"refunded"`
+	Claimed  bool   `json:"claimed"`
+}
+
+type Position struct {
+	Pool   uint64          `json:"pid"`
+	State  string          `json:"state"`
+	Lend   *LendPosition   `json:"lend,omitempty"`
+	Borrow *BorrowPosition `json:"borrow,omitempty"`
+}
+
+type Service struct {
+	db       *gorm.DB
+	contract *pledgepool.PledgePool
+	Now      func() time.Time
+}
+
+func New(db *gorm.DB, address common.Address, backend bind.ContractBackend) (*Service, error) {
+	contract, err := pledgepool.NewPledgePool(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{db: db, contract: contract, Now: time.Now}, nil
+}
+
+func (s *Service) Pools() ([]PoolView, error) {
+	var pools []model.Pool
+	if err := s.db.Order("id").Find(&pools).Error; err != nil {
+		return nil, err
+	}
+	views := make([]PoolView, len(pools))
+	for i, p := range pools {
+		views[i] = s.view(p)
+	}
+	return views, nil
+}
+
+// Pool 返回单个池子，不存在时返回 gorm.ErrRecordNotFound
+func (s *Service) Pool(pid uint64) (PoolView, error) {
+	var p model.Pool
+	if err := s.db.First(&p, pid).Error; err != nil {
+		return PoolView{}, err
+	}
+	return s.view(p), nil
+}
+
+func (s *Service) view(p model.Pool) PoolView {
+	now := s.Now().Unix()
+	settled := bigInt(p.SettleAmountLend)
+	rate := bigInt(p.InterestRate)
+	v := PoolView{
+		Pool:             p,
+		StateName:        stateName(p.State),
+		FillRate:         ratio(bigInt(p.LendSupply), bigInt(p.MaxSupply)),
+		Interest:         "0",
+		MaturityInterest: Interest(settled, rate, p.SettleTime, p.EndTime, p.EndTime).String(),
+	}
+	if p.State != model.StateMatch && p.State != model.StateUndone {
+		v.Utilization = ratio(settled, bigInt(p.LendSupply))
+		v.Interest = Interest(settled, rate, p.SettleTime, p.EndTime, now).String()
+	}
+	return v
+}
+
+// Events 按区块倒序分页返回事件，pid < 0 时不按池过滤，user 为空时不按用户过滤
+func (s *Service) Events(pid int64, user string, limit, offset int) ([]model.Event, error) {
+	q := s.db.Model(&model.Event{})
+	if pid >= 0 {
+		q = q.Where("pool = ?", pid)
+	}
+	if user != "" {
+		q = q.Where("user = ?", strings.ToLower(user))
+	}
+	var events []model.Event
+	err := q.Order("block desc, log_index desc").Limit(limit).Offset(offset).Find(&events).Error
+	return events, err
+}
+
+// Positions 实时读取用户在每个池里的出借和借款信息，没有参与的池不返回
+func (s *Service) Positions(ctx context.Context, user common.Address) ([]Position, error) {
+	pools, err := s.Pools()
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	now := s.Now().Unix()
+	var positions []Position
+	for _, p := range pools {
+		id := new(big.Int).SetUint64(p.ID)
+		lend, err := s.contract.UserLendInfo(opts, user, id)
+		if err != nil {
+			return nil, err
+		}
+		borrow, err := s.contract.UserBorrowInfo(opts, user, id)
+		if err != nil {
+			return nil, err
+		}
+		pos := Position{Pool: p.ID, State: p.StateName}
+		if lend.StakeAmount.Sign() > 0 {
+			pos.Lend = &LendPosition{
+				Stake:    lend.StakeAmount.String(),
+				Refund:   lend.RefundAmount.String(),
+				Matched:  "0",
+				Interest: "0",
+				Refunded: lend.HasNoRefund,
+				Claimed:  lend.HasNoClaim,
+			}
+			if p.State != model.StateMatch && p.State != model.StateUndone {
+				matched := share(lend.StakeAmount, bigInt(p.SettleAmountLend), bigInt(p.LendSupply))
+				pos.Lend.Matched = matched.String()
+				pos.Lend.Interest = Interest(matched, bigInt(p.InterestRate), p.SettleTime, p.EndTime, now).String()
+			}
+		}
+		if borrow.StakeAmount.Sign() > 0 {
+			pos.Borrow = &BorrowPosition{
+				Stake:    borrow.StakeAmount.String(),
+				Refund:   borrow.RefundAmount.String(),
+				Refunded: borrow.HasNoRefund,
+				Claimed:  borrow.HasNoClaim,
+			}
+		}
+		if pos.Lend != nil || pos.Borrow != nil {
+			positions = append(positions, pos)
+		}
+	}
+	return positions, nil
+}
+
+// Interest 按年化利率线性计息：principal * rate / 1e8 * (min(now, end) - settle) / 365 天
+func Interest(principal, rate *big.Int, settleTime, endTime, now int64) *big.Int {
+	elapsed := min(now, endTime) - settleTime
+	if elapsed <= 0 || principal.Sign() == 0 {
+		return new(big.Int)
+	}
+	n := new(big.Int).Mul(principal, rate)
+	n.Mul(n, big.NewInt(elapsed))
+	return n.Quo(n, new(big.Int).Mul(rateBase, big.NewInt(year)))
+}
+
+// share 返回 stake 在 total 里按比例分到的 amount
+func share(stake, amount, total *big.Int) *big.Int {
+	if total.Sign() == 0 {
+		return new(big.Int)
+	}
+	n := new(big.Int).Mul(stake, amount)
+	return n.Quo(n, total)
+}
+
+func ratio(a, b *big.Int) float64 {
+	if b.Sign() == 0 {
+		return 0
+	}
+	f, _ := new(big.Rat).SetFrac(a, b).Float64()
+	return f
+}
+
+func bigInt(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return new(big.Int)
+	}
+	return n
+}
+
+func stateName(state int) string {
+	if state < 0 || state >= len(model.StateNames) {
+		return "UNKNOWN"
+	}
+	return model.StateNames[state]
+}